@@ -0,0 +1,50 @@
+// optionhub-export выгружает каталог сервиса через ExportCatalog, например для хранения в git и сравнения окружений:
+//
+//	optionhub-export -addr optionhub:7070 -uuid <uuid> -format yaml -out catalog.yaml
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/s21platform/optionhub-service/internal/client"
+	"github.com/s21platform/optionhub-service/pkg/optionhub"
+)
+
+func main() {
+	conn := client.RegisterFlags(flag.CommandLine, "moderator")
+	format := flag.String("format", "json", "формат выгрузки: json, csv или yaml")
+	includeDeleted := flag.Bool("include-deleted", false, "выгрузить и удалённые значения")
+	out := flag.String("out", "", "файл для выгрузки, по умолчанию stdout")
+	flag.Parse()
+
+	exportFormat, ok := optionhub.ExportFormat_value["EXPORT_FORMAT_"+strings.ToUpper(*format)]
+	if !ok || exportFormat == int32(optionhub.ExportFormat_EXPORT_FORMAT_UNSPECIFIED) {
+		log.Fatalf("unknown format: %s", *format)
+	}
+
+	optionhubClient, ctx, closeFn, err := conn.Connect()
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer closeFn()
+
+	resp, err := optionhubClient.ExportCatalog(ctx, &optionhub.ExportCatalogIn{
+		Format:         optionhub.ExportFormat(exportFormat),
+		IncludeDeleted: *includeDeleted,
+	})
+	if err != nil {
+		log.Fatalf("failed to export catalog: %v", err)
+	}
+
+	if *out == "" {
+		_, err = os.Stdout.Write(resp.Data)
+	} else {
+		err = os.WriteFile(*out, resp.Data, 0o644)
+	}
+	if err != nil {
+		log.Fatalf("failed to write catalog: %v", err)
+	}
+}
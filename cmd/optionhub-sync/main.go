@@ -0,0 +1,200 @@
+// optionhub-sync приводит каталог сервиса к описанию из yaml (формат ExportCatalog):
+//
+//	optionhub-sync plan -addr optionhub:7070 -uuid <uuid> -file catalog.yaml
+//	optionhub-sync apply -addr optionhub:7070 -uuid <uuid> -file catalog.yaml -prune
+//
+// plan только печатает разницу. apply печатает её же, создаёт недостающие атрибуты и значения, а с -prune
+// ещё и удаляет (мягко) лишние значения. Лишние атрибуты никогда не удаляются, только попадают в отчёт
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+
+	"github.com/s21platform/optionhub-service/internal/client"
+	"github.com/s21platform/optionhub-service/internal/model"
+	"github.com/s21platform/optionhub-service/pkg/optionhub"
+)
+
+const (
+	modePlan  = "plan"
+	modeApply = "apply"
+)
+
+func main() {
+	if len(os.Args) < 2 || (os.Args[1] != modePlan && os.Args[1] != modeApply) {
+		fmt.Fprintf(os.Stderr, "usage: %s plan|apply -addr host:port -uuid uuid -file catalog.yaml [-prune]\n", os.Args[0])
+		os.Exit(2)
+	}
+	mode := os.Args[1]
+
+	fs := flag.NewFlagSet(mode, flag.ExitOnError)
+	conn := client.RegisterFlags(fs, "admin")
+	file := fs.String("file", "", "yaml с описанием каталога")
+	prune := fs.Bool("prune", false, "удалить значения, которых нет в описании")
+	_ = fs.Parse(os.Args[2:])
+
+	data, err := os.ReadFile(*file)
+	if err != nil {
+		log.Fatalf("failed to read seed: %v", err)
+	}
+
+	seed, err := model.ParseCatalogYAML(data)
+	if err != nil {
+		log.Fatalf("invalid seed %s: %v", *file, err)
+	}
+
+	optionhubClient, ctx, closeFn, err := conn.Connect()
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer closeFn()
+
+	current, err := exportCatalog(ctx, optionhubClient)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	plan, err := model.PlanSync(seed, current)
+	if err != nil {
+		log.Fatalf("failed to plan sync: %v", err)
+	}
+
+	printPlan(os.Stdout, plan, *prune)
+
+	if plan.Empty() || mode == modePlan {
+		return
+	}
+	if plan.HasConflicts() {
+		log.Fatal("plan has conflicts, nothing was changed")
+	}
+
+	err = applyPlan(ctx, optionhubClient, plan, *prune)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+func exportCatalog(ctx context.Context, optionhubClient optionhub.OptionhubServiceClient) (model.Catalog, error) {
+	resp, err := optionhubClient.ExportCatalog(ctx, &optionhub.ExportCatalogIn{
+		Format:         optionhub.ExportFormat_EXPORT_FORMAT_JSON,
+		IncludeDeleted: true,
+	})
+	if err != nil {
+		return model.Catalog{}, fmt.Errorf("failed to export catalog: %v", err)
+	}
+
+	var catalog model.Catalog
+	err = json.Unmarshal(resp.Data, &catalog)
+	if err != nil {
+		return model.Catalog{}, fmt.Errorf("failed to parse catalog: %v", err)
+	}
+
+	return catalog, nil
+}
+
+func printPlan(w io.Writer, plan model.SyncPlan, prune bool) {
+	if plan.Empty() {
+		fmt.Fprintln(w, "catalog is up to date")
+		return
+	}
+
+	var createdAttributes, created, conflicts, extras int
+	for _, attribute := range plan {
+		switch {
+		case attribute.Extra:
+			fmt.Fprintf(w, "? attribute %s [%d]: not in seed, kept\n", attribute.Name, attribute.ID)
+			continue
+		case attribute.Conflict != "":
+			fmt.Fprintf(w, "! attribute %s [%d]: %s\n", attribute.Name, attribute.ID, attribute.Conflict)
+			conflicts++
+			continue
+		case attribute.ID == 0:
+			fmt.Fprintf(w, "+ attribute %s (%s)\n", attribute.Name, attribute.Type)
+			createdAttributes++
+		default:
+			fmt.Fprintf(w, "  attribute %s [%d] (%s)\n", attribute.Name, attribute.ID, attribute.Type)
+		}
+
+		for _, step := range attribute.Steps {
+			switch step.Status {
+			case model.ImportStatusCreated:
+				fmt.Fprintf(w, "    + %s\n", model.JoinImportPath(step.Path))
+				created++
+			case model.ImportStatusConflict:
+				fmt.Fprintf(w, "    ! %s: %s\n", model.JoinImportPath(step.Path), step.Message)
+				conflicts++
+			}
+		}
+
+		for _, extra := range attribute.Extras {
+			action := "extra, kept"
+			if prune {
+				action = "will be deleted"
+			}
+			fmt.Fprintf(w, "    - %s [%d]: %s\n", model.JoinImportPath(extra.Path[1:]), extra.OptionID, action)
+			extras++
+		}
+	}
+
+	fmt.Fprintf(w, "\nattributes to create: %d, values to create: %d, conflicts: %d, extra values: %d\n",
+		createdAttributes, created, conflicts, extras)
+}
+
+func applyPlan(ctx context.Context, optionhubClient optionhub.OptionhubServiceClient, plan model.SyncPlan, prune bool) error {
+	for _, attribute := range plan {
+		if attribute.Extra {
+			continue
+		}
+
+		if attribute.ID == 0 {
+			resp, err := optionhubClient.CreateAttribute(ctx, &optionhub.CreateAttributeIn{
+				Name: attribute.Name,
+				Type: attribute.Type.ToDTO(),
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create attribute %s: %v", attribute.Name, err)
+			}
+			attribute.ID = resp.AttributeId
+			fmt.Printf("created attribute %s [%d]\n", attribute.Name, attribute.ID)
+		}
+
+		if attribute.Steps.Count(model.ImportStatusCreated) > 0 {
+			data, err := attribute.Values.JSON()
+			if err != nil {
+				return err
+			}
+
+			// план строится без удалённых значений, вернувшиеся в описание значения создаются заново
+			resp, err := optionhubClient.ImportAttributeValues(ctx, &optionhub.ImportAttributeValuesIn{
+				AttributeId:     attribute.ID,
+				Format:          optionhub.ImportFormat_IMPORT_FORMAT_JSON,
+				Data:            data,
+				RecreateDeleted: true,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to import values of %s: %v", attribute.Name, err)
+			}
+			fmt.Printf("imported values of %s: created %d, skipped %d, conflicts %d\n",
+				attribute.Name, resp.Created, resp.Skipped, resp.Conflicts)
+		}
+
+		if !prune {
+			continue
+		}
+		for _, extra := range attribute.Extras {
+			_, err := optionhubClient.DeleteAttributeValue(ctx, &optionhub.DeleteAttributeValueIn{OptionId: extra.OptionID})
+			if err != nil {
+				return fmt.Errorf("failed to delete %s [%d]: %v", model.JoinImportPath(extra.Path), extra.OptionID, err)
+			}
+			fmt.Printf("deleted %s [%d]\n", model.JoinImportPath(extra.Path), extra.OptionID)
+		}
+	}
+
+	return nil
+}
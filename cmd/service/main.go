@@ -1,9 +1,11 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net"
+	"time"
 
 	"google.golang.org/grpc"
 
@@ -11,8 +13,13 @@ import (
 	logger_lib "github.com/s21platform/logger-lib"
 	"github.com/s21platform/metrics-lib/pkg"
 
+	"github.com/s21platform/optionhub-service/internal/cache"
 	"github.com/s21platform/optionhub-service/internal/config"
+	"github.com/s21platform/optionhub-service/internal/consumer"
 	"github.com/s21platform/optionhub-service/internal/infra"
+	"github.com/s21platform/optionhub-service/internal/model"
+	"github.com/s21platform/optionhub-service/internal/notify"
+	"github.com/s21platform/optionhub-service/internal/outbox"
 	"github.com/s21platform/optionhub-service/internal/repository/postgres"
 	"github.com/s21platform/optionhub-service/internal/service"
 	"github.com/s21platform/optionhub-service/pkg/optionhub"
@@ -32,24 +39,91 @@ func main() {
 	}
 	defer metrics.Disconnect()
 
-	kafkaConfig := kafka_lib.DefaultProducerConfig(cfg.Kafka.Host, cfg.Kafka.Port, cfg.Kafka.SetAttributeTopic)
-
-	producerSetAttribute := kafka_lib.NewProducer(kafkaConfig)
-	defer func(producerSetAttribute *kafka_lib.KafkaProducer) {
+	producerSetAttribute := outbox.NewKafkaProducer(cfg.Kafka.Host, cfg.Kafka.Port, cfg.Kafka.SetAttributeTopic)
+	defer func(producerSetAttribute *outbox.KafkaProducer) {
 		err := producerSetAttribute.Close()
 		if err != nil {
 			logger.Error(fmt.Sprintf("failed to close producer: %v", err))
 		}
 	}(producerSetAttribute)
 
-	optionhubService := service.NewService(dbRepo, producerSetAttribute)
+	producerCatalogEvents := outbox.NewKafkaProducer(cfg.Kafka.Host, cfg.Kafka.Port, cfg.Kafka.CatalogEventsTopic)
+	defer func(producerCatalogEvents *outbox.KafkaProducer) {
+		err := producerCatalogEvents.Close()
+		if err != nil {
+			logger.Error(fmt.Sprintf("failed to close producer: %v", err))
+		}
+	}(producerCatalogEvents)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	relay := outbox.New(dbRepo, map[string]outbox.Producer{
+		model.OutboxTopicSetAttribute:  producerSetAttribute,
+		model.OutboxTopicCatalogEvents: producerCatalogEvents,
+	}, logger, cfg.Outbox.PollInterval, cfg.Outbox.BatchSize)
+	go relay.Run(ctx)
+	go pruneAttributeValueChanges(ctx, dbRepo, logger, cfg.Changes.PruneInterval, cfg.Changes.KeepVersions)
+
+	treeCache := cache.NewTreeCache(cfg.TreeCache.TTL, cfg.TreeCache.MaxEntries, metrics)
+	watchHub := notify.NewHub()
+	go listenAttributeChanges(ctx, dbRepo, logger, treeCache, watchHub)
+
+	optionhubService := service.NewService(dbRepo, treeCache, watchHub)
+
+	normalized, err := optionhubService.NormalizeLegacyValues(ctx)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to normalize legacy values: %v", err))
+		log.Fatalf("failed to normalize legacy values: %v", err)
+	}
+	if normalized > 0 {
+		logger.Info(fmt.Sprintf("normalized %d legacy values", normalized))
+	}
+
+	normalized, err = optionhubService.NormalizeLegacyOptionRequests(ctx)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to normalize legacy option requests: %v", err))
+		log.Fatalf("failed to normalize legacy option requests: %v", err)
+	}
+	if normalized > 0 {
+		logger.Info(fmt.Sprintf("normalized %d legacy option requests", normalized))
+	}
+
+	producerDeadLetter := kafka_lib.NewProducer(kafka_lib.DefaultProducerConfig(cfg.Kafka.Host, cfg.Kafka.Port, cfg.Kafka.OptionRequestDeadLetterTopic))
+	defer func(producerDeadLetter *kafka_lib.KafkaProducer) {
+		err := producerDeadLetter.Close()
+		if err != nil {
+			logger.Error(fmt.Sprintf("failed to close producer: %v", err))
+		}
+	}(producerDeadLetter)
+
+	optionRequestConsumer, err := kafka_lib.NewConsumer(kafka_lib.DefaultConsumerConfig(
+		cfg.Kafka.Host, cfg.Kafka.Port, cfg.Kafka.OptionRequestTopic, cfg.Kafka.OptionRequestGroup), metrics)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to create consumer: %v", err))
+		log.Fatalf("failed to create consumer: %v", err)
+	}
+
+	optionRequestHandler := consumer.NewOptionRequestHandler(dbRepo, optionhubService, producerDeadLetter, logger, cfg.Kafka.OptionRequestTopic)
+	optionRequestConsumer.RegisterHandler(ctx, optionRequestHandler.Handle)
+
+	roleProvider := infra.NewMetadataRoleProvider()
 
 	s := grpc.NewServer(
 		grpc.ChainUnaryInterceptor(
+			infra.RequestIDInterceptor,
 			infra.AuthInterceptor,
+			infra.AuthorizationInterceptor(roleProvider),
 			infra.MetricsInterceptor(metrics),
 			infra.Logger(logger),
 		),
+		grpc.ChainStreamInterceptor(
+			infra.RequestIDStreamInterceptor,
+			infra.AuthStreamInterceptor,
+			infra.AuthorizationStreamInterceptor(roleProvider),
+			infra.MetricsStreamInterceptor(metrics),
+			infra.StreamLogger(logger),
+		),
 	)
 
 	optionhub.RegisterOptionhubServiceServer(s, optionhubService)
@@ -63,3 +137,63 @@ func main() {
 		logger.Error(fmt.Sprintf("failed to start service: %s; Error: %s", cfg.Service.Port, err))
 	}
 }
+
+const (
+	listenRetryMin = time.Second
+	listenRetryMax = time.Minute
+)
+
+// listenAttributeChanges сбрасывает кэш деревьев и будит подписчиков WatchAttribute по уведомлениям об изменениях значений.
+// Пока подписки нет, изменения других реплик не видны, поэтому после ошибки кэш сбрасывается, а подписка
+// повторяется с растущей паузой, пока не отменён ctx
+func listenAttributeChanges(ctx context.Context, dbRepo *postgres.Repository, logger logger_lib.LoggerInterface, treeCache *cache.TreeCache, watchHub *notify.Hub) {
+	purge := func() {
+		treeCache.Purge()
+		watchHub.NotifyAll()
+	}
+
+	wait := listenRetryMin
+	for {
+		err := dbRepo.ListenAttributeChanges(ctx,
+			func(attributeId int64) {
+				treeCache.Invalidate(attributeId)
+				watchHub.Notify(attributeId)
+			},
+			purge,
+		)
+		if ctx.Err() != nil {
+			return
+		}
+
+		purge()
+		logger.Error(fmt.Sprintf("failed to listen attribute changes, retry in %s: %v", wait, err))
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(wait):
+		}
+		wait = min(wait*2, listenRetryMax)
+	}
+}
+
+// pruneAttributeValueChanges периодически ограничивает историю изменений значений, пока не отменён ctx
+func pruneAttributeValueChanges(ctx context.Context, dbRepo *postgres.Repository, logger logger_lib.LoggerInterface, interval time.Duration, keep int64) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		pruned, err := dbRepo.PruneAttributeValueChanges(ctx, keep)
+		if err != nil {
+			logger.Error(fmt.Sprintf("failed to prune attribute value changes: %v", err))
+		} else if pruned > 0 {
+			logger.Info(fmt.Sprintf("pruned %d attribute value changes", pruned))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
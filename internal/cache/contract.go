@@ -0,0 +1,6 @@
+//go:generate mockgen -destination=mock_contract_test.go -package=${GOPACKAGE} -source=contract.go
+package cache
+
+type Metrics interface {
+	Increment(name string)
+}
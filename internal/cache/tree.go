@@ -0,0 +1,137 @@
+package cache
+
+import (
+	"container/list"
+	"sync"
+	"time"
+
+	"github.com/s21platform/optionhub-service/pkg/optionhub"
+)
+
+const (
+	metricHit      = "tree_cache_hit"
+	metricMiss     = "tree_cache_miss"
+	metricEviction = "tree_cache_eviction"
+)
+
+type treeKey struct {
+	attributeId    int64
+	includeDeleted bool
+	locale         string
+}
+
+type treeEntry struct {
+	key       treeKey
+	version   int64
+	options   []*optionhub.Option
+	expiresAt time.Time
+}
+
+// TreeCache хранит построенные деревья значений по id атрибута. Записи живут не дольше ttl,
+// при переполнении вытесняются давно не читавшиеся. Отданные деревья общие для всех читателей и не должны изменяться
+type TreeCache struct {
+	mu          sync.Mutex
+	ttl         time.Duration
+	maxEntries  int
+	entries     map[treeKey]*list.Element
+	lru         *list.List
+	generations map[int64]uint64
+	purges      uint64
+	metrics     Metrics
+	now         func() time.Time
+}
+
+func NewTreeCache(ttl time.Duration, maxEntries int, metrics Metrics) *TreeCache {
+	return &TreeCache{
+		ttl:         ttl,
+		maxEntries:  maxEntries,
+		entries:     make(map[treeKey]*list.Element),
+		lru:         list.New(),
+		generations: make(map[int64]uint64),
+		metrics:     metrics,
+		now:         time.Now,
+	}
+}
+
+// GetOrLoad возвращает дерево версии version, переведённое на locale, из кэша или строит его через load.
+// Если атрибут инвалидировали, пока load читал базу, результат не кэшируется: он мог быть прочитан до изменения
+func (c *TreeCache) GetOrLoad(attributeId int64, version int64, includeDeleted bool, locale string, load func() ([]*optionhub.Option, error)) ([]*optionhub.Option, error) {
+	key := treeKey{attributeId: attributeId, includeDeleted: includeDeleted, locale: locale}
+
+	c.mu.Lock()
+	if el, ok := c.entries[key]; ok {
+		entry := el.Value.(*treeEntry)
+		if c.now().Before(entry.expiresAt) && entry.version == version {
+			c.lru.MoveToFront(el)
+			c.mu.Unlock()
+			c.metrics.Increment(metricHit)
+			return entry.options, nil
+		}
+		// более новое дерево не вытесняем: version мог быть прочитан до последнего изменения
+		if entry.version <= version {
+			c.remove(el)
+		}
+	}
+	generation, purges := c.generations[attributeId], c.purges
+	c.mu.Unlock()
+
+	c.metrics.Increment(metricMiss)
+
+	options, err := load()
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.generations[attributeId] == generation && c.purges == purges {
+		c.add(key, version, options)
+	}
+
+	return options, nil
+}
+
+// Invalidate удаляет деревья атрибута
+func (c *TreeCache) Invalidate(attributeId int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.generations[attributeId]++
+	for key, el := range c.entries {
+		if key.attributeId == attributeId {
+			c.remove(el)
+		}
+	}
+}
+
+// Purge удаляет все деревья, например когда уведомления об изменениях могли потеряться
+func (c *TreeCache) Purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.purges++
+	c.entries = make(map[treeKey]*list.Element)
+	c.lru.Init()
+}
+
+func (c *TreeCache) add(key treeKey, version int64, options []*optionhub.Option) {
+	if el, ok := c.entries[key]; ok {
+		if el.Value.(*treeEntry).version > version {
+			return
+		}
+		c.remove(el)
+	}
+
+	c.entries[key] = c.lru.PushFront(&treeEntry{key: key, version: version, options: options, expiresAt: c.now().Add(c.ttl)})
+
+	for c.lru.Len() > c.maxEntries {
+		c.remove(c.lru.Back())
+		c.metrics.Increment(metricEviction)
+	}
+}
+
+func (c *TreeCache) remove(el *list.Element) {
+	c.lru.Remove(el)
+	delete(c.entries, el.Value.(*treeEntry).key)
+}
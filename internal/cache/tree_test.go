@@ -0,0 +1,191 @@
+package cache
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/s21platform/optionhub-service/pkg/optionhub"
+)
+
+func TestTreeCache_GetOrLoad(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockMetrics := NewMockMetrics(ctrl)
+	mockMetrics.EXPECT().Increment(gomock.Any()).AnyTimes()
+
+	tree := []*optionhub.Option{{OptionId: 1, OptionValue: "Россия"}}
+
+	loads := 0
+	load := func() ([]*optionhub.Option, error) {
+		loads++
+		return tree, nil
+	}
+
+	t.Run("hit_after_miss", func(t *testing.T) {
+		c := NewTreeCache(time.Minute, 10, mockMetrics)
+		loads = 0
+
+		first, err := c.GetOrLoad(1, 1, false, "", load)
+		assert.NoError(t, err)
+		second, err := c.GetOrLoad(1, 1, false, "", load)
+		assert.NoError(t, err)
+
+		assert.Equal(t, tree, first)
+		assert.Equal(t, tree, second)
+		assert.Equal(t, 1, loads)
+	})
+
+	t.Run("include_deleted_cached_separately", func(t *testing.T) {
+		c := NewTreeCache(time.Minute, 10, mockMetrics)
+		loads = 0
+
+		_, _ = c.GetOrLoad(1, 1, false, "", load)
+		_, _ = c.GetOrLoad(1, 1, true, "", load)
+
+		assert.Equal(t, 2, loads)
+	})
+
+	t.Run("locale_cached_separately", func(t *testing.T) {
+		c := NewTreeCache(time.Minute, 10, mockMetrics)
+		loads = 0
+
+		_, _ = c.GetOrLoad(1, 1, false, "", load)
+		_, _ = c.GetOrLoad(1, 1, false, "en", load)
+		_, _ = c.GetOrLoad(1, 1, false, "en", load)
+
+		assert.Equal(t, 2, loads)
+
+		c.Invalidate(1)
+		_, _ = c.GetOrLoad(1, 1, false, "en", load)
+
+		assert.Equal(t, 3, loads)
+	})
+
+	t.Run("new_version_reloads", func(t *testing.T) {
+		c := NewTreeCache(time.Minute, 10, mockMetrics)
+		loads = 0
+
+		_, _ = c.GetOrLoad(1, 1, false, "", load)
+		_, _ = c.GetOrLoad(1, 2, false, "", load)
+		_, _ = c.GetOrLoad(1, 2, false, "", load)
+
+		assert.Equal(t, 2, loads)
+	})
+
+	t.Run("stale_version_keeps_newer_tree", func(t *testing.T) {
+		c := NewTreeCache(time.Minute, 10, mockMetrics)
+		loads = 0
+
+		_, _ = c.GetOrLoad(1, 2, false, "", load)
+		_, _ = c.GetOrLoad(1, 1, false, "", load)
+		_, _ = c.GetOrLoad(1, 2, false, "", load)
+
+		assert.Equal(t, 2, loads)
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		c := NewTreeCache(time.Minute, 10, mockMetrics)
+		now := time.Now()
+		c.now = func() time.Time { return now }
+		loads = 0
+
+		_, _ = c.GetOrLoad(1, 1, false, "", load)
+		now = now.Add(2 * time.Minute)
+		_, _ = c.GetOrLoad(1, 1, false, "", load)
+
+		assert.Equal(t, 2, loads)
+	})
+
+	t.Run("evicts_least_recently_used", func(t *testing.T) {
+		c := NewTreeCache(time.Minute, 2, mockMetrics)
+		loads = 0
+
+		_, _ = c.GetOrLoad(1, 1, false, "", load)
+		_, _ = c.GetOrLoad(2, 1, false, "", load)
+		_, _ = c.GetOrLoad(1, 1, false, "", load)
+		_, _ = c.GetOrLoad(3, 1, false, "", load)
+
+		_, _ = c.GetOrLoad(1, 1, false, "", load)
+		assert.Equal(t, 3, loads)
+		_, _ = c.GetOrLoad(2, 1, false, "", load)
+		assert.Equal(t, 4, loads)
+	})
+
+	t.Run("invalidate", func(t *testing.T) {
+		c := NewTreeCache(time.Minute, 10, mockMetrics)
+		loads = 0
+
+		_, _ = c.GetOrLoad(1, 1, false, "", load)
+		_, _ = c.GetOrLoad(1, 1, true, "", load)
+		_, _ = c.GetOrLoad(2, 1, false, "", load)
+		c.Invalidate(1)
+		_, _ = c.GetOrLoad(1, 1, false, "", load)
+		_, _ = c.GetOrLoad(1, 1, true, "", load)
+		_, _ = c.GetOrLoad(2, 1, false, "", load)
+
+		assert.Equal(t, 5, loads)
+	})
+
+	t.Run("invalidated_during_load", func(t *testing.T) {
+		c := NewTreeCache(time.Minute, 10, mockMetrics)
+		loads = 0
+
+		_, _ = c.GetOrLoad(1, 1, false, "", func() ([]*optionhub.Option, error) {
+			c.Invalidate(1)
+			return load()
+		})
+		_, _ = c.GetOrLoad(1, 1, false, "", load)
+
+		assert.Equal(t, 2, loads)
+	})
+
+	t.Run("purge", func(t *testing.T) {
+		c := NewTreeCache(time.Minute, 10, mockMetrics)
+		loads = 0
+
+		_, _ = c.GetOrLoad(1, 1, false, "", load)
+		c.Purge()
+		_, _ = c.GetOrLoad(1, 1, false, "", load)
+
+		assert.Equal(t, 2, loads)
+	})
+
+	t.Run("load_error_not_cached", func(t *testing.T) {
+		c := NewTreeCache(time.Minute, 10, mockMetrics)
+		loads = 0
+
+		_, err := c.GetOrLoad(1, 1, false, "", func() ([]*optionhub.Option, error) {
+			return nil, errors.New("test error")
+		})
+		assert.Error(t, err)
+
+		_, _ = c.GetOrLoad(1, 1, false, "", load)
+		assert.Equal(t, 1, loads)
+	})
+}
+
+func TestTreeCache_Metrics(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockMetrics := NewMockMetrics(ctrl)
+	gomock.InOrder(
+		mockMetrics.EXPECT().Increment("tree_cache_miss"),
+		mockMetrics.EXPECT().Increment("tree_cache_hit"),
+	)
+
+	c := NewTreeCache(time.Minute, 10, mockMetrics)
+	load := func() ([]*optionhub.Option, error) { return nil, nil }
+
+	_, _ = c.GetOrLoad(1, 1, false, "", load)
+	_, _ = c.GetOrLoad(1, 1, false, "", load)
+}
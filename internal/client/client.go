@@ -0,0 +1,55 @@
+// Package client подключает утилиты из cmd к сервису от имени пользователя
+package client
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/s21platform/optionhub-service/pkg/optionhub"
+)
+
+// Flags - общие флаги подключения
+type Flags struct {
+	Addr    string
+	UUID    string
+	Role    string
+	Timeout time.Duration
+}
+
+// RegisterFlags добавляет флаги подключения в fs; role - роль по умолчанию, которой хватает утилите
+func RegisterFlags(fs *flag.FlagSet, role string) *Flags {
+	f := &Flags{}
+	fs.StringVar(&f.Addr, "addr", "", "адрес сервиса, host:port")
+	fs.StringVar(&f.UUID, "uuid", "", "uuid пользователя, от имени которого идут запросы")
+	fs.StringVar(&f.Role, "role", role, "роль пользователя")
+	fs.DurationVar(&f.Timeout, "timeout", time.Minute, "таймаут всей работы утилиты")
+	return f
+}
+
+// Connect открывает соединение и возвращает контекст с метаданными пользователя. close закрывает и то, и другое
+func (f *Flags) Connect() (optionhub.OptionhubServiceClient, context.Context, func(), error) {
+	if f.Addr == "" || f.UUID == "" {
+		return nil, nil, nil, fmt.Errorf("-addr and -uuid are required")
+	}
+
+	conn, err := grpc.NewClient(f.Addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to connect: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), f.Timeout)
+	ctx = metadata.AppendToOutgoingContext(ctx, "uuid", f.UUID, "role", f.Role)
+
+	closeFn := func() {
+		cancel()
+		_ = conn.Close()
+	}
+
+	return optionhub.NewOptionhubServiceClient(conn), ctx, closeFn, nil
+}
@@ -2,17 +2,21 @@ package config
 
 import (
 	"log"
+	"time"
 
 	"github.com/ilyakaznacheev/cleanenv"
 )
 
 type Config struct {
-	Service  Service
-	Postgres Postgres
-	Metrics  Metrics
-	Logger   Logger
-	Platform Platform
-	Kafka    Kafka
+	Service   Service
+	Postgres  Postgres
+	Metrics   Metrics
+	Logger    Logger
+	Platform  Platform
+	Kafka     Kafka
+	Outbox    Outbox
+	TreeCache TreeCache
+	Changes   Changes
 }
 
 type Service struct {
@@ -43,9 +47,29 @@ type Platform struct {
 }
 
 type Kafka struct {
-	Host              string `env:"KAFKA_HOST"`
-	Port              string `env:"KAFKA_PORT"`
-	SetAttributeTopic string `env:"STAFF_SET_ATTRIBUTE"`
+	Host               string `env:"KAFKA_HOST"`
+	Port               string `env:"KAFKA_PORT"`
+	SetAttributeTopic  string `env:"STAFF_SET_ATTRIBUTE"`
+	CatalogEventsTopic string `env:"OPTIONHUB_CATALOG_EVENTS"`
+
+	OptionRequestTopic           string `env:"OPTIONHUB_OPTION_REQUEST"`
+	OptionRequestGroup           string `env:"OPTIONHUB_OPTION_REQUEST_GROUP"`
+	OptionRequestDeadLetterTopic string `env:"OPTIONHUB_OPTION_REQUEST_DLQ"`
+}
+
+type Outbox struct {
+	PollInterval time.Duration `env:"OUTBOX_POLL_INTERVAL" env-default:"1s"` // пауза между опросами outbox
+	BatchSize    uint64        `env:"OUTBOX_BATCH_SIZE" env-default:"100"`
+}
+
+type TreeCache struct {
+	TTL        time.Duration `env:"TREE_CACHE_TTL" env-default:"5m"` // время жизни дерева значений в кэше
+	MaxEntries int           `env:"TREE_CACHE_MAX_ENTRIES" env-default:"1000"`
+}
+
+type Changes struct {
+	KeepVersions  int64         `env:"CHANGES_KEEP_VERSIONS" env-default:"1000"` // сколько последних изменений атрибута хранить для WatchAttribute
+	PruneInterval time.Duration `env:"CHANGES_PRUNE_INTERVAL" env-default:"1h"`
 }
 
 func NewConfig() *Config {
@@ -5,3 +5,5 @@ type key string
 const KeyUUID = key("uuid")
 const KeyMetrics = key("metrics")
 const KeyLogger = key("logger")
+const KeyRole = key("role")
+const KeyRequestID = key("request_id")
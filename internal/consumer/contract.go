@@ -0,0 +1,21 @@
+//go:generate mockgen -destination=mock_contract_test.go -package=${GOPACKAGE} -source=contract.go
+package consumer
+
+import (
+	"context"
+
+	"github.com/s21platform/optionhub-service/pkg/optionhub"
+)
+
+type Repository interface {
+	WithTx(ctx context.Context, fn func(ctx context.Context) error) error
+	MarkMessageConsumed(ctx context.Context, messageId string) (bool, error)
+}
+
+type OptionRequestCreator interface {
+	CreateOptionRequest(ctx context.Context, in *optionhub.CreateOptionRequestIn) (*optionhub.CreateOptionRequestOut, error)
+}
+
+type DeadLetterProducer interface {
+	ProduceMessage(ctx context.Context, message any, key any) error
+}
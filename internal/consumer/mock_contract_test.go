@@ -0,0 +1,140 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: contract.go
+
+// Package consumer is a generated GoMock package.
+package consumer
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	optionhub "github.com/s21platform/optionhub-service/pkg/optionhub"
+)
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// MarkMessageConsumed mocks base method.
+func (m *MockRepository) MarkMessageConsumed(ctx context.Context, messageId string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkMessageConsumed", ctx, messageId)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkMessageConsumed indicates an expected call of MarkMessageConsumed.
+func (mr *MockRepositoryMockRecorder) MarkMessageConsumed(ctx, messageId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkMessageConsumed", reflect.TypeOf((*MockRepository)(nil).MarkMessageConsumed), ctx, messageId)
+}
+
+// WithTx mocks base method.
+func (m *MockRepository) WithTx(ctx context.Context, fn func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithTx", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WithTx indicates an expected call of WithTx.
+func (mr *MockRepositoryMockRecorder) WithTx(ctx, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithTx", reflect.TypeOf((*MockRepository)(nil).WithTx), ctx, fn)
+}
+
+// MockOptionRequestCreator is a mock of OptionRequestCreator interface.
+type MockOptionRequestCreator struct {
+	ctrl     *gomock.Controller
+	recorder *MockOptionRequestCreatorMockRecorder
+}
+
+// MockOptionRequestCreatorMockRecorder is the mock recorder for MockOptionRequestCreator.
+type MockOptionRequestCreatorMockRecorder struct {
+	mock *MockOptionRequestCreator
+}
+
+// NewMockOptionRequestCreator creates a new mock instance.
+func NewMockOptionRequestCreator(ctrl *gomock.Controller) *MockOptionRequestCreator {
+	mock := &MockOptionRequestCreator{ctrl: ctrl}
+	mock.recorder = &MockOptionRequestCreatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOptionRequestCreator) EXPECT() *MockOptionRequestCreatorMockRecorder {
+	return m.recorder
+}
+
+// CreateOptionRequest mocks base method.
+func (m *MockOptionRequestCreator) CreateOptionRequest(ctx context.Context, in *optionhub.CreateOptionRequestIn) (*optionhub.CreateOptionRequestOut, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOptionRequest", ctx, in)
+	ret0, _ := ret[0].(*optionhub.CreateOptionRequestOut)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOptionRequest indicates an expected call of CreateOptionRequest.
+func (mr *MockOptionRequestCreatorMockRecorder) CreateOptionRequest(ctx, in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOptionRequest", reflect.TypeOf((*MockOptionRequestCreator)(nil).CreateOptionRequest), ctx, in)
+}
+
+// MockDeadLetterProducer is a mock of DeadLetterProducer interface.
+type MockDeadLetterProducer struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeadLetterProducerMockRecorder
+}
+
+// MockDeadLetterProducerMockRecorder is the mock recorder for MockDeadLetterProducer.
+type MockDeadLetterProducerMockRecorder struct {
+	mock *MockDeadLetterProducer
+}
+
+// NewMockDeadLetterProducer creates a new mock instance.
+func NewMockDeadLetterProducer(ctrl *gomock.Controller) *MockDeadLetterProducer {
+	mock := &MockDeadLetterProducer{ctrl: ctrl}
+	mock.recorder = &MockDeadLetterProducerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeadLetterProducer) EXPECT() *MockDeadLetterProducerMockRecorder {
+	return m.recorder
+}
+
+// ProduceMessage mocks base method.
+func (m *MockDeadLetterProducer) ProduceMessage(ctx context.Context, message, key any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProduceMessage", ctx, message, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ProduceMessage indicates an expected call of ProduceMessage.
+func (mr *MockDeadLetterProducerMockRecorder) ProduceMessage(ctx, message, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProduceMessage", reflect.TypeOf((*MockDeadLetterProducer)(nil).ProduceMessage), ctx, message, key)
+}
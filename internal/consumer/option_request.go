@@ -0,0 +1,138 @@
+package consumer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	logger_lib "github.com/s21platform/logger-lib"
+
+	"github.com/s21platform/optionhub-service/internal/config"
+	"github.com/s21platform/optionhub-service/internal/model"
+	"github.com/s21platform/optionhub-service/pkg/optionhub"
+)
+
+const maxAttempts = 3
+
+// OptionRequestHandler принимает заявки на новые значения из Kafka и создаёт их так же, как CreateOptionRequest.
+// Сообщения, которые невозможно обработать, уходят в dead-letter топик, чтобы не блокировать чтение
+type OptionRequestHandler struct {
+	repo       Repository
+	creator    OptionRequestCreator
+	dlq        DeadLetterProducer
+	logger     logger_lib.LoggerInterface
+	topic      string
+	retryDelay time.Duration
+}
+
+func NewOptionRequestHandler(repo Repository, creator OptionRequestCreator, dlq DeadLetterProducer, logger logger_lib.LoggerInterface, topic string) *OptionRequestHandler {
+	return &OptionRequestHandler{
+		repo:       repo,
+		creator:    creator,
+		dlq:        dlq,
+		logger:     logger,
+		topic:      topic,
+		retryDelay: time.Second,
+	}
+}
+
+// Handle обрабатывает одно сообщение. Временные ошибки повторяются до maxAttempts раз,
+// после чего сообщение, как и невалидное, отправляется в dead-letter топик
+func (h *OptionRequestHandler) Handle(ctx context.Context, msg []byte) error {
+	var in optionhub.OptionRequest
+
+	err := json.Unmarshal(msg, &in)
+	if err != nil {
+		return h.deadLetter(ctx, msg, fmt.Errorf("failed to decode message: %v", err))
+	}
+
+	for attempt := 1; ; attempt++ {
+		err = h.ingest(ctx, &in)
+		if err == nil {
+			return nil
+		}
+		if !isRetryable(err) || attempt == maxAttempts {
+			break
+		}
+
+		h.logger.Warn(fmt.Sprintf("failed to consume option request, attempt %d: %v", attempt, err))
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(h.retryDelay * time.Duration(attempt)):
+		}
+	}
+
+	return h.deadLetter(ctx, msg, err)
+}
+
+func (h *OptionRequestHandler) ingest(ctx context.Context, in *optionhub.OptionRequest) error {
+	if in.UserUuid == "" {
+		return status.Error(codes.InvalidArgument, "user_uuid is empty")
+	}
+
+	ctx = context.WithValue(ctx, config.KeyLogger, h.logger)
+	ctx = context.WithValue(ctx, config.KeyUUID, in.UserUuid)
+
+	return h.repo.WithTx(ctx, func(ctx context.Context) error {
+		if in.MessageId != "" {
+			fresh, err := h.repo.MarkMessageConsumed(ctx, in.MessageId)
+			if err != nil {
+				return err
+			}
+			if !fresh {
+				h.logger.Info(fmt.Sprintf("message %s is already consumed", in.MessageId))
+				return nil
+			}
+		}
+
+		_, err := h.creator.CreateOptionRequest(ctx, &optionhub.CreateOptionRequestIn{
+			AttributeId: in.AttributeId,
+			Value:       in.Value,
+			ParentId:    in.ParentId,
+		})
+		if status.Code(err) == codes.AlreadyExists {
+			// значение уже есть в каталоге или ждёт модерации - повторная заявка не нужна
+			h.logger.Info(fmt.Sprintf("skip duplicate option request: %v", err))
+			return nil
+		}
+
+		return err
+	})
+}
+
+func (h *OptionRequestHandler) deadLetter(ctx context.Context, msg []byte, cause error) error {
+	h.logger.Error(fmt.Sprintf("failed to consume option request, sending to dead-letter topic: %v", cause))
+
+	err := h.dlq.ProduceMessage(ctx, model.DeadLetter{
+		Topic:    h.topic,
+		Payload:  string(msg),
+		Error:    cause.Error(),
+		FailedAt: time.Now(),
+	}, h.topic)
+	if err != nil {
+		return fmt.Errorf("failed to produce dead letter: %v", err)
+	}
+
+	return nil
+}
+
+// isRetryable отделяет ошибки в самом сообщении от временных ошибок базы
+func isRetryable(err error) bool {
+	st, ok := status.FromError(err)
+	if !ok {
+		return true
+	}
+
+	switch st.Code() {
+	case codes.InvalidArgument, codes.NotFound, codes.FailedPrecondition, codes.Unauthenticated:
+		return false
+	default:
+		return true
+	}
+}
@@ -0,0 +1,140 @@
+package consumer
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	logger_lib "github.com/s21platform/logger-lib"
+
+	"github.com/s21platform/optionhub-service/internal/config"
+	"github.com/s21platform/optionhub-service/internal/model"
+	"github.com/s21platform/optionhub-service/pkg/optionhub"
+)
+
+func runInTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func TestOptionRequestHandler_Handle(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLogger := logger_lib.NewMockLoggerInterface(ctrl)
+	mockRepo := NewMockRepository(ctrl)
+	mockCreator := NewMockOptionRequestCreator(ctrl)
+	mockDLQ := NewMockDeadLetterProducer(ctrl)
+
+	newHandler := func() *OptionRequestHandler {
+		h := NewOptionRequestHandler(mockRepo, mockCreator, mockDLQ, mockLogger, "option_request")
+		h.retryDelay = 0
+		return h
+	}
+
+	msg := []byte(`{"message_id":"m-1","attribute_id":5,"value":"Ubuntu","user_uuid":"user-uuid"}`)
+
+	t.Run("handle_ok", func(t *testing.T) {
+		mockRepo.EXPECT().WithTx(gomock.Any(), gomock.Any()).DoAndReturn(runInTx)
+		mockRepo.EXPECT().MarkMessageConsumed(gomock.Any(), "m-1").Return(true, nil)
+		mockCreator.EXPECT().CreateOptionRequest(gomock.Any(), &optionhub.CreateOptionRequestIn{AttributeId: 5, Value: "Ubuntu"}).
+			DoAndReturn(func(ctx context.Context, _ *optionhub.CreateOptionRequestIn) (*optionhub.CreateOptionRequestOut, error) {
+				assert.Equal(t, "user-uuid", ctx.Value(config.KeyUUID))
+				return &optionhub.CreateOptionRequestOut{OptionRequestId: 1}, nil
+			})
+
+		err := newHandler().Handle(ctx, msg)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("handle_redelivered", func(t *testing.T) {
+		mockRepo.EXPECT().WithTx(gomock.Any(), gomock.Any()).DoAndReturn(runInTx)
+		mockRepo.EXPECT().MarkMessageConsumed(gomock.Any(), "m-1").Return(false, nil)
+		mockLogger.EXPECT().Info("message m-1 is already consumed")
+
+		err := newHandler().Handle(ctx, msg)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("handle_duplicate_value", func(t *testing.T) {
+		mockRepo.EXPECT().WithTx(gomock.Any(), gomock.Any()).DoAndReturn(runInTx)
+		mockRepo.EXPECT().MarkMessageConsumed(gomock.Any(), "m-1").Return(true, nil)
+		mockCreator.EXPECT().CreateOptionRequest(gomock.Any(), gomock.Any()).
+			Return(nil, status.Error(codes.AlreadyExists, "value is already requested"))
+		mockLogger.EXPECT().Info(gomock.Any())
+
+		err := newHandler().Handle(ctx, msg)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("handle_broken_json", func(t *testing.T) {
+		mockLogger.EXPECT().Error(gomock.Any())
+		mockDLQ.EXPECT().ProduceMessage(gomock.Any(), gomock.Any(), "option_request").
+			DoAndReturn(func(_ context.Context, message any, _ any) error {
+				letter := message.(model.DeadLetter)
+				assert.Equal(t, "option_request", letter.Topic)
+				assert.Equal(t, "{broken", letter.Payload)
+				assert.Contains(t, letter.Error, "failed to decode message")
+				return nil
+			})
+
+		err := newHandler().Handle(ctx, []byte("{broken"))
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("handle_invalid_value", func(t *testing.T) {
+		mockRepo.EXPECT().WithTx(gomock.Any(), gomock.Any()).DoAndReturn(runInTx)
+		mockRepo.EXPECT().MarkMessageConsumed(gomock.Any(), "m-1").Return(true, nil)
+		mockCreator.EXPECT().CreateOptionRequest(gomock.Any(), gomock.Any()).
+			Return(nil, status.Error(codes.InvalidArgument, "invalid attribute value"))
+		mockLogger.EXPECT().Error(gomock.Any())
+		mockDLQ.EXPECT().ProduceMessage(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+		err := newHandler().Handle(ctx, msg)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("handle_retry_then_dead_letter", func(t *testing.T) {
+		mockRepo.EXPECT().WithTx(gomock.Any(), gomock.Any()).Return(errors.New("db is down")).Times(maxAttempts)
+		mockLogger.EXPECT().Warn(gomock.Any()).Times(maxAttempts - 1)
+		mockLogger.EXPECT().Error(gomock.Any())
+		mockDLQ.EXPECT().ProduceMessage(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+		err := newHandler().Handle(ctx, msg)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("handle_retry_ok", func(t *testing.T) {
+		gomock.InOrder(
+			mockRepo.EXPECT().WithTx(gomock.Any(), gomock.Any()).Return(errors.New("db is down")),
+			mockRepo.EXPECT().WithTx(gomock.Any(), gomock.Any()).Return(nil),
+		)
+		mockLogger.EXPECT().Warn(gomock.Any())
+
+		err := newHandler().Handle(ctx, msg)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("handle_dead_letter_error", func(t *testing.T) {
+		mockLogger.EXPECT().Error(gomock.Any())
+		mockDLQ.EXPECT().ProduceMessage(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("kafka is down"))
+
+		err := newHandler().Handle(ctx, []byte("{broken"))
+
+		assert.ErrorContains(t, err, "failed to produce dead letter")
+	})
+}
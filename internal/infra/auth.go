@@ -18,6 +18,29 @@ func AuthInterceptor(
 	handler grpc.UnaryHandler,
 ) (interface{}, error) {
 	_ = info
+	ctx, err := authenticate(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler(ctx, req)
+}
+
+func AuthStreamInterceptor(
+	srv interface{},
+	ss grpc.ServerStream,
+	_ *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	ctx, err := authenticate(ss.Context())
+	if err != nil {
+		return err
+	}
+
+	return handler(srv, withContext(ss, ctx))
+}
+
+func authenticate(ctx context.Context) (context.Context, error) {
 	md, ok := metadata.FromIncomingContext(ctx)
 
 	if !ok {
@@ -29,7 +52,5 @@ func AuthInterceptor(
 		return nil, status.Errorf(codes.Unauthenticated, "no uuid or more than one in metadata")
 	}
 
-	ctx = context.WithValue(ctx, config.KeyUUID, userIDs[0])
-
-	return handler(ctx, req)
+	return context.WithValue(ctx, config.KeyUUID, userIDs[0]), nil
 }
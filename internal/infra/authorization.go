@@ -0,0 +1,102 @@
+package infra
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/s21platform/optionhub-service/internal/config"
+	"github.com/s21platform/optionhub-service/internal/model"
+	"github.com/s21platform/optionhub-service/pkg/optionhub"
+)
+
+// methodRoles - минимальная роль для вызова метода. Методы, которых нет в таблице, доступны только админу
+var methodRoles = map[string]model.Role{
+	optionhub.OptionhubService_GetAttributeValues_FullMethodName:       model.RoleUser,
+	optionhub.OptionhubService_SearchAttributeValues_FullMethodName:    model.RoleUser,
+	optionhub.OptionhubService_GetSubtree_FullMethodName:               model.RoleUser,
+	optionhub.OptionhubService_GetAncestors_FullMethodName:             model.RoleUser,
+	optionhub.OptionhubService_GetChildren_FullMethodName:              model.RoleUser,
+	optionhub.OptionhubService_ResolveOptions_FullMethodName:           model.RoleUser,
+	optionhub.OptionhubService_GetAttributeValueAliases_FullMethodName: model.RoleUser,
+	optionhub.OptionhubService_WatchAttribute_FullMethodName:           model.RoleUser,
+	optionhub.OptionhubService_GetAttribute_FullMethodName:             model.RoleUser,
+	optionhub.OptionhubService_ListAttributes_FullMethodName:           model.RoleUser,
+	optionhub.OptionhubService_CreateOptionRequest_FullMethodName:      model.RoleUser,
+	// обычный пользователь видит только свои заявки, см. Service.GetOptionRequests
+	optionhub.OptionhubService_GetOptionRequests_FullMethodName: model.RoleUser,
+
+	optionhub.OptionhubService_AddAttributeValue_FullMethodName:     model.RoleModerator,
+	optionhub.OptionhubService_UpdateAttributeValue_FullMethodName:  model.RoleModerator,
+	optionhub.OptionhubService_DeleteAttributeValue_FullMethodName:  model.RoleModerator,
+	optionhub.OptionhubService_MergeAttributeValues_FullMethodName:  model.RoleModerator,
+	optionhub.OptionhubService_ImportAttributeValues_FullMethodName: model.RoleModerator,
+	optionhub.OptionhubService_ExportCatalog_FullMethodName:         model.RoleModerator,
+	optionhub.OptionhubService_ApproveOptionRequest_FullMethodName:  model.RoleModerator,
+	optionhub.OptionhubService_RejectOptionRequest_FullMethodName:   model.RoleModerator,
+	optionhub.OptionhubService_MergeOptionRequest_FullMethodName:    model.RoleModerator,
+
+	optionhub.OptionhubService_SetAttributeTranslation_FullMethodName:         model.RoleModerator,
+	optionhub.OptionhubService_DeleteAttributeTranslation_FullMethodName:      model.RoleModerator,
+	optionhub.OptionhubService_SetAttributeValueTranslation_FullMethodName:    model.RoleModerator,
+	optionhub.OptionhubService_DeleteAttributeValueTranslation_FullMethodName: model.RoleModerator,
+	optionhub.OptionhubService_AddAttributeValueAlias_FullMethodName:          model.RoleModerator,
+	optionhub.OptionhubService_DeleteAttributeValueAlias_FullMethodName:       model.RoleModerator,
+
+	optionhub.OptionhubService_CreateAttribute_FullMethodName: model.RoleAdmin,
+	optionhub.OptionhubService_UpdateAttribute_FullMethodName: model.RoleAdmin,
+	optionhub.OptionhubService_DeleteAttribute_FullMethodName: model.RoleAdmin,
+	optionhub.OptionhubService_GetAuditLog_FullMethodName:     model.RoleAdmin,
+}
+
+func requiredRole(method string) model.Role {
+	if role, ok := methodRoles[method]; ok {
+		return role
+	}
+	return model.RoleAdmin
+}
+
+// AuthorizationInterceptor проверяет роль пользователя по таблице methodRoles и кладёт её в контекст.
+// Должен идти в цепочке после AuthInterceptor
+func AuthorizationInterceptor(provider RoleProvider) func(context.Context, interface{}, *grpc.UnaryServerInfo, grpc.UnaryHandler) (interface{}, error) {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := authorize(ctx, provider, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// AuthorizationStreamInterceptor - то же для стримов, идёт после AuthStreamInterceptor
+func AuthorizationStreamInterceptor(provider RoleProvider) func(interface{}, grpc.ServerStream, *grpc.StreamServerInfo, grpc.StreamHandler) error {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := authorize(ss.Context(), provider, info.FullMethod)
+		if err != nil {
+			return err
+		}
+
+		return handler(srv, withContext(ss, ctx))
+	}
+}
+
+func authorize(ctx context.Context, provider RoleProvider, method string) (context.Context, error) {
+	uuid, ok := ctx.Value(config.KeyUUID).(string)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "no uuid in context")
+	}
+
+	role, err := provider.GetRole(ctx, uuid)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get role: %v", err)
+	}
+
+	if !role.Allows(requiredRole(method)) {
+		return nil, status.Errorf(codes.PermissionDenied, "method %s requires role %s", method, requiredRole(method))
+	}
+
+	return context.WithValue(ctx, config.KeyRole, role), nil
+}
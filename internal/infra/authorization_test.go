@@ -0,0 +1,163 @@
+package infra
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/s21platform/optionhub-service/internal/config"
+	"github.com/s21platform/optionhub-service/internal/model"
+	"github.com/s21platform/optionhub-service/pkg/optionhub"
+)
+
+func TestAuthorizationInterceptor(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockProvider := NewMockRoleProvider(ctrl)
+	interceptor := AuthorizationInterceptor(mockProvider)
+
+	ctx := context.WithValue(context.Background(), config.KeyUUID, "test-uuid")
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return ctx.Value(config.KeyRole), nil
+	}
+	call := func(ctx context.Context, method string) (interface{}, error) {
+		return interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+	}
+
+	t.Run("user_reads_catalog", func(t *testing.T) {
+		mockProvider.EXPECT().GetRole(gomock.Any(), "test-uuid").Return(model.RoleUser, nil)
+
+		role, err := call(ctx, optionhub.OptionhubService_GetAttributeValues_FullMethodName)
+
+		assert.NoError(t, err)
+		assert.Equal(t, model.RoleUser, role)
+	})
+
+	t.Run("user_adds_value", func(t *testing.T) {
+		mockProvider.EXPECT().GetRole(gomock.Any(), "test-uuid").Return(model.RoleUser, nil)
+
+		_, err := call(ctx, optionhub.OptionhubService_AddAttributeValue_FullMethodName)
+
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("moderator_approves_request", func(t *testing.T) {
+		mockProvider.EXPECT().GetRole(gomock.Any(), "test-uuid").Return(model.RoleModerator, nil)
+
+		_, err := call(ctx, optionhub.OptionhubService_ApproveOptionRequest_FullMethodName)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("moderator_creates_attribute", func(t *testing.T) {
+		mockProvider.EXPECT().GetRole(gomock.Any(), "test-uuid").Return(model.RoleModerator, nil)
+
+		_, err := call(ctx, optionhub.OptionhubService_CreateAttribute_FullMethodName)
+
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("admin_creates_attribute", func(t *testing.T) {
+		mockProvider.EXPECT().GetRole(gomock.Any(), "test-uuid").Return(model.RoleAdmin, nil)
+
+		_, err := call(ctx, optionhub.OptionhubService_CreateAttribute_FullMethodName)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("unknown_method_requires_admin", func(t *testing.T) {
+		mockProvider.EXPECT().GetRole(gomock.Any(), "test-uuid").Return(model.RoleModerator, nil)
+
+		_, err := call(ctx, "/OptionhubService/Unknown")
+
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("provider_error", func(t *testing.T) {
+		mockProvider.EXPECT().GetRole(gomock.Any(), "test-uuid").Return(model.Role(""), errors.New("test error"))
+
+		_, err := call(ctx, optionhub.OptionhubService_GetAttributeValues_FullMethodName)
+
+		assert.Equal(t, codes.Internal, status.Code(err))
+	})
+
+	t.Run("no_uuid", func(t *testing.T) {
+		_, err := call(context.Background(), optionhub.OptionhubService_GetAttributeValues_FullMethodName)
+
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+}
+
+// testServerStream - стрим, у которого есть только контекст
+type testServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *testServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestAuthorizationStreamInterceptor(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockProvider := NewMockRoleProvider(ctrl)
+	interceptor := AuthorizationStreamInterceptor(mockProvider)
+
+	ctx := context.WithValue(context.Background(), config.KeyUUID, "test-uuid")
+	info := &grpc.StreamServerInfo{FullMethod: optionhub.OptionhubService_WatchAttribute_FullMethodName}
+
+	t.Run("user_watches_attribute", func(t *testing.T) {
+		mockProvider.EXPECT().GetRole(gomock.Any(), "test-uuid").Return(model.RoleUser, nil)
+
+		var role interface{}
+		err := interceptor(nil, &testServerStream{ctx: ctx}, info, func(_ interface{}, ss grpc.ServerStream) error {
+			role = ss.Context().Value(config.KeyRole)
+			return nil
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, model.RoleUser, role)
+	})
+
+	t.Run("no_uuid", func(t *testing.T) {
+		err := interceptor(nil, &testServerStream{ctx: context.Background()}, info, func(interface{}, grpc.ServerStream) error {
+			return nil
+		})
+
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+}
+
+func TestMetadataRoleProvider_GetRole(t *testing.T) {
+	t.Parallel()
+
+	p := NewMetadataRoleProvider()
+
+	role, err := p.GetRole(context.Background(), "test-uuid")
+	assert.NoError(t, err)
+	assert.Equal(t, model.RoleUser, role)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("role", "moderator"))
+	role, err = p.GetRole(ctx, "test-uuid")
+	assert.NoError(t, err)
+	assert.Equal(t, model.RoleModerator, role)
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs("role", "superuser"))
+	_, err = p.GetRole(ctx, "test-uuid")
+	assert.Error(t, err)
+}
@@ -0,0 +1,13 @@
+//go:generate mockgen -destination=mock_contract_test.go -package=${GOPACKAGE} -source=contract.go
+package infra
+
+import (
+	"context"
+
+	"github.com/s21platform/optionhub-service/internal/model"
+)
+
+// RoleProvider определяет роль пользователя; реализацию можно заменить, например, на сервис пользователей
+type RoleProvider interface {
+	GetRole(ctx context.Context, uuid string) (model.Role, error)
+}
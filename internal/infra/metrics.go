@@ -36,3 +36,27 @@ func MetricsInterceptor(metrics *pkg.Metrics) func(ctx context.Context, req inte
 		return resp, err
 	}
 }
+
+func MetricsStreamInterceptor(metrics *pkg.Metrics) func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler) error {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler) error {
+		t := time.Now()
+		method := strings.Trim(strings.ReplaceAll(info.FullMethod, "/", "_"), "_")
+		metrics.Increment(method)
+
+		ctx := context.WithValue(ss.Context(), config.KeyMetrics, metrics)
+		err := handler(srv, withContext(ss, ctx))
+
+		if err != nil {
+			metrics.Increment(method + "_error")
+		}
+
+		metrics.Duration(time.Since(t).Milliseconds(), method)
+
+		return err
+	}
+}
@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: contract.go
+
+// Package infra is a generated GoMock package.
+package infra
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	model "github.com/s21platform/optionhub-service/internal/model"
+)
+
+// MockRoleProvider is a mock of RoleProvider interface.
+type MockRoleProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockRoleProviderMockRecorder
+}
+
+// MockRoleProviderMockRecorder is the mock recorder for MockRoleProvider.
+type MockRoleProviderMockRecorder struct {
+	mock *MockRoleProvider
+}
+
+// NewMockRoleProvider creates a new mock instance.
+func NewMockRoleProvider(ctrl *gomock.Controller) *MockRoleProvider {
+	mock := &MockRoleProvider{ctrl: ctrl}
+	mock.recorder = &MockRoleProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRoleProvider) EXPECT() *MockRoleProviderMockRecorder {
+	return m.recorder
+}
+
+// GetRole mocks base method.
+func (m *MockRoleProvider) GetRole(ctx context.Context, uuid string) (model.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRole", ctx, uuid)
+	ret0, _ := ret[0].(model.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRole indicates an expected call of GetRole.
+func (mr *MockRoleProviderMockRecorder) GetRole(ctx, uuid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRole", reflect.TypeOf((*MockRoleProvider)(nil).GetRole), ctx, uuid)
+}
@@ -0,0 +1,50 @@
+package infra
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/s21platform/optionhub-service/internal/config"
+)
+
+// RequestIDInterceptor кладёт в контекст id запроса из метаданных x-request-id, а если его нет - генерирует новый
+func RequestIDInterceptor(
+	ctx context.Context,
+	req interface{},
+	_ *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	return handler(withRequestID(ctx), req)
+}
+
+func RequestIDStreamInterceptor(
+	srv interface{},
+	ss grpc.ServerStream,
+	_ *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	return handler(srv, withContext(ss, withRequestID(ss.Context())))
+}
+
+func withRequestID(ctx context.Context) context.Context {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	var requestID string
+	if ids := md.Get("x-request-id"); len(ids) > 0 {
+		requestID = ids[0]
+	} else {
+		requestID = newRequestID()
+	}
+
+	return context.WithValue(ctx, config.KeyRequestID, requestID)
+}
+
+func newRequestID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
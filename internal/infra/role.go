@@ -0,0 +1,32 @@
+package infra
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/metadata"
+
+	"github.com/s21platform/optionhub-service/internal/model"
+)
+
+// MetadataRoleProvider берёт роль из метаданных запроса, которые выставляет gateway.
+// Без роли в метаданных пользователь считается обычным
+type MetadataRoleProvider struct{}
+
+func NewMetadataRoleProvider() *MetadataRoleProvider {
+	return &MetadataRoleProvider{}
+}
+
+func (p *MetadataRoleProvider) GetRole(ctx context.Context, _ string) (model.Role, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	roles := md.Get("role")
+	switch len(roles) {
+	case 0:
+		return model.RoleUser, nil
+	case 1:
+		return model.ParseRole(roles[0])
+	default:
+		return "", fmt.Errorf("more than one role in metadata")
+	}
+}
@@ -0,0 +1,21 @@
+package infra
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+)
+
+// serverStream подменяет контекст стрима, чтобы интерсепторы могли передать значения дальше по цепочке
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+func withContext(ss grpc.ServerStream, ctx context.Context) grpc.ServerStream {
+	return &serverStream{ServerStream: ss, ctx: ctx}
+}
@@ -0,0 +1,35 @@
+package model
+
+import (
+	"time"
+
+	"github.com/s21platform/optionhub-service/pkg/optionhub"
+)
+
+// AttributeValueAlias - синоним значения ("СПб", "Питер" для "Санкт-Петербург"). Синонимы находятся поиском
+// и учитываются при проверке заявок на дубли, но наружу всегда отдаётся само значение
+type AttributeValueAlias struct {
+	ID          int64     `db:"id" json:"id"`
+	OptionID    int64     `db:"option_id" json:"option_id"`
+	AttributeID int64     `db:"attribute_id" json:"attribute_id"`
+	Alias       string    `db:"alias" json:"alias"`
+	CreatedAt   time.Time `db:"created_at" json:"-"`
+}
+
+func (a AttributeValueAlias) ToDTO() *optionhub.AttributeValueAlias {
+	return &optionhub.AttributeValueAlias{
+		AliasId:  a.ID,
+		OptionId: a.OptionID,
+		Alias:    a.Alias,
+	}
+}
+
+type AttributeValueAliasList []AttributeValueAlias
+
+func (l AttributeValueAliasList) ToDTO() []*optionhub.AttributeValueAlias {
+	result := make([]*optionhub.AttributeValueAlias, 0, len(l))
+	for _, alias := range l {
+		result = append(result, alias.ToDTO())
+	}
+	return result
+}
@@ -1,27 +1,91 @@
 package model
 
 import (
+	"time"
+
 	"github.com/samber/lo"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/s21platform/optionhub-service/pkg/optionhub"
 )
 
 type Attribute struct {
-	ID   int64  `db:"id"`
-	Name string `db:"name"`
+	ID        int64         `db:"id" json:"id"`
+	Name      string        `db:"name" json:"name"`
+	Type      AttributeType `db:"type" json:"type"`
+	CreatedAt time.Time     `db:"created_at" json:"created_at"`
+	Version   int64         `db:"version" json:"version"`
+
+	Translations Translations `db:"-" json:"-"`
+}
+
+// Localize заполняет переводы названия и подменяет его переводом по chain, если он есть
+func (a *Attribute) Localize(translations Translations, chain LocaleChain) {
+	a.Translations = translations
+	if name, ok := translations.Pick(chain); ok {
+		a.Name = name
+	}
+}
+
+func (a *Attribute) ToDTO() *optionhub.Attribute {
+	return &optionhub.Attribute{
+		AttributeId:  a.ID,
+		Name:         a.Name,
+		Type:         a.Type.ToDTO(),
+		CreatedAt:    timestamppb.New(a.CreatedAt),
+		Version:      a.Version,
+		Translations: a.Translations,
+	}
+}
+
+type AttributeList []Attribute
+
+func (a AttributeList) ToDTO() []*optionhub.Attribute {
+	result := make([]*optionhub.Attribute, 0, len(a))
+
+	for _, item := range a {
+		result = append(result, item.ToDTO())
+	}
+
+	return result
 }
 
 type AttributeValue struct {
-	Id          int64  `db:"id"`
-	AttributeId int64  `db:"attribute_id"`
-	Value       string `db:"value"`
-	ParentId    *int64 `db:"parent_id"`
+	Id          int64      `db:"id" json:"id"`
+	AttributeId int64      `db:"attribute_id" json:"attribute_id"`
+	Value       string     `db:"value" json:"value"`
+	ParentId    *int64     `db:"parent_id" json:"parent_id"`
+	DeletedAt   *time.Time `db:"deleted_at" json:"deleted_at"`
+	HasChildren bool       `db:"has_children" json:"-"`
+
+	Translations Translations `db:"-" json:"-"`
+}
+
+// AttributeValueUpdate описывает изменение значения атрибута; nil-поля не меняются
+type AttributeValueUpdate struct {
+	ID          int64
+	Value       *string
+	ParentId    *int64
+	ClearParent bool
+}
+
+// Apply возвращает значение с применёнными изменениями
+func (u AttributeValueUpdate) Apply(value AttributeValue) AttributeValue {
+	if u.Value != nil {
+		value.Value = *u.Value
+	}
+	if u.ClearParent {
+		value.ParentId = nil
+	} else if u.ParentId != nil {
+		value.ParentId = u.ParentId
+	}
+	return value
 }
 
 func (a *AttributeValue) ToDTO(in *optionhub.AddAttributeValueIn) (AttributeValue, error) {
 	result := AttributeValue{
 		AttributeId: in.AttributeId,
-		Value:       in.Value,
+		Value:       CleanValue(in.Value),
 		ParentId:    in.ParentId,
 	}
 	return result, nil
@@ -29,42 +93,105 @@ func (a *AttributeValue) ToDTO(in *optionhub.AddAttributeValueIn) (AttributeValu
 
 type AttributeValueList []AttributeValue
 
-func (a AttributeValueList) FromDTO() []*optionhub.Option {
+// Localize заполняет переводы значений и подменяет значения переводами по chain, если они есть
+func (a AttributeValueList) Localize(translations map[int64]Translations, chain LocaleChain) {
+	for i := range a {
+		a[i].Translations = translations[a[i].Id]
+		if value, ok := a[i].Translations.Pick(chain); ok {
+			a[i].Value = value
+		}
+	}
+}
+
+// Ids возвращает id значений
+func (a AttributeValueList) Ids() []int64 {
+	return lo.Map(a, func(val AttributeValue, _ int) int64 { return val.Id })
+}
+
+// FromDTO строит деревья значений; удалённые значения попадают в результат только при includeDeleted
+func (a AttributeValueList) FromDTO(includeDeleted bool) []*optionhub.Option {
 	result := make([]*optionhub.Option, 0)
 
-	roots := lo.Filter(a, func(val AttributeValue, _ int) bool {
+	visible := lo.Filter(a, func(val AttributeValue, _ int) bool {
+		return includeDeleted || val.DeletedAt == nil
+	})
+
+	roots := lo.Filter(visible, func(val AttributeValue, _ int) bool {
 		return val.ParentId == nil
 	})
 
 	//group children by parent_id
 	childrenMap := make(map[int64]AttributeValueList)
-	for _, val := range a {
+	for _, val := range visible {
 		if val.ParentId != nil {
 			childrenMap[*val.ParentId] = append(childrenMap[*val.ParentId], val)
 		}
 	}
 
+	visited := make(map[int64]bool)
 	for _, root := range roots {
-		rootNode := optionhub.Option{
-			OptionId:    root.Id,
-			OptionValue: root.Value,
-			Children:    buildTree(root.Id, childrenMap),
+		visited[root.Id] = true
+		rootNode := root.toOption()
+		rootNode.Children = buildTree(root.Id, childrenMap, visited)
+		result = append(result, rootNode)
+	}
+
+	return result
+}
+
+// SubtreeFromDTO строит дерево с корнем в значении rootId; возвращает nil, если корня нет в списке
+func (a AttributeValueList) SubtreeFromDTO(rootId int64) *optionhub.Option {
+	root, ok := lo.Find(a, func(val AttributeValue) bool {
+		return val.Id == rootId
+	})
+	if !ok {
+		return nil
+	}
+
+	childrenMap := make(map[int64]AttributeValueList)
+	for _, val := range a {
+		if val.ParentId != nil && val.Id != rootId {
+			childrenMap[*val.ParentId] = append(childrenMap[*val.ParentId], val)
 		}
-		result = append(result, &rootNode)
 	}
 
+	rootNode := root.toOption()
+	rootNode.Children = buildTree(root.Id, childrenMap, map[int64]bool{root.Id: true})
+
+	return rootNode
+}
+
+// FlatFromDTO возвращает значения списком, без вложенных потомков
+func (a AttributeValueList) FlatFromDTO() []*optionhub.Option {
+	result := make([]*optionhub.Option, 0, len(a))
+	for _, val := range a {
+		result = append(result, val.toOption())
+	}
 	return result
 }
 
-func buildTree(parentId int64, children map[int64]AttributeValueList) []*optionhub.Option {
+func (a AttributeValue) toOption() *optionhub.Option {
+	return &optionhub.Option{
+		OptionId:     a.Id,
+		OptionValue:  a.Value,
+		Children:     make([]*optionhub.Option, 0),
+		IsDeleted:    a.DeletedAt != nil,
+		HasChildren:  a.HasChildren,
+		Translations: a.Translations,
+	}
+}
+
+// visited защищает от бесконечной рекурсии, если в данных всё же оказался цикл
+func buildTree(parentId int64, children map[int64]AttributeValueList, visited map[int64]bool) []*optionhub.Option {
 	result := make([]*optionhub.Option, 0)
 	for _, child := range children[parentId] {
-		node := optionhub.Option{
-			OptionId:    child.Id,
-			OptionValue: child.Value,
-			Children:    buildTree(child.Id, children),
+		if visited[child.Id] {
+			continue
 		}
-		result = append(result, &node)
+		visited[child.Id] = true
+		node := child.toOption()
+		node.Children = buildTree(child.Id, children, visited)
+		result = append(result, node)
 	}
 	return result
 }
@@ -0,0 +1,97 @@
+package model
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/s21platform/optionhub-service/pkg/optionhub"
+)
+
+type AttributeType string
+
+const (
+	AttributeTypeEnum        AttributeType = "enum"
+	AttributeTypeTree        AttributeType = "tree"
+	AttributeTypeFreeText    AttributeType = "free_text"
+	AttributeTypeNumberRange AttributeType = "number_range"
+	AttributeTypeDate        AttributeType = "date"
+	AttributeTypeBoolean     AttributeType = "boolean"
+)
+
+const dateLayout = "2006-01-02"
+
+// число или диапазон чисел: "42", "18-25", "-5 - 10", "1.5-2.5"
+var numberRangeRe = regexp.MustCompile(`^\s*(-?\d+(?:\.\d+)?)\s*(?:-\s*(-?\d+(?:\.\d+)?))?\s*$`)
+
+var attributeTypeToDTO = map[AttributeType]optionhub.AttributeType{
+	AttributeTypeEnum:        optionhub.AttributeType_ATTRIBUTE_TYPE_ENUM,
+	AttributeTypeTree:        optionhub.AttributeType_ATTRIBUTE_TYPE_TREE,
+	AttributeTypeFreeText:    optionhub.AttributeType_ATTRIBUTE_TYPE_FREE_TEXT,
+	AttributeTypeNumberRange: optionhub.AttributeType_ATTRIBUTE_TYPE_NUMBER_RANGE,
+	AttributeTypeDate:        optionhub.AttributeType_ATTRIBUTE_TYPE_DATE,
+	AttributeTypeBoolean:     optionhub.AttributeType_ATTRIBUTE_TYPE_BOOLEAN,
+}
+
+func AttributeTypeFromDTO(in optionhub.AttributeType) (AttributeType, error) {
+	for t, dto := range attributeTypeToDTO {
+		if dto == in {
+			return t, nil
+		}
+	}
+	return "", fmt.Errorf("unknown attribute type: %s", in)
+}
+
+func (t AttributeType) ToDTO() optionhub.AttributeType {
+	return attributeTypeToDTO[t]
+}
+
+// ValidateValue проверяет, что значение подходит под тип атрибута
+func (t AttributeType) ValidateValue(in AttributeValue) error {
+	if in.Value == "" {
+		return fmt.Errorf("value is empty")
+	}
+
+	if t != AttributeTypeTree && in.ParentId != nil {
+		return fmt.Errorf("attribute of type %s does not support parent_id", t)
+	}
+
+	switch t {
+	case AttributeTypeEnum, AttributeTypeTree, AttributeTypeFreeText:
+		return nil
+	case AttributeTypeNumberRange:
+		return validateNumberRange(in.Value)
+	case AttributeTypeDate:
+		if _, err := time.Parse(dateLayout, in.Value); err != nil {
+			return fmt.Errorf("value %q is not a date in YYYY-MM-DD format", in.Value)
+		}
+		return nil
+	case AttributeTypeBoolean:
+		if in.Value != "true" && in.Value != "false" {
+			return fmt.Errorf("value %q is not a boolean", in.Value)
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown attribute type: %s", t)
+	}
+}
+
+func validateNumberRange(value string) error {
+	matches := numberRangeRe.FindStringSubmatch(value)
+	if matches == nil {
+		return fmt.Errorf("value %q is not a number or a range of numbers", value)
+	}
+
+	if matches[2] == "" {
+		return nil
+	}
+
+	from, _ := strconv.ParseFloat(matches[1], 64)
+	to, _ := strconv.ParseFloat(matches[2], 64)
+	if from > to {
+		return fmt.Errorf("range %q has lower bound greater than upper bound", value)
+	}
+
+	return nil
+}
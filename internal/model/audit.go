@@ -0,0 +1,181 @@
+package model
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/s21platform/optionhub-service/pkg/optionhub"
+)
+
+const (
+	defaultAuditLogPageSize = 50
+	maxAuditLogPageSize     = 500
+)
+
+type AuditEntity string
+
+const (
+	AuditEntityAttribute      AuditEntity = "attribute"
+	AuditEntityAttributeValue AuditEntity = "attribute_value"
+	AuditEntityOptionRequest  AuditEntity = "option_request"
+)
+
+var auditEntityToDTO = map[AuditEntity]optionhub.AuditEntityType{
+	AuditEntityAttribute:      optionhub.AuditEntityType_AUDIT_ENTITY_TYPE_ATTRIBUTE,
+	AuditEntityAttributeValue: optionhub.AuditEntityType_AUDIT_ENTITY_TYPE_ATTRIBUTE_VALUE,
+	AuditEntityOptionRequest:  optionhub.AuditEntityType_AUDIT_ENTITY_TYPE_OPTION_REQUEST,
+}
+
+func AuditEntityFromDTO(in optionhub.AuditEntityType) (AuditEntity, error) {
+	for entity, dto := range auditEntityToDTO {
+		if dto == in {
+			return entity, nil
+		}
+	}
+	return "", fmt.Errorf("unknown audit entity type: %s", in)
+}
+
+func (e AuditEntity) ToDTO() optionhub.AuditEntityType {
+	return auditEntityToDTO[e]
+}
+
+// AuditRecord - запись об изменении каталога; Before и After хранят сущность в JSON до и после изменения
+type AuditRecord struct {
+	ID         int64       `db:"id"`
+	ActorUuid  string      `db:"actor_uuid"`
+	Method     string      `db:"method"`
+	EntityType AuditEntity `db:"entity_type"`
+	EntityID   int64       `db:"entity_id"`
+	Before     []byte      `db:"before"`
+	After      []byte      `db:"after"`
+	RequestID  string      `db:"request_id"`
+	CreatedAt  time.Time   `db:"created_at"`
+}
+
+// NewAuditRecord сериализует состояния сущности; nil означает, что сущности до или после изменения нет
+func NewAuditRecord(method string, entity AuditEntity, id int64, before, after any) (AuditRecord, error) {
+	record := AuditRecord{
+		Method:     method,
+		EntityType: entity,
+		EntityID:   id,
+	}
+
+	var err error
+	if before != nil {
+		record.Before, err = json.Marshal(before)
+		if err != nil {
+			return AuditRecord{}, fmt.Errorf("failed to marshal entity before change: %v", err)
+		}
+	}
+	if after != nil {
+		record.After, err = json.Marshal(after)
+		if err != nil {
+			return AuditRecord{}, fmt.Errorf("failed to marshal entity after change: %v", err)
+		}
+	}
+
+	return record, nil
+}
+
+type AuditRecordList []AuditRecord
+
+func (l AuditRecordList) ToDTO() []*optionhub.AuditRecord {
+	result := make([]*optionhub.AuditRecord, 0, len(l))
+
+	for _, record := range l {
+		result = append(result, &optionhub.AuditRecord{
+			AuditRecordId: record.ID,
+			ActorUuid:     record.ActorUuid,
+			Method:        record.Method,
+			EntityType:    record.EntityType.ToDTO(),
+			EntityId:      record.EntityID,
+			Before:        string(record.Before),
+			After:         string(record.After),
+			RequestId:     record.RequestID,
+			CreatedAt:     timestamppb.New(record.CreatedAt),
+		})
+	}
+
+	return result
+}
+
+type AuditLogFilter struct {
+	EntityType  *AuditEntity
+	EntityID    *int64
+	ActorUuid   *string
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+	PageSize    uint64
+	BeforeID    *int64
+}
+
+func NewAuditLogFilter(in *optionhub.GetAuditLogIn) (AuditLogFilter, error) {
+	filter := AuditLogFilter{
+		EntityID:  in.EntityId,
+		ActorUuid: in.ActorUuid,
+		PageSize:  defaultAuditLogPageSize,
+	}
+
+	if in.EntityType != optionhub.AuditEntityType_AUDIT_ENTITY_TYPE_UNSPECIFIED {
+		entity, err := AuditEntityFromDTO(in.EntityType)
+		if err != nil {
+			return AuditLogFilter{}, err
+		}
+		filter.EntityType = &entity
+	}
+	if filter.EntityID != nil && filter.EntityType == nil {
+		return AuditLogFilter{}, fmt.Errorf("entity_id requires entity_type")
+	}
+
+	if in.CreatedFrom != nil {
+		createdFrom := in.CreatedFrom.AsTime()
+		filter.CreatedFrom = &createdFrom
+	}
+	if in.CreatedTo != nil {
+		createdTo := in.CreatedTo.AsTime()
+		filter.CreatedTo = &createdTo
+	}
+
+	switch {
+	case in.PageSize < 0:
+		return AuditLogFilter{}, fmt.Errorf("page size must not be negative")
+	case in.PageSize > maxAuditLogPageSize:
+		filter.PageSize = maxAuditLogPageSize
+	case in.PageSize > 0:
+		filter.PageSize = uint64(in.PageSize)
+	}
+
+	if in.PageToken != "" {
+		id, err := decodeAuditLogPageToken(in.PageToken)
+		if err != nil {
+			return AuditLogFilter{}, err
+		}
+		filter.BeforeID = &id
+	}
+
+	return filter, nil
+}
+
+// AuditLogPageToken указывает на последнюю выданную запись; записи отдаются от новых к старым
+func AuditLogPageToken(last AuditRecord) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(last.ID, 10)))
+}
+
+func decodeAuditLogPageToken(token string) (int64, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, fmt.Errorf("malformed page token: %v", err)
+	}
+
+	id, err := strconv.ParseInt(string(data), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("malformed page token: %v", err)
+	}
+
+	return id, nil
+}
@@ -0,0 +1,11 @@
+package model
+
+import "time"
+
+// DeadLetter - сообщение, которое не удалось обработать; отправляется в dead-letter топик вместе с причиной
+type DeadLetter struct {
+	Topic    string    `json:"topic"`
+	Payload  string    `json:"payload"`
+	Error    string    `json:"error"`
+	FailedAt time.Time `json:"failed_at"`
+}
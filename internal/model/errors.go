@@ -0,0 +1,40 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrNotFound возвращается репозиторием, когда запрошенная запись отсутствует
+var ErrNotFound = errors.New("not found")
+
+// ErrInvalidStatusTransition возвращается при попытке перевести заявку в недопустимый статус
+var ErrInvalidStatusTransition = errors.New("invalid status transition")
+
+// ErrAlreadyExists возвращается, когда создаваемая запись дублирует существующую
+var ErrAlreadyExists = errors.New("already exists")
+
+// ErrHasChildren возвращается при удалении значения, у которого остались неудалённые потомки
+var ErrHasChildren = errors.New("value has children")
+
+// ErrHasValues возвращается при удалении атрибута, у которого есть значения, в том числе удалённые
+var ErrHasValues = errors.New("attribute has values")
+
+// ErrParentAttributeMismatch возвращается, когда родитель относится к другому атрибуту
+var ErrParentAttributeMismatch = errors.New("parent belongs to another attribute")
+
+// ErrParentCycle возвращается, когда новый родитель создал бы цикл в дереве значений
+var ErrParentCycle = errors.New("parent would create a cycle")
+
+// DuplicateValueError возвращается, когда значение после NormalizeValue совпадает с неудалённым значением того же родителя
+type DuplicateValueError struct {
+	Existing AttributeValue
+}
+
+func (e *DuplicateValueError) Error() string {
+	return fmt.Sprintf("value %q already exists as option %d", e.Existing.Value, e.Existing.Id)
+}
+
+func (e *DuplicateValueError) Unwrap() error {
+	return ErrAlreadyExists
+}
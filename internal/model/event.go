@@ -0,0 +1,17 @@
+package model
+
+import (
+	"strconv"
+
+	"github.com/s21platform/optionhub-service/pkg/optionhub"
+)
+
+// CatalogEventVersion - версия схемы CatalogEvent, увеличивается при несовместимых изменениях
+const CatalogEventVersion = 1
+
+// NewCatalogEventMessage готовит событие к записи в outbox. Ключ партиционирования - attribute_id,
+// поэтому события одного атрибута приходят потребителям по порядку
+func NewCatalogEventMessage(event *optionhub.CatalogEvent) (OutboxMessage, error) {
+	event.Version = CatalogEventVersion
+	return NewProtoOutboxMessage(OutboxTopicCatalogEvents, strconv.FormatInt(event.AttributeId, 10), event)
+}
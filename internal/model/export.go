@@ -0,0 +1,162 @@
+package model
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/s21platform/optionhub-service/pkg/optionhub"
+)
+
+var exportCSVHeader = []string{"attribute_id", "attribute", "type", "option_id", importPathColumn, "deleted"}
+
+// Catalog - снимок всех атрибутов с деревьями значений. Атрибуты отсортированы по названию, значения - по тексту,
+// при совпадении - по id, поэтому выгрузка одного каталога всегда побайтно одинаковая
+type Catalog struct {
+	Attributes []CatalogAttribute `json:"attributes" yaml:"attributes"`
+}
+
+type CatalogAttribute struct {
+	ID     int64          `json:"id" yaml:"id"`
+	Name   string         `json:"name" yaml:"name"`
+	Type   AttributeType  `json:"type" yaml:"type"`
+	Values []CatalogValue `json:"values" yaml:"values"`
+}
+
+// CatalogValue совместимо с форматом ParseImportJSON: values атрибута можно импортировать обратно
+type CatalogValue struct {
+	ID       int64          `json:"id" yaml:"id"`
+	Value    string         `json:"value" yaml:"value"`
+	Deleted  bool           `json:"deleted,omitempty" yaml:"deleted,omitempty"`
+	Children []CatalogValue `json:"children,omitempty" yaml:"children,omitempty"`
+}
+
+// NewCatalog строит деревья значений каждого атрибута через FromDTO
+func NewCatalog(attributes AttributeList, values AttributeValueList, includeDeleted bool) Catalog {
+	byAttribute := make(map[int64]AttributeValueList)
+	for _, value := range values {
+		byAttribute[value.AttributeId] = append(byAttribute[value.AttributeId], value)
+	}
+
+	result := make([]CatalogAttribute, 0, len(attributes))
+	for _, attribute := range attributes {
+		result = append(result, CatalogAttribute{
+			ID:     attribute.ID,
+			Name:   attribute.Name,
+			Type:   attribute.Type,
+			Values: newCatalogValues(byAttribute[attribute.ID].FromDTO(includeDeleted)),
+		})
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Name != result[j].Name {
+			return result[i].Name < result[j].Name
+		}
+		return result[i].ID < result[j].ID
+	})
+
+	return Catalog{Attributes: result}
+}
+
+func newCatalogValues(options []*optionhub.Option) []CatalogValue {
+	result := make([]CatalogValue, 0, len(options))
+	for _, option := range options {
+		result = append(result, CatalogValue{
+			ID:       option.OptionId,
+			Value:    option.OptionValue,
+			Deleted:  option.IsDeleted,
+			Children: newCatalogValues(option.Children),
+		})
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Value != result[j].Value {
+			return result[i].Value < result[j].Value
+		}
+		return result[i].ID < result[j].ID
+	})
+
+	return result
+}
+
+func (c Catalog) JSON() ([]byte, error) {
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal json: %v", err)
+	}
+	return append(data, '\n'), nil
+}
+
+func (c Catalog) YAML() ([]byte, error) {
+	var buf bytes.Buffer
+
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+
+	err := encoder.Encode(c)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal yaml: %v", err)
+	}
+
+	err = encoder.Close()
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal yaml: %v", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// CSV пишет по строке на значение, родители идут раньше потомков. Колонка path совместима с ParseImportCSV
+func (c Catalog) CSV() ([]byte, error) {
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+
+	err := writer.Write(exportCSVHeader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to write csv: %v", err)
+	}
+
+	for _, attribute := range c.Attributes {
+		err = writeCatalogCSV(writer, attribute, attribute.Values, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	writer.Flush()
+	err = writer.Error()
+	if err != nil {
+		return nil, fmt.Errorf("failed to write csv: %v", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+func writeCatalogCSV(writer *csv.Writer, attribute CatalogAttribute, values []CatalogValue, path []string) error {
+	for _, value := range values {
+		valuePath := append(append([]string{}, path...), value.Value)
+
+		err := writer.Write([]string{
+			strconv.FormatInt(attribute.ID, 10),
+			attribute.Name,
+			string(attribute.Type),
+			strconv.FormatInt(value.ID, 10),
+			JoinImportPath(valuePath),
+			strconv.FormatBool(value.Deleted),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to write csv: %v", err)
+		}
+
+		err = writeCatalogCSV(writer, attribute, value.Children, valuePath)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
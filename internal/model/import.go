@@ -0,0 +1,375 @@
+package model
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/samber/lo"
+
+	"github.com/s21platform/optionhub-service/pkg/optionhub"
+)
+
+const (
+	importPathColumn    = "path"
+	importPathSeparator = '/'
+	importPathEscape    = '\\'
+)
+
+var utf8BOM = []byte("\xef\xbb\xbf")
+
+// ImportNode - значение из файла импорта с потомками. Одинаковые значения одного родителя склеиваются
+type ImportNode struct {
+	Value    string
+	Children ImportNodeList
+}
+
+type ImportNodeList []*ImportNode
+
+// Count возвращает число значений во всех деревьях
+func (l ImportNodeList) Count() int {
+	count := 0
+	for _, node := range l {
+		count += 1 + node.Children.Count()
+	}
+	return count
+}
+
+func (l ImportNodeList) child(value string) (ImportNodeList, *ImportNode) {
+	key := importKey(value)
+	if node, ok := lo.Find(l, func(n *ImportNode) bool { return importKey(n.Value) == key }); ok {
+		return l, node
+	}
+
+	node := &ImportNode{Value: value}
+	return append(l, node), node
+}
+
+func importKey(value string) string {
+	return NormalizeValue(value)
+}
+
+// JoinImportPath собирает путь для колонки path: значения разделяются "/", а "/" и "\" внутри значений
+// экранируются обратной косой чертой
+func JoinImportPath(path []string) string {
+	var b strings.Builder
+	for i, value := range path {
+		if i > 0 {
+			b.WriteRune(importPathSeparator)
+		}
+		for _, r := range value {
+			if r == importPathSeparator || r == importPathEscape {
+				b.WriteRune(importPathEscape)
+			}
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// SplitImportPath разбирает путь, собранный JoinImportPath
+func SplitImportPath(path string) ([]string, error) {
+	segments := make([]string, 0)
+
+	var b strings.Builder
+	escaped := false
+	for _, r := range path {
+		switch {
+		case escaped:
+			if r != importPathSeparator && r != importPathEscape {
+				return nil, fmt.Errorf("invalid escape \\%c in path %q", r, path)
+			}
+			b.WriteRune(r)
+			escaped = false
+		case r == importPathEscape:
+			escaped = true
+		case r == importPathSeparator:
+			segments = append(segments, b.String())
+			b.Reset()
+		default:
+			b.WriteRune(r)
+		}
+	}
+	if escaped {
+		return nil, fmt.Errorf("path %q ends with an escape", path)
+	}
+
+	return append(segments, b.String()), nil
+}
+
+// ParseImportCSV читает csv с заголовком. Колонка path содержит путь от корня в формате JoinImportPath
+func ParseImportCSV(data []byte) (ImportNodeList, error) {
+	reader := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(data, utf8BOM)))
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("csv is empty")
+		}
+		return nil, fmt.Errorf("failed to read csv header: %v", err)
+	}
+
+	column := lo.IndexOf(lo.Map(header, func(h string, _ int) string { return importKey(h) }), importPathColumn)
+	if column < 0 {
+		return nil, fmt.Errorf("csv has no %s column", importPathColumn)
+	}
+
+	roots := make(ImportNodeList, 0)
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read csv: %v", err)
+		}
+
+		line, _ := reader.FieldPos(0)
+		if column >= len(record) {
+			return nil, fmt.Errorf("line %d: no %s column", line, importPathColumn)
+		}
+
+		segments, err := SplitImportPath(record[column])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %v", line, err)
+		}
+
+		level := &roots
+		for _, segment := range segments {
+			value := CleanValue(segment)
+			if value == "" {
+				return nil, fmt.Errorf("line %d: empty value in path %q", line, record[column])
+			}
+
+			var node *ImportNode
+			*level, node = level.child(value)
+			level = &node.Children
+		}
+	}
+
+	return roots, nil
+}
+
+type importJSONNode struct {
+	Value    string           `json:"value"`
+	Children []importJSONNode `json:"children,omitempty"`
+}
+
+// ParseImportJSON читает массив деревьев вида {"value": "...", "children": [...]}
+func ParseImportJSON(data []byte) (ImportNodeList, error) {
+	var nodes []importJSONNode
+	err := json.Unmarshal(bytes.TrimPrefix(data, utf8BOM), &nodes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse json: %v", err)
+	}
+
+	roots := make(ImportNodeList, 0)
+	err = mergeImportJSON(&roots, nodes, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return roots, nil
+}
+
+// JSON возвращает деревья в формате ParseImportJSON
+func (l ImportNodeList) JSON() ([]byte, error) {
+	data, err := json.Marshal(toImportJSON(l))
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal json: %v", err)
+	}
+	return data, nil
+}
+
+func toImportJSON(nodes ImportNodeList) []importJSONNode {
+	result := make([]importJSONNode, 0, len(nodes))
+	for _, node := range nodes {
+		result = append(result, importJSONNode{
+			Value:    node.Value,
+			Children: toImportJSON(node.Children),
+		})
+	}
+	return result
+}
+
+func mergeImportJSON(level *ImportNodeList, nodes []importJSONNode, path []string) error {
+	for _, n := range nodes {
+		value := CleanValue(n.Value)
+		if value == "" {
+			return fmt.Errorf("empty value under %q", JoinImportPath(path))
+		}
+
+		var node *ImportNode
+		*level, node = level.child(value)
+
+		err := mergeImportJSON(&node.Children, n.Children, append(path, value))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type ImportStatus string
+
+const (
+	ImportStatusCreated  ImportStatus = "created"
+	ImportStatusSkipped  ImportStatus = "skipped"
+	ImportStatusConflict ImportStatus = "conflict"
+)
+
+var importStatusToDTO = map[ImportStatus]optionhub.ImportRowStatus{
+	ImportStatusCreated:  optionhub.ImportRowStatus_IMPORT_ROW_STATUS_CREATED,
+	ImportStatusSkipped:  optionhub.ImportRowStatus_IMPORT_ROW_STATUS_SKIPPED,
+	ImportStatusConflict: optionhub.ImportRowStatus_IMPORT_ROW_STATUS_CONFLICT,
+}
+
+func (s ImportStatus) ToDTO() optionhub.ImportRowStatus {
+	return importStatusToDTO[s]
+}
+
+// ImportStep - решение по одному значению импорта. OptionID заполняется для существующих значений,
+// а для новых - после их создания
+type ImportStep struct {
+	Path     []string
+	Value    string
+	Parent   *ImportStep
+	Status   ImportStatus
+	OptionID int64
+	Message  string
+}
+
+// ParentID возвращает id родителя; для нового родителя он известен только после его создания
+func (s *ImportStep) ParentID() *int64 {
+	if s.Parent == nil {
+		return nil
+	}
+	return lo.ToPtr(s.Parent.OptionID)
+}
+
+// ImportPlan - шаги импорта, родители идут раньше потомков
+type ImportPlan []*ImportStep
+
+type importIndexKey struct {
+	parentId int64
+	value    string
+}
+
+// PlanImport сопоставляет деревья импорта с существующими значениями атрибута (включая удалённые).
+// Совпадение ищется без учёта регистра среди потомков того же родителя. Новое значение, совпавшее с синонимом
+// из aliases, не создаётся: синоним занимает значение во всём атрибуте
+func PlanImport(roots ImportNodeList, existing AttributeValueList, aliases AttributeValueAliasList, attributeType AttributeType) ImportPlan {
+	index := make(map[importIndexKey]AttributeValueList)
+	for _, value := range existing {
+		key := importIndexKey{parentId: lo.FromPtr(value.ParentId), value: importKey(value.Value)}
+		index[key] = append(index[key], value)
+	}
+	aliasIndex := lo.KeyBy(aliases, func(a AttributeValueAlias) string { return importKey(a.Alias) })
+
+	plan := make(ImportPlan, 0)
+
+	var walk func(nodes ImportNodeList, parent *ImportStep, path []string)
+	walk = func(nodes ImportNodeList, parent *ImportStep, path []string) {
+		for _, node := range nodes {
+			step := &ImportStep{
+				Path:   append(append([]string{}, path...), node.Value),
+				Value:  node.Value,
+				Parent: parent,
+			}
+			planImportStep(step, index, aliasIndex, attributeType)
+			plan = append(plan, step)
+
+			walk(node.Children, step, step.Path)
+		}
+	}
+	walk(roots, nil, nil)
+
+	return plan
+}
+
+func planImportStep(step *ImportStep, index map[importIndexKey]AttributeValueList, aliasIndex map[string]AttributeValueAlias, attributeType AttributeType) {
+	if step.Parent != nil {
+		switch step.Parent.Status {
+		case ImportStatusConflict:
+			step.Status = ImportStatusConflict
+			step.Message = "parent value is not imported"
+			return
+		case ImportStatusCreated:
+			planImportCreate(step, aliasIndex, attributeType)
+			return
+		}
+	}
+
+	var parentId int64
+	if step.Parent != nil {
+		parentId = step.Parent.OptionID
+	}
+
+	matches := index[importIndexKey{parentId: parentId, value: importKey(step.Value)}]
+	alive := lo.Filter(matches, func(v AttributeValue, _ int) bool { return v.DeletedAt == nil })
+
+	switch {
+	case len(alive) == 1:
+		step.Status = ImportStatusSkipped
+		step.OptionID = alive[0].Id
+	case len(alive) > 1:
+		step.Status = ImportStatusConflict
+		step.Message = fmt.Sprintf("value matches %d existing values", len(alive))
+	case len(matches) > 0:
+		step.Status = ImportStatusConflict
+		step.Message = fmt.Sprintf("value was deleted, option %d", matches[0].Id)
+	default:
+		planImportCreate(step, aliasIndex, attributeType)
+	}
+}
+
+func planImportCreate(step *ImportStep, aliasIndex map[string]AttributeValueAlias, attributeType AttributeType) {
+	if alias, ok := aliasIndex[importKey(step.Value)]; ok {
+		step.Status = ImportStatusConflict
+		step.Message = fmt.Sprintf("value matches alias %q of option %d", alias.Alias, alias.OptionID)
+		return
+	}
+
+	value := AttributeValue{Value: step.Value}
+	if step.Parent != nil {
+		value.ParentId = lo.ToPtr(step.Parent.OptionID)
+	}
+
+	err := attributeType.ValidateValue(value)
+	if err != nil {
+		step.Status = ImportStatusConflict
+		step.Message = err.Error()
+		return
+	}
+
+	step.Status = ImportStatusCreated
+}
+
+// Count возвращает число шагов со статусом status
+func (p ImportPlan) Count(status ImportStatus) int {
+	return lo.CountBy(p, func(s *ImportStep) bool { return s.Status == status })
+}
+
+func (p ImportPlan) ToDTO() *optionhub.ImportAttributeValuesOut {
+	rows := make([]*optionhub.ImportRow, 0, len(p))
+	for _, step := range p {
+		rows = append(rows, &optionhub.ImportRow{
+			Path:     step.Path,
+			Status:   step.Status.ToDTO(),
+			OptionId: step.OptionID,
+			Message:  step.Message,
+		})
+	}
+
+	return &optionhub.ImportAttributeValuesOut{
+		Rows:      rows,
+		Created:   int32(p.Count(ImportStatusCreated)),
+		Skipped:   int32(p.Count(ImportStatusSkipped)),
+		Conflicts: int32(p.Count(ImportStatusConflict)),
+	}
+}
@@ -0,0 +1,95 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImportPath(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		path []string
+		raw  string
+	}{
+		{
+			name: "plain",
+			path: []string{"Россия", "Москва", "Курьяново"},
+			raw:  "Россия/Москва/Курьяново",
+		},
+		{
+			name: "slash",
+			path: []string{"Размер", "1/2"},
+			raw:  `Размер/1\/2`,
+		},
+		{
+			name: "backslash",
+			path: []string{`C:\`, "Windows"},
+			raw:  `C:\\/Windows`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.raw, JoinImportPath(tt.path))
+
+			path, err := SplitImportPath(tt.raw)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.path, path)
+		})
+	}
+
+	t.Run("invalid_escape", func(t *testing.T) {
+		_, err := SplitImportPath(`Россия\Москва`)
+
+		assert.ErrorContains(t, err, "invalid escape")
+	})
+
+	t.Run("trailing_escape", func(t *testing.T) {
+		_, err := SplitImportPath(`Россия\`)
+
+		assert.ErrorContains(t, err, "ends with an escape")
+	})
+}
+
+func TestParseImportCSV(t *testing.T) {
+	t.Parallel()
+
+	t.Run("catalog_round_trip", func(t *testing.T) {
+		catalog := Catalog{Attributes: []CatalogAttribute{{
+			ID:   1,
+			Name: "Город",
+			Type: AttributeTypeTree,
+			Values: []CatalogValue{{
+				ID:    10,
+				Value: "Россия",
+				Children: []CatalogValue{
+					{ID: 11, Value: "Ханты-Мансийск/Югра"},
+					{ID: 12, Value: "Москва"},
+				},
+			}},
+		}}}
+
+		data, err := catalog.CSV()
+		require.NoError(t, err)
+
+		nodes, err := ParseImportCSV(data)
+
+		require.NoError(t, err)
+		require.Len(t, nodes, 1)
+		assert.Equal(t, "Россия", nodes[0].Value)
+		require.Len(t, nodes[0].Children, 2)
+		assert.Equal(t, "Ханты-Мансийск/Югра", nodes[0].Children[0].Value)
+		assert.Equal(t, "Москва", nodes[0].Children[1].Value)
+	})
+
+	t.Run("invalid_path", func(t *testing.T) {
+		_, err := ParseImportCSV([]byte("path\nРоссия\\Москва\n"))
+
+		assert.ErrorContains(t, err, "line 2")
+	})
+}
@@ -0,0 +1,14 @@
+package model
+
+// ValueRedirect - id значения, слитого в TargetID. Редирект всегда ведёт на неслитое значение
+type ValueRedirect struct {
+	SourceID int64 `db:"source_id"`
+	TargetID int64 `db:"target_id"`
+}
+
+// AttributeValueMerge - результат слияния Source в Target, пишется в журнал аудита
+type AttributeValueMerge struct {
+	Source        AttributeValue `json:"source"`
+	Target        AttributeValue `json:"target"`
+	MovedChildIds []int64        `json:"moved_child_ids"`
+}
@@ -0,0 +1,20 @@
+package model
+
+import (
+	"strings"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/unicode/norm"
+)
+
+// CleanValue убирает пробелы по краям, схлопывает пробельные символы в один пробел и приводит текст к NFC.
+// В таком виде значения хранятся
+func CleanValue(value string) string {
+	return norm.NFC.String(strings.Join(strings.Fields(value), " "))
+}
+
+// NormalizeValue - ключ для поиска дублей: CleanValue без учёта регистра (case folding).
+// По нему построен уникальный индекс attribute_values_normalized_uniq
+func NormalizeValue(value string) string {
+	return cases.Fold().String(CleanValue(value))
+}
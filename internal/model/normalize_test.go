@@ -0,0 +1,58 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCleanValue(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		value string
+		want  string
+	}{
+		{name: "trim", value: "  Москва \t", want: "Москва"},
+		{name: "collapse_spaces", value: "Санкт   -\tПетербург\n", want: "Санкт - Петербург"},
+		{name: "nbsp", value: "Нижний\u00a0Новгород", want: "Нижний Новгород"},
+		{name: "nfc", value: "Е\u0308лки", want: "Ёлки"},
+		{name: "case_kept", value: "GoLang", want: "GoLang"},
+		{name: "empty", value: " \t\n", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, CleanValue(tt.value))
+		})
+	}
+}
+
+func TestNormalizeValue(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		a    string
+		b    string
+	}{
+		{name: "case", a: "Москва", b: "МОСКВА"},
+		{name: "spaces", a: " санкт  петербург", b: "Санкт Петербург "},
+		{name: "nfc", a: "Е\u0308лки", b: "ёлки"},
+		{name: "sharp_s", a: "Straße", b: "STRASSE"},
+		{name: "final_sigma", a: "ΟΔΟΣ", b: "οδος"},
+		{name: "ligature", a: "ﬁle", b: "FILE"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, NormalizeValue(tt.a), NormalizeValue(tt.b))
+		})
+	}
+
+	t.Run("different", func(t *testing.T) {
+		assert.NotEqual(t, NormalizeValue("Москва"), NormalizeValue("Москва-Сити"))
+		assert.NotEqual(t, NormalizeValue("ёлки"), NormalizeValue("елки"))
+	})
+}
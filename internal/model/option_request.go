@@ -1,19 +1,75 @@
 package model
 
 import (
+	"fmt"
 	"time"
 
+	"github.com/samber/lo"
+
 	"github.com/s21platform/optionhub-service/pkg/optionhub"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+type OptionRequestStatus string
+
+const (
+	OptionRequestStatusPending  OptionRequestStatus = "pending"
+	OptionRequestStatusApproved OptionRequestStatus = "approved"
+	OptionRequestStatusRejected OptionRequestStatus = "rejected"
+	OptionRequestStatusMerged   OptionRequestStatus = "merged"
+)
+
+// допустимые переходы между статусами заявки
+var optionRequestTransitions = map[OptionRequestStatus][]OptionRequestStatus{
+	OptionRequestStatusPending: {
+		OptionRequestStatusApproved,
+		OptionRequestStatusRejected,
+		OptionRequestStatusMerged,
+	},
+}
+
+var optionRequestStatusToDTO = map[OptionRequestStatus]optionhub.OptionRequestStatus{
+	OptionRequestStatusPending:  optionhub.OptionRequestStatus_OPTION_REQUEST_STATUS_PENDING,
+	OptionRequestStatusApproved: optionhub.OptionRequestStatus_OPTION_REQUEST_STATUS_APPROVED,
+	OptionRequestStatusRejected: optionhub.OptionRequestStatus_OPTION_REQUEST_STATUS_REJECTED,
+	OptionRequestStatusMerged:   optionhub.OptionRequestStatus_OPTION_REQUEST_STATUS_MERGED,
+}
+
+func (s OptionRequestStatus) CanTransitionTo(next OptionRequestStatus) bool {
+	return lo.Contains(optionRequestTransitions[s], next)
+}
+
+func OptionRequestStatusFromDTO(in optionhub.OptionRequestStatus) (OptionRequestStatus, error) {
+	for status, dto := range optionRequestStatusToDTO {
+		if dto == in {
+			return status, nil
+		}
+	}
+	return "", fmt.Errorf("unknown option request status: %s", in)
+}
+
+func (s OptionRequestStatus) ToDTO() optionhub.OptionRequestStatus {
+	return optionRequestStatusToDTO[s]
+}
+
 type OptionRequest struct {
-	ID             int64 `db:"id"`
-	AttributeID    int64 `db:"attribute_id"`
-	AttributeValue string
-	Value          string    `db:"value"`
-	UserUuid       string    `db:"user_uuid"`
-	CreatedAt      time.Time `db:"created_at"`
+	ID             int64               `db:"id" json:"id"`
+	AttributeID    int64               `db:"attribute_id" json:"attribute_id"`
+	AttributeValue string              `json:"-"`
+	Value          string              `db:"value" json:"value"`
+	ParentId       *int64              `db:"parent_id" json:"parent_id"`
+	UserUuid       string              `db:"user_uuid" json:"user_uuid"`
+	Status         OptionRequestStatus `db:"status" json:"status"`
+	CreatedAt      time.Time           `db:"created_at" json:"created_at"`
+}
+
+// OptionRequestResolution описывает решение модератора по заявке
+type OptionRequestResolution struct {
+	RequestID     int64
+	Status        OptionRequestStatus
+	ModeratorUuid string
+	Reason        *string
+	OptionID      *int64
 }
 
 type OptionRequestList []OptionRequest
@@ -26,8 +82,10 @@ func (o OptionRequestList) ToDTO() []*optionhub.OptionRequestItem {
 			OptionRequestId:    item.ID,
 			AttributeId:        item.AttributeID,
 			OptionRequestValue: item.Value,
+			ParentId:           item.ParentId,
 			CreatedAt:          timestamppb.New(item.CreatedAt),
 			UserUuid:           item.UserUuid,
+			Status:             item.Status.ToDTO(),
 		})
 	}
 
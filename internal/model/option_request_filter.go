@@ -0,0 +1,128 @@
+package model
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/s21platform/optionhub-service/pkg/optionhub"
+)
+
+const (
+	defaultOptionRequestsPageSize = 50
+	maxOptionRequestsPageSize     = 500
+)
+
+type OptionRequestSortField string
+
+const (
+	OptionRequestSortByID        OptionRequestSortField = "id"
+	OptionRequestSortByCreatedAt OptionRequestSortField = "created_at"
+)
+
+// OptionRequestCursor указывает на последнюю выданную заявку для keyset-пагинации
+type OptionRequestCursor struct {
+	SortBy    OptionRequestSortField `json:"s"`
+	CreatedAt time.Time              `json:"c"`
+	ID        int64                  `json:"i"`
+}
+
+func (c OptionRequestCursor) Encode() string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func DecodeOptionRequestCursor(token string) (*OptionRequestCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, fmt.Errorf("malformed page token: %v", err)
+	}
+
+	var cursor OptionRequestCursor
+	err = json.Unmarshal(data, &cursor)
+	if err != nil {
+		return nil, fmt.Errorf("malformed page token: %v", err)
+	}
+
+	return &cursor, nil
+}
+
+type OptionRequestFilter struct {
+	AttributeID *int64
+	UserUuid    *string
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+	Status      *OptionRequestStatus
+	SortBy      OptionRequestSortField
+	Ascending   bool
+	PageSize    uint64
+	After       *OptionRequestCursor
+}
+
+func NewOptionRequestFilter(in *optionhub.GetOptionRequestsIn) (OptionRequestFilter, error) {
+	filter := OptionRequestFilter{
+		AttributeID: in.AttributeId,
+		UserUuid:    in.UserUuid,
+		SortBy:      OptionRequestSortByID,
+		Ascending:   in.Ascending,
+		PageSize:    defaultOptionRequestsPageSize,
+	}
+
+	if in.CreatedFrom != nil {
+		createdFrom := in.CreatedFrom.AsTime()
+		filter.CreatedFrom = &createdFrom
+	}
+	if in.CreatedTo != nil {
+		createdTo := in.CreatedTo.AsTime()
+		filter.CreatedTo = &createdTo
+	}
+
+	if in.Status != optionhub.OptionRequestStatus_OPTION_REQUEST_STATUS_UNSPECIFIED {
+		status, err := OptionRequestStatusFromDTO(in.Status)
+		if err != nil {
+			return OptionRequestFilter{}, err
+		}
+		filter.Status = &status
+	}
+
+	switch in.SortBy {
+	case optionhub.OptionRequestSortField_OPTION_REQUEST_SORT_FIELD_ID:
+		filter.SortBy = OptionRequestSortByID
+	case optionhub.OptionRequestSortField_OPTION_REQUEST_SORT_FIELD_CREATED_AT:
+		filter.SortBy = OptionRequestSortByCreatedAt
+	default:
+		return OptionRequestFilter{}, fmt.Errorf("unknown sort field: %s", in.SortBy)
+	}
+
+	switch {
+	case in.PageSize < 0:
+		return OptionRequestFilter{}, fmt.Errorf("page size must not be negative")
+	case in.PageSize > maxOptionRequestsPageSize:
+		filter.PageSize = maxOptionRequestsPageSize
+	case in.PageSize > 0:
+		filter.PageSize = uint64(in.PageSize)
+	}
+
+	if in.PageToken != "" {
+		cursor, err := DecodeOptionRequestCursor(in.PageToken)
+		if err != nil {
+			return OptionRequestFilter{}, err
+		}
+		if cursor.SortBy != filter.SortBy {
+			return OptionRequestFilter{}, fmt.Errorf("page token was issued for sorting by %s", cursor.SortBy)
+		}
+		filter.After = cursor
+	}
+
+	return filter, nil
+}
+
+// NextCursor возвращает курсор на последний элемент страницы
+func (f OptionRequestFilter) NextCursor(last OptionRequest) OptionRequestCursor {
+	return OptionRequestCursor{
+		SortBy:    f.SortBy,
+		CreatedAt: last.CreatedAt,
+		ID:        last.ID,
+	}
+}
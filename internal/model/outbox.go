@@ -0,0 +1,63 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// логические топики outbox; relay сопоставляет их с продюсерами Kafka
+const (
+	OutboxTopicSetAttribute  = "set_attribute"
+	OutboxTopicCatalogEvents = "catalog_events"
+)
+
+// ContentTypeJSON - обычный json, как его сериализует encoding/json
+const ContentTypeJSON = "application/json"
+
+type OutboxMessage struct {
+	ID          int64  `db:"id"`
+	Topic       string `db:"topic"`
+	Key         string `db:"message_key"`
+	Payload     []byte `db:"payload"`
+	ContentType string `db:"content_type"`
+	Attempts    int    `db:"attempts"`
+}
+
+// NewOutboxMessage сериализует сообщение так же, как его сериализует kafka-lib при отправке
+func NewOutboxMessage(topic, key string, message any) (OutboxMessage, error) {
+	payload, err := json.Marshal(message)
+	if err != nil {
+		return OutboxMessage{}, fmt.Errorf("failed to marshal outbox message: %v", err)
+	}
+
+	return OutboxMessage{
+		Topic:       topic,
+		Key:         key,
+		Payload:     payload,
+		ContentType: ContentTypeJSON,
+	}, nil
+}
+
+// NewProtoOutboxMessage сериализует proto-сообщение в protojson, который потребители декодируют через protojson.Unmarshal.
+// Бинарный proto не подходит: payload хранится в jsonb
+func NewProtoOutboxMessage(topic, key string, message proto.Message) (OutboxMessage, error) {
+	payload, err := protojson.Marshal(message)
+	if err != nil {
+		return OutboxMessage{}, fmt.Errorf("failed to marshal outbox message: %v", err)
+	}
+
+	return OutboxMessage{
+		Topic:       topic,
+		Key:         key,
+		Payload:     payload,
+		ContentType: ProtoJSONContentType(message),
+	}, nil
+}
+
+// ProtoJSONContentType - content type protojson с полным именем сообщения, например "application/json; proto=CatalogEvent"
+func ProtoJSONContentType(message proto.Message) string {
+	return fmt.Sprintf("%s; proto=%s", ContentTypeJSON, message.ProtoReflect().Descriptor().FullName())
+}
@@ -0,0 +1,61 @@
+package model
+
+import (
+	"github.com/lib/pq"
+
+	"github.com/s21platform/optionhub-service/pkg/optionhub"
+)
+
+// OptionPath - значения от корня дерева до самого значения OptionID
+type OptionPath struct {
+	OptionID int64          `db:"option_id"`
+	Path     pq.StringArray `db:"path"`
+	PathIDs  pq.Int64Array  `db:"path_ids"`
+}
+
+// Localize возвращает путь, в котором значения подменены переводами по chain, если они есть
+func (p OptionPath) Localize(translations map[int64]Translations, chain LocaleChain) []string {
+	return localizePath(p.Path, p.PathIDs, translations, chain)
+}
+
+func localizePath(names []string, ids []int64, translations map[int64]Translations, chain LocaleChain) []string {
+	result := make([]string, len(names))
+	for i, name := range names {
+		result[i] = name
+		if i >= len(ids) {
+			continue
+		}
+		if value, ok := translations[ids[i]].Pick(chain); ok {
+			result[i] = value
+		}
+	}
+	return result
+}
+
+type ResolvedOption struct {
+	AttributeValue
+	AttributeName string
+	Path          []string
+	// RedirectedFrom - запрошенный id, если он был слит в это значение
+	RedirectedFrom *int64
+}
+
+type ResolvedOptionList []ResolvedOption
+
+func (r ResolvedOptionList) ToDTO() []*optionhub.ResolvedOption {
+	result := make([]*optionhub.ResolvedOption, 0, len(r))
+
+	for _, item := range r {
+		result = append(result, &optionhub.ResolvedOption{
+			OptionId:       item.Id,
+			OptionValue:    item.Value,
+			AttributeId:    item.AttributeId,
+			AttributeName:  item.AttributeName,
+			Path:           item.Path,
+			IsDeleted:      item.DeletedAt != nil,
+			RedirectedFrom: item.RedirectedFrom,
+		})
+	}
+
+	return result
+}
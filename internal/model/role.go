@@ -0,0 +1,32 @@
+package model
+
+import "fmt"
+
+// Role - роль пользователя платформы; каждая следующая роль включает права предыдущих
+type Role string
+
+const (
+	RoleUser      Role = "user"
+	RoleModerator Role = "moderator"
+	RoleAdmin     Role = "admin"
+)
+
+var roleRanks = map[Role]int{
+	RoleUser:      1,
+	RoleModerator: 2,
+	RoleAdmin:     3,
+}
+
+func ParseRole(s string) (Role, error) {
+	role := Role(s)
+	if _, ok := roleRanks[role]; !ok {
+		return "", fmt.Errorf("unknown role: %q", s)
+	}
+	return role, nil
+}
+
+// Allows сообщает, достаточно ли роли r для действия, требующего роль required
+func (r Role) Allows(required Role) bool {
+	rank, ok := roleRanks[r]
+	return ok && rank >= roleRanks[required]
+}
@@ -0,0 +1,54 @@
+package model
+
+import (
+	"github.com/lib/pq"
+	"github.com/samber/lo"
+
+	"github.com/s21platform/optionhub-service/pkg/optionhub"
+)
+
+type SearchHit struct {
+	Id       int64          `db:"id"`
+	Value    string         `db:"value"`
+	ParentId *int64         `db:"parent_id"`
+	Alias    *string        `db:"alias"`
+	Path     pq.StringArray `db:"path"`
+	PathIDs  pq.Int64Array  `db:"path_ids"`
+}
+
+type SearchHitList []SearchHit
+
+// Localize подменяет значения и пути переводами по chain, если они есть. Совпавший синоним не переводится
+func (s SearchHitList) Localize(translations map[int64]Translations, chain LocaleChain) {
+	for i := range s {
+		if value, ok := translations[s[i].Id].Pick(chain); ok {
+			s[i].Value = value
+		}
+		s[i].Path = localizePath(s[i].Path, s[i].PathIDs, translations, chain)
+	}
+}
+
+// Ids возвращает id найденных значений и всех значений их путей
+func (s SearchHitList) Ids() []int64 {
+	ids := make([]int64, 0, len(s))
+	for _, hit := range s {
+		ids = append(ids, hit.PathIDs...)
+	}
+	return lo.Uniq(ids)
+}
+
+func (s SearchHitList) ToDTO() []*optionhub.SearchHit {
+	result := make([]*optionhub.SearchHit, 0, len(s))
+
+	for _, hit := range s {
+		result = append(result, &optionhub.SearchHit{
+			OptionId:     hit.Id,
+			OptionValue:  hit.Value,
+			ParentId:     hit.ParentId,
+			Path:         hit.Path,
+			MatchedAlias: hit.Alias,
+		})
+	}
+
+	return result
+}
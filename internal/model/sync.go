@@ -0,0 +1,214 @@
+package model
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/samber/lo"
+	"gopkg.in/yaml.v3"
+)
+
+// ParseCatalogYAML читает описание каталога в формате выгрузки ExportCatalog. id не обязательны и не используются,
+// удалённые значения (deleted: true) пропускаются
+func ParseCatalogYAML(data []byte) (Catalog, error) {
+	var catalog Catalog
+
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+
+	err := decoder.Decode(&catalog)
+	if err != nil {
+		return Catalog{}, fmt.Errorf("failed to parse yaml: %v", err)
+	}
+
+	names := make(map[string]bool)
+	for _, attribute := range catalog.Attributes {
+		name := importKey(attribute.Name)
+		if name == "" {
+			return Catalog{}, fmt.Errorf("attribute name is empty")
+		}
+		if names[name] {
+			return Catalog{}, fmt.Errorf("attribute %q is defined twice", attribute.Name)
+		}
+		names[name] = true
+
+		if _, ok := attributeTypeToDTO[attribute.Type]; !ok {
+			return Catalog{}, fmt.Errorf("attribute %q: unknown type %q", attribute.Name, attribute.Type)
+		}
+
+		_, err = catalogImportNodes(attribute.Values, []string{attribute.Name})
+		if err != nil {
+			return Catalog{}, err
+		}
+	}
+
+	return catalog, nil
+}
+
+func catalogImportNodes(values []CatalogValue, path []string) (ImportNodeList, error) {
+	nodes := make(ImportNodeList, 0)
+	err := mergeCatalogValues(&nodes, values, path)
+	if err != nil {
+		return nil, err
+	}
+	return nodes, nil
+}
+
+func mergeCatalogValues(level *ImportNodeList, values []CatalogValue, path []string) error {
+	for _, v := range values {
+		if v.Deleted {
+			continue
+		}
+
+		value := CleanValue(v.Value)
+		if value == "" {
+			return fmt.Errorf("empty value under %q", JoinImportPath(path))
+		}
+
+		var node *ImportNode
+		*level, node = level.child(value)
+
+		err := mergeCatalogValues(&node.Children, v.Children, append(path, value))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// liveCatalogValueList разворачивает деревья обратно в список значений с parent_id, как их хранит база.
+// Удалённые значения вместе с поддеревьями пропускаются
+func liveCatalogValueList(attributeId int64, values []CatalogValue, parentId *int64) AttributeValueList {
+	result := make(AttributeValueList, 0)
+	for _, v := range values {
+		if v.Deleted {
+			continue
+		}
+
+		result = append(result, AttributeValue{
+			Id:          v.ID,
+			AttributeId: attributeId,
+			Value:       v.Value,
+			ParentId:    parentId,
+		})
+		result = append(result, liveCatalogValueList(attributeId, v.Children, lo.ToPtr(v.ID))...)
+	}
+	return result
+}
+
+// SyncExtra - значение из базы, которого нет в описании каталога
+type SyncExtra struct {
+	Path     []string
+	OptionID int64
+}
+
+// SyncAttribute - изменения одного атрибута. ID равен 0, если атрибут будет создан
+type SyncAttribute struct {
+	ID   int64
+	Name string
+	Type AttributeType
+	// Conflict - причина, по которой атрибут нельзя привести к описанию
+	Conflict string
+	// Extra - атрибут есть только в базе; атрибуты не удаляются, только попадают в отчёт
+	Extra bool
+	// Values - значения из описания, в формате импорта
+	Values ImportNodeList
+	Steps  ImportPlan
+	// Extras - лишние значения, потомки идут раньше родителей, чтобы их можно было удалять по порядку
+	Extras []SyncExtra
+}
+
+// SyncPlan - разница между описанием каталога и базой. Атрибуты идут в порядке описания, лишние - в конце
+type SyncPlan []*SyncAttribute
+
+// PlanSync сравнивает описание каталога seed с текущим состоянием current, выгруженным вместе с удалёнными значениями.
+// Атрибуты и значения сопоставляются по тексту без учёта регистра, как при импорте. Удалённые значения не учитываются:
+// значение, которое вернули в описание после -prune, создаётся заново (импорт с recreate_deleted)
+func PlanSync(seed, current Catalog) (SyncPlan, error) {
+	byName := lo.SliceToMap(current.Attributes, func(a CatalogAttribute) (string, CatalogAttribute) {
+		return importKey(a.Name), a
+	})
+
+	plan := make(SyncPlan, 0, len(seed.Attributes))
+	for _, attribute := range seed.Attributes {
+		nodes, err := catalogImportNodes(attribute.Values, []string{attribute.Name})
+		if err != nil {
+			return nil, err
+		}
+
+		item := &SyncAttribute{
+			Name:   attribute.Name,
+			Type:   attribute.Type,
+			Values: nodes,
+		}
+		plan = append(plan, item)
+
+		existing, ok := byName[importKey(attribute.Name)]
+		if !ok {
+			item.Steps = PlanImport(nodes, nil, nil, attribute.Type)
+			continue
+		}
+		delete(byName, importKey(attribute.Name))
+
+		item.ID = existing.ID
+		if existing.Type != attribute.Type {
+			item.Conflict = fmt.Sprintf("attribute has type %s, seed has %s", existing.Type, attribute.Type)
+			continue
+		}
+
+		item.Steps = PlanImport(nodes, liveCatalogValueList(existing.ID, existing.Values, nil), nil, attribute.Type)
+		item.Extras = syncExtras(existing.Values, nodes, []string{attribute.Name})
+	}
+
+	for _, attribute := range current.Attributes {
+		if _, ok := byName[importKey(attribute.Name)]; !ok {
+			continue
+		}
+		plan = append(plan, &SyncAttribute{
+			ID:    attribute.ID,
+			Name:  attribute.Name,
+			Type:  attribute.Type,
+			Extra: true,
+		})
+	}
+
+	return plan, nil
+}
+
+func syncExtras(values []CatalogValue, nodes ImportNodeList, path []string) []SyncExtra {
+	result := make([]SyncExtra, 0)
+	for _, value := range values {
+		if value.Deleted {
+			continue
+		}
+
+		valuePath := append(append([]string{}, path...), value.Value)
+		node, found := lo.Find(nodes, func(n *ImportNode) bool { return importKey(n.Value) == importKey(value.Value) })
+
+		var children ImportNodeList
+		if found {
+			children = node.Children
+		}
+		result = append(result, syncExtras(value.Children, children, valuePath)...)
+
+		if !found {
+			result = append(result, SyncExtra{Path: valuePath, OptionID: value.ID})
+		}
+	}
+	return result
+}
+
+// HasConflicts сообщает, есть ли атрибуты или значения, которые нельзя привести к описанию
+func (p SyncPlan) HasConflicts() bool {
+	return lo.SomeBy(p, func(a *SyncAttribute) bool {
+		return a.Conflict != "" || a.Steps.Count(ImportStatusConflict) > 0
+	})
+}
+
+// Empty сообщает, что база уже соответствует описанию
+func (p SyncPlan) Empty() bool {
+	return lo.EveryBy(p, func(a *SyncAttribute) bool {
+		return a.ID != 0 && a.Conflict == "" && !a.Extra && len(a.Extras) == 0 &&
+			a.Steps.Count(ImportStatusCreated) == 0 && a.Steps.Count(ImportStatusConflict) == 0
+	})
+}
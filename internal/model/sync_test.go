@@ -0,0 +1,266 @@
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCatalogYAML(t *testing.T) {
+	t.Parallel()
+
+	t.Run("ok", func(t *testing.T) {
+		data := []byte(`
+attributes:
+  - name: Город
+    type: tree
+    values:
+      - value: Россия
+        children:
+          - value: Москва
+          - value: Тверь
+            deleted: true
+  - name: Язык
+    type: enum
+    values:
+      - value: Go
+`)
+
+		catalog, err := ParseCatalogYAML(data)
+
+		require.NoError(t, err)
+		require.Len(t, catalog.Attributes, 2)
+		assert.Equal(t, "Город", catalog.Attributes[0].Name)
+		assert.Equal(t, AttributeTypeTree, catalog.Attributes[0].Type)
+		assert.Equal(t, "Москва", catalog.Attributes[0].Values[0].Children[0].Value)
+		assert.True(t, catalog.Attributes[0].Values[0].Children[1].Deleted)
+	})
+
+	tests := []struct {
+		name string
+		data string
+		err  string
+	}{
+		{
+			name: "unknown_field",
+			data: "attributes:\n  - name: Город\n    type: tree\n    title: Город\n",
+			err:  "failed to parse yaml",
+		},
+		{
+			name: "empty_name",
+			data: "attributes:\n  - name: \" \"\n    type: enum\n",
+			err:  "attribute name is empty",
+		},
+		{
+			name: "duplicate_name",
+			data: "attributes:\n  - name: Город\n    type: tree\n  - name: ГОРОД\n    type: tree\n",
+			err:  `attribute "ГОРОД" is defined twice`,
+		},
+		{
+			name: "unknown_type",
+			data: "attributes:\n  - name: Город\n    type: list\n",
+			err:  `attribute "Город": unknown type "list"`,
+		},
+		{
+			name: "empty_value",
+			data: "attributes:\n  - name: Город\n    type: tree\n    values:\n      - value: Россия\n        children:\n          - value: \"\"\n",
+			err:  `empty value under "Город/Россия"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseCatalogYAML([]byte(tt.data))
+
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.err)
+		})
+	}
+}
+
+func TestPlanSync(t *testing.T) {
+	t.Parallel()
+
+	current := Catalog{Attributes: []CatalogAttribute{
+		{
+			ID:   1,
+			Name: "Город",
+			Type: AttributeTypeTree,
+			Values: []CatalogValue{
+				{ID: 10, Value: "Россия", Children: []CatalogValue{
+					{ID: 11, Value: "Москва"},
+					{ID: 12, Value: "Тверь", Deleted: true},
+				}},
+			},
+		},
+		{ID: 2, Name: "Язык", Type: AttributeTypeEnum},
+	}}
+
+	tests := []struct {
+		name      string
+		seed      Catalog
+		created   []string
+		conflicts []string
+		extras    []int64
+		conflict  string
+		extra     []string
+		empty     bool
+		blocked   bool
+	}{
+		{
+			name: "up_to_date",
+			seed: Catalog{Attributes: []CatalogAttribute{
+				{Name: "город", Type: AttributeTypeTree, Values: []CatalogValue{
+					{Value: "РОССИЯ", Children: []CatalogValue{{Value: "москва"}}},
+				}},
+				{Name: "Язык", Type: AttributeTypeEnum},
+			}},
+			empty: true,
+		},
+		{
+			name: "plan",
+			seed: Catalog{Attributes: []CatalogAttribute{
+				{Name: "Город", Type: AttributeTypeTree, Values: []CatalogValue{
+					{Value: "Россия", Children: []CatalogValue{{Value: "Москва"}, {Value: "Казань"}}},
+					{Value: "Беларусь", Children: []CatalogValue{{Value: "Минск"}}},
+				}},
+				{Name: "Язык", Type: AttributeTypeEnum},
+				{Name: "Грейд", Type: AttributeTypeEnum, Values: []CatalogValue{{Value: "Junior"}}},
+			}},
+			created: []string{"Россия/Казань", "Беларусь", "Беларусь/Минск", "Junior"},
+		},
+		{
+			name: "prune",
+			seed: Catalog{Attributes: []CatalogAttribute{
+				{Name: "Город", Type: AttributeTypeTree},
+			}},
+			extras: []int64{11, 10},
+			extra:  []string{"Язык"},
+		},
+		{
+			name: "pruned_value",
+			seed: Catalog{Attributes: []CatalogAttribute{
+				{Name: "Город", Type: AttributeTypeTree, Values: []CatalogValue{
+					{Value: "Россия", Children: []CatalogValue{{Value: "Москва"}, {Value: "Тверь", Children: []CatalogValue{{Value: "Центр"}}}}},
+				}},
+				{Name: "Язык", Type: AttributeTypeEnum},
+			}},
+			created: []string{"Россия/Тверь", "Россия/Тверь/Центр"},
+		},
+		{
+			name: "invalid_value",
+			seed: Catalog{Attributes: []CatalogAttribute{
+				{Name: "Город", Type: AttributeTypeTree, Values: []CatalogValue{
+					{Value: "Россия", Children: []CatalogValue{{Value: "Москва"}}},
+				}},
+				{Name: "Язык", Type: AttributeTypeEnum, Values: []CatalogValue{{Value: "Go", Children: []CatalogValue{{Value: "1.22"}}}}},
+			}},
+			created:   []string{"Go"},
+			conflicts: []string{"Go/1.22"},
+			blocked:   true,
+		},
+		{
+			name: "type_conflict",
+			seed: Catalog{Attributes: []CatalogAttribute{
+				{Name: "Город", Type: AttributeTypeEnum},
+				{Name: "Язык", Type: AttributeTypeEnum},
+			}},
+			conflict: "attribute has type tree, seed has enum",
+			blocked:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan, err := PlanSync(tt.seed, current)
+			require.NoError(t, err)
+
+			var created, conflicts, extra []string
+			var extras []int64
+			var conflict string
+			for _, attribute := range plan {
+				if attribute.Extra {
+					extra = append(extra, attribute.Name)
+				}
+				if attribute.Conflict != "" {
+					conflict = attribute.Conflict
+				}
+				for _, step := range attribute.Steps {
+					switch step.Status {
+					case ImportStatusCreated:
+						created = append(created, strings.Join(step.Path, "/"))
+					case ImportStatusConflict:
+						conflicts = append(conflicts, strings.Join(step.Path, "/"))
+					}
+				}
+				for _, e := range attribute.Extras {
+					extras = append(extras, e.OptionID)
+				}
+			}
+
+			assert.Equal(t, tt.created, created)
+			assert.Equal(t, tt.conflicts, conflicts)
+			assert.Equal(t, tt.extras, extras)
+			assert.Equal(t, tt.conflict, conflict)
+			assert.Equal(t, tt.extra, extra)
+			assert.Equal(t, tt.empty, plan.Empty())
+			assert.Equal(t, tt.blocked, plan.HasConflicts())
+		})
+	}
+}
+
+func TestSyncExtras(t *testing.T) {
+	t.Parallel()
+
+	values := []CatalogValue{
+		{ID: 1, Value: "Россия", Children: []CatalogValue{
+			{ID: 2, Value: "Москва", Children: []CatalogValue{{ID: 3, Value: "Центр"}}},
+			{ID: 4, Value: "Тверь", Deleted: true},
+		}},
+		{ID: 5, Value: "Беларусь"},
+	}
+
+	tests := []struct {
+		name  string
+		nodes ImportNodeList
+		want  []SyncExtra
+	}{
+		{
+			name: "all_in_seed",
+			nodes: ImportNodeList{
+				{Value: "россия", Children: ImportNodeList{{Value: "Москва", Children: ImportNodeList{{Value: "Центр"}}}}},
+				{Value: "Беларусь"},
+			},
+			want: []SyncExtra{},
+		},
+		{
+			name: "children_before_parents",
+			nodes: ImportNodeList{
+				{Value: "Беларусь"},
+			},
+			want: []SyncExtra{
+				{Path: []string{"Город", "Россия", "Москва", "Центр"}, OptionID: 3},
+				{Path: []string{"Город", "Россия", "Москва"}, OptionID: 2},
+				{Path: []string{"Город", "Россия"}, OptionID: 1},
+			},
+		},
+		{
+			name: "leaf_missing",
+			nodes: ImportNodeList{
+				{Value: "Россия", Children: ImportNodeList{{Value: "Москва"}}},
+				{Value: "Беларусь"},
+			},
+			want: []SyncExtra{
+				{Path: []string{"Город", "Россия", "Москва", "Центр"}, OptionID: 3},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, syncExtras(values, tt.nodes, []string{"Город"}))
+		})
+	}
+}
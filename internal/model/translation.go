@@ -0,0 +1,109 @@
+package model
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/samber/lo"
+)
+
+var localeRe = regexp.MustCompile(`^[a-z]{2,3}(-[a-z0-9]{2,8})*$`)
+
+// NormalizeLocale приводит тег локали к виду "en-us"
+func NormalizeLocale(locale string) (string, error) {
+	normalized := strings.ToLower(strings.ReplaceAll(strings.TrimSpace(locale), "_", "-"))
+	if !localeRe.MatchString(normalized) {
+		return "", fmt.Errorf("invalid locale %q", locale)
+	}
+	return normalized, nil
+}
+
+// LocaleChain - локали в порядке поиска перевода
+type LocaleChain []string
+
+// ParseLocaleChain разбирает список локалей через запятую. За каждой локалью идут её более общие варианты,
+// например "kk-KZ,ru" -> kk-kz, kk, ru. Пустая строка даёт пустую цепочку: показываются исходные значения
+func ParseLocaleChain(in string) (LocaleChain, error) {
+	chain := make(LocaleChain, 0)
+
+	for _, part := range strings.Split(in, ",") {
+		if strings.TrimSpace(part) == "" {
+			continue
+		}
+
+		locale, err := NormalizeLocale(part)
+		if err != nil {
+			return nil, err
+		}
+
+		for {
+			if !lo.Contains(chain, locale) {
+				chain = append(chain, locale)
+			}
+
+			i := strings.LastIndex(locale, "-")
+			if i < 0 {
+				break
+			}
+			locale = locale[:i]
+		}
+	}
+
+	return chain, nil
+}
+
+func (c LocaleChain) String() string {
+	return strings.Join(c, ",")
+}
+
+// Translations - переводы текста по локалям
+type Translations map[string]string
+
+// Pick возвращает перевод для первой локали цепочки, у которой он есть
+func (t Translations) Pick(chain LocaleChain) (string, bool) {
+	for _, locale := range chain {
+		if text, ok := t[locale]; ok {
+			return text, true
+		}
+	}
+	return "", false
+}
+
+type AttributeTranslation struct {
+	AttributeID int64  `db:"attribute_id" json:"attribute_id"`
+	Locale      string `db:"locale" json:"locale"`
+	Name        string `db:"name" json:"name"`
+}
+
+type AttributeTranslationList []AttributeTranslation
+
+func (l AttributeTranslationList) ByAttribute() map[int64]Translations {
+	result := make(map[int64]Translations)
+	for _, t := range l {
+		if result[t.AttributeID] == nil {
+			result[t.AttributeID] = make(Translations)
+		}
+		result[t.AttributeID][t.Locale] = t.Name
+	}
+	return result
+}
+
+type ValueTranslation struct {
+	OptionID int64  `db:"option_id" json:"option_id"`
+	Locale   string `db:"locale" json:"locale"`
+	Value    string `db:"value" json:"value"`
+}
+
+type ValueTranslationList []ValueTranslation
+
+func (l ValueTranslationList) ByOption() map[int64]Translations {
+	result := make(map[int64]Translations)
+	for _, t := range l {
+		if result[t.OptionID] == nil {
+			result[t.OptionID] = make(Translations)
+		}
+		result[t.OptionID][t.Locale] = t.Value
+	}
+	return result
+}
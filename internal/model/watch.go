@@ -0,0 +1,57 @@
+package model
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/s21platform/optionhub-service/pkg/optionhub"
+)
+
+type AttributeValueOperation string
+
+const (
+	AttributeValueOperationAdded   AttributeValueOperation = "added"
+	AttributeValueOperationUpdated AttributeValueOperation = "updated"
+	AttributeValueOperationDeleted AttributeValueOperation = "deleted"
+)
+
+var attributeValueOperationToDTO = map[AttributeValueOperation]optionhub.WatchEventType{
+	AttributeValueOperationAdded:   optionhub.WatchEventType_WATCH_EVENT_TYPE_VALUE_ADDED,
+	AttributeValueOperationUpdated: optionhub.WatchEventType_WATCH_EVENT_TYPE_VALUE_UPDATED,
+	AttributeValueOperationDeleted: optionhub.WatchEventType_WATCH_EVENT_TYPE_VALUE_DELETED,
+}
+
+func (o AttributeValueOperation) ToDTO() optionhub.WatchEventType {
+	return attributeValueOperationToDTO[o]
+}
+
+// AttributeValueChange - изменение значения атрибута, после которого версия атрибута стала Version.
+// Пишется триггером attribute_values_bump_version
+type AttributeValueChange struct {
+	AttributeID int64                   `db:"attribute_id"`
+	Version     int64                   `db:"version"`
+	OptionID    int64                   `db:"option_id"`
+	Operation   AttributeValueOperation `db:"operation"`
+	Value       string                  `db:"value"`
+	ParentId    *int64                  `db:"parent_id"`
+	CreatedAt   time.Time               `db:"created_at"`
+}
+
+// Localize подменяет значение переводом по chain, если он есть
+func (c *AttributeValueChange) Localize(translations Translations, chain LocaleChain) {
+	if value, ok := translations.Pick(chain); ok {
+		c.Value = value
+	}
+}
+
+func (c AttributeValueChange) ToDTO() *optionhub.WatchAttributeOut {
+	return &optionhub.WatchAttributeOut{
+		Type:       c.Operation.ToDTO(),
+		Version:    c.Version,
+		OptionId:   c.OptionID,
+		Value:      c.Value,
+		ParentId:   c.ParentId,
+		OccurredAt: timestamppb.New(c.CreatedAt),
+	}
+}
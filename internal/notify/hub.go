@@ -0,0 +1,66 @@
+package notify
+
+import "sync"
+
+// Hub раздаёт уведомления об изменении значений атрибута подписчикам, например открытым WatchAttribute.
+// Уведомление лишь сообщает, что стоит перечитать изменения: несколько подряд склеиваются в одно
+type Hub struct {
+	mu   sync.Mutex
+	subs map[int64]map[chan struct{}]struct{}
+}
+
+func NewHub() *Hub {
+	return &Hub{subs: make(map[int64]map[chan struct{}]struct{})}
+}
+
+// Subscribe подписывает на изменения атрибута. Возвращённую функцию нужно вызвать, когда подписка больше не нужна
+func (h *Hub) Subscribe(attributeId int64) (<-chan struct{}, func()) {
+	ch := make(chan struct{}, 1)
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.subs[attributeId] == nil {
+		h.subs[attributeId] = make(map[chan struct{}]struct{})
+	}
+	h.subs[attributeId][ch] = struct{}{}
+
+	return ch, func() {
+		h.mu.Lock()
+		defer h.mu.Unlock()
+
+		delete(h.subs[attributeId], ch)
+		if len(h.subs[attributeId]) == 0 {
+			delete(h.subs, attributeId)
+		}
+	}
+}
+
+// Notify будит подписчиков атрибута
+func (h *Hub) Notify(attributeId int64) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for ch := range h.subs[attributeId] {
+		wake(ch)
+	}
+}
+
+// NotifyAll будит всех подписчиков, например когда уведомления могли потеряться
+func (h *Hub) NotifyAll() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for _, subs := range h.subs {
+		for ch := range subs {
+			wake(ch)
+		}
+	}
+}
+
+func wake(ch chan struct{}) {
+	select {
+	case ch <- struct{}{}:
+	default:
+	}
+}
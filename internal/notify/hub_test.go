@@ -0,0 +1,69 @@
+package notify
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func received(ch <-chan struct{}) bool {
+	select {
+	case <-ch:
+		return true
+	default:
+		return false
+	}
+}
+
+func TestHub(t *testing.T) {
+	t.Parallel()
+
+	t.Run("notify_subscribers_of_attribute", func(t *testing.T) {
+		h := NewHub()
+		first, unsubscribeFirst := h.Subscribe(1)
+		defer unsubscribeFirst()
+		other, unsubscribeOther := h.Subscribe(2)
+		defer unsubscribeOther()
+
+		h.Notify(1)
+
+		assert.True(t, received(first))
+		assert.False(t, received(other))
+	})
+
+	t.Run("notifications_coalesce", func(t *testing.T) {
+		h := NewHub()
+		ch, unsubscribe := h.Subscribe(1)
+		defer unsubscribe()
+
+		h.Notify(1)
+		h.Notify(1)
+
+		assert.True(t, received(ch))
+		assert.False(t, received(ch))
+	})
+
+	t.Run("notify_all", func(t *testing.T) {
+		h := NewHub()
+		first, unsubscribeFirst := h.Subscribe(1)
+		defer unsubscribeFirst()
+		second, unsubscribeSecond := h.Subscribe(2)
+		defer unsubscribeSecond()
+
+		h.NotifyAll()
+
+		assert.True(t, received(first))
+		assert.True(t, received(second))
+	})
+
+	t.Run("unsubscribe", func(t *testing.T) {
+		h := NewHub()
+		ch, unsubscribe := h.Subscribe(1)
+		unsubscribe()
+
+		h.Notify(1)
+
+		assert.False(t, received(ch))
+		assert.Empty(t, h.subs)
+	})
+}
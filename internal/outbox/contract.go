@@ -0,0 +1,16 @@
+//go:generate mockgen -destination=mock_contract_test.go -package=${GOPACKAGE} -source=contract.go
+package outbox
+
+import (
+	"context"
+
+	"github.com/s21platform/optionhub-service/internal/model"
+)
+
+type Repository interface {
+	ProcessOutbox(ctx context.Context, limit uint64, handle func(ctx context.Context, msg model.OutboxMessage) error) (int, error)
+}
+
+type Producer interface {
+	Produce(ctx context.Context, msg model.OutboxMessage) error
+}
@@ -0,0 +1,50 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+
+	"github.com/s21platform/optionhub-service/internal/model"
+)
+
+const contentTypeHeader = "content-type"
+
+// KafkaProducer пишет сообщения outbox в Kafka как есть, без повторной сериализации. В отличие от продюсера kafka-lib
+// выбирает партицию по ключу, чтобы сообщения одного ключа читались по порядку, и передаёт content-type в заголовке
+type KafkaProducer struct {
+	writer *kafka.Writer
+}
+
+func NewKafkaProducer(host, port, topic string) *KafkaProducer {
+	return &KafkaProducer{
+		writer: &kafka.Writer{
+			Addr:         kafka.TCP(host + ":" + port),
+			Topic:        topic,
+			Balancer:     &kafka.Hash{},
+			RequiredAcks: kafka.RequireAll,
+			WriteTimeout: 10 * time.Second,
+		},
+	}
+}
+
+func (p *KafkaProducer) Produce(ctx context.Context, msg model.OutboxMessage) error {
+	err := p.writer.WriteMessages(ctx, kafka.Message{
+		Key:   []byte(msg.Key),
+		Value: msg.Payload,
+		Headers: []kafka.Header{
+			{Key: contentTypeHeader, Value: []byte(msg.ContentType)},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to write message: %v", err)
+	}
+
+	return nil
+}
+
+func (p *KafkaProducer) Close() error {
+	return p.writer.Close()
+}
@@ -0,0 +1,89 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	logger_lib "github.com/s21platform/logger-lib"
+
+	"github.com/s21platform/optionhub-service/internal/model"
+)
+
+const maxBackoff = time.Minute
+
+// Relay публикует сообщения из outbox в Kafka. Сообщение помечается отправленным только после
+// успешной публикации, поэтому доставка - at-least-once. Сообщения одного ключа публикуются по порядку
+// и при нескольких репликах: ProcessOutbox не отдаёт ключ, который разбирает другая реплика
+type Relay struct {
+	repo      Repository
+	producers map[string]Producer
+	logger    logger_lib.LoggerInterface
+	interval  time.Duration
+	batchSize uint64
+}
+
+func New(repo Repository, producers map[string]Producer, logger logger_lib.LoggerInterface, interval time.Duration, batchSize uint64) *Relay {
+	return &Relay{
+		repo:      repo,
+		producers: producers,
+		logger:    logger,
+		interval:  interval,
+		batchSize: batchSize,
+	}
+}
+
+// Run разбирает outbox, пока не отменён ctx. После ошибки следующая попытка откладывается
+// с экспоненциально растущей паузой, но не дольше maxBackoff
+func (r *Relay) Run(ctx context.Context) {
+	failures := 0
+
+	for {
+		wait := r.interval
+
+		sent, err := r.repo.ProcessOutbox(ctx, r.batchSize, r.publish)
+		switch {
+		case err != nil:
+			failures++
+			wait = backoff(r.interval, failures)
+			r.logger.Error(fmt.Sprintf("failed to process outbox, retry in %s: %v", wait, err))
+		case uint64(sent) == r.batchSize:
+			// в outbox, скорее всего, остались сообщения - забираем следующую пачку сразу
+			failures = 0
+			wait = 0
+		default:
+			failures = 0
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(wait):
+		}
+	}
+}
+
+func (r *Relay) publish(ctx context.Context, msg model.OutboxMessage) error {
+	producer, ok := r.producers[msg.Topic]
+	if !ok {
+		return fmt.Errorf("no producer for topic %q", msg.Topic)
+	}
+
+	err := producer.Produce(ctx, msg)
+	if err != nil {
+		return fmt.Errorf("failed to produce message %d: %v", msg.ID, err)
+	}
+
+	return nil
+}
+
+func backoff(interval time.Duration, failures int) time.Duration {
+	wait := interval
+	for i := 1; i < failures && wait < maxBackoff; i++ {
+		wait *= 2
+	}
+	if wait > maxBackoff {
+		return maxBackoff
+	}
+	return wait
+}
@@ -0,0 +1,91 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	logger_lib "github.com/s21platform/logger-lib"
+
+	"github.com/s21platform/optionhub-service/internal/model"
+)
+
+func TestRelay_publish(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := NewMockRepository(ctrl)
+	mockProducer := NewMockProducer(ctrl)
+	mockLogger := logger_lib.NewMockLoggerInterface(ctrl)
+
+	r := New(mockRepo, map[string]Producer{model.OutboxTopicSetAttribute: mockProducer}, mockLogger, time.Second, 10)
+
+	t.Run("publish_ok", func(t *testing.T) {
+		msg := model.OutboxMessage{
+			ID:          1,
+			Topic:       model.OutboxTopicSetAttribute,
+			Key:         "set_new_attribute",
+			Payload:     []byte(`{"attribute_id":1}`),
+			ContentType: model.ContentTypeJSON,
+		}
+		mockProducer.EXPECT().Produce(ctx, msg).Return(nil)
+
+		err := r.publish(ctx, msg)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("publish_producer_error", func(t *testing.T) {
+		mockProducer.EXPECT().Produce(ctx, gomock.Any()).Return(errors.New("kafka is down"))
+
+		err := r.publish(ctx, model.OutboxMessage{ID: 2, Topic: model.OutboxTopicSetAttribute})
+
+		assert.ErrorContains(t, err, "kafka is down")
+	})
+
+	t.Run("publish_unknown_topic", func(t *testing.T) {
+		err := r.publish(ctx, model.OutboxMessage{ID: 3, Topic: "unknown"})
+
+		assert.ErrorContains(t, err, "no producer for topic")
+	})
+}
+
+func TestRelay_Run(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := NewMockRepository(ctrl)
+	mockLogger := logger_lib.NewMockLoggerInterface(ctrl)
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	gomock.InOrder(
+		mockRepo.EXPECT().ProcessOutbox(gomock.Any(), uint64(10), gomock.Any()).Return(0, errors.New("db error")),
+		mockRepo.EXPECT().ProcessOutbox(gomock.Any(), uint64(10), gomock.Any()).DoAndReturn(
+			func(context.Context, uint64, func(context.Context, model.OutboxMessage) error) (int, error) {
+				cancel()
+				return 0, nil
+			}),
+	)
+	mockLogger.EXPECT().Error(gomock.Any())
+
+	r := New(mockRepo, nil, mockLogger, time.Millisecond, 10)
+	r.Run(ctx)
+}
+
+func TestBackoff(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, time.Second, backoff(time.Second, 1))
+	assert.Equal(t, 4*time.Second, backoff(time.Second, 3))
+	assert.Equal(t, maxBackoff, backoff(time.Second, 20))
+}
@@ -0,0 +1,282 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/s21platform/optionhub-service/internal/model"
+)
+
+const attributeValueAliasesTable = "attribute_value_aliases"
+
+// attributeLockKey - первый ключ advisory-блокировки атрибута, второй - attribute_id.
+// Тот же ключ берёт триггер attribute_values_check_parent при перепривязке значения
+const attributeLockKey = 3
+
+// lockAttribute сериализует запись значений и синонимов одного атрибута. Значение не должно совпадать с синонимом,
+// а синоним - со значением с любым родителем, и уникальные индексы этого не покрывают, поэтому проверка идёт под блокировкой.
+// Блокировка держится до конца транзакции и берётся раньше блокировок строк значений
+func lockAttribute(ctx context.Context, q sqlx.ExecerContext, attributeId int64) error {
+	_, err := q.ExecContext(ctx, "SELECT pg_advisory_xact_lock($1, $2)", attributeLockKey, attributeId)
+	if err != nil {
+		return fmt.Errorf("failed to lock attribute %d: %v", attributeId, err)
+	}
+	return nil
+}
+
+func (r *Repository) GetValueAliases(ctx context.Context, optionId int64) (model.AttributeValueAliasList, error) {
+	var res model.AttributeValueAliasList
+
+	query, args, err := sq.
+		Select(
+			"id",
+			"option_id",
+			"attribute_id",
+			"alias",
+			"created_at",
+		).
+		From(attributeValueAliasesTable).
+		Where(sq.Eq{"option_id": optionId}).
+		OrderBy("id").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %v", err)
+	}
+
+	err = r.db(ctx).SelectContext(ctx, &res, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get value aliases: %v", err)
+	}
+
+	return res, nil
+}
+
+func (r *Repository) GetAttributeAliases(ctx context.Context, attributeId int64) (model.AttributeValueAliasList, error) {
+	var res model.AttributeValueAliasList
+
+	query, args, err := sq.
+		Select(
+			"id",
+			"option_id",
+			"attribute_id",
+			"alias",
+			"created_at",
+		).
+		From(attributeValueAliasesTable).
+		Where(sq.Eq{"attribute_id": attributeId}).
+		OrderBy("id").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %v", err)
+	}
+
+	err = r.db(ctx).SelectContext(ctx, &res, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get attribute aliases: %v", err)
+	}
+
+	return res, nil
+}
+
+func (r *Repository) GetValueAlias(ctx context.Context, id int64) (model.AttributeValueAlias, error) {
+	var res model.AttributeValueAlias
+
+	query, args, err := sq.
+		Select(
+			"id",
+			"option_id",
+			"attribute_id",
+			"alias",
+			"created_at",
+		).
+		From(attributeValueAliasesTable).
+		Where(sq.Eq{"id": id}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return model.AttributeValueAlias{}, fmt.Errorf("failed to build query: %v", err)
+	}
+
+	err = r.db(ctx).GetContext(ctx, &res, query, args...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.AttributeValueAlias{}, model.ErrNotFound
+		}
+		return model.AttributeValueAlias{}, fmt.Errorf("failed to get value alias: %v", err)
+	}
+
+	return res, nil
+}
+
+// AddValueAlias при совпадении с существующим синонимом атрибута или с неудалённым значением атрибута
+// возвращает *model.DuplicateValueError с этим значением
+func (r *Repository) AddValueAlias(ctx context.Context, in model.AttributeValueAlias) (int64, error) {
+	var id int64
+
+	err := r.withTx(ctx, func(tx *sqlx.Tx) error {
+		err := lockAttribute(ctx, tx, in.AttributeID)
+		if err != nil {
+			return err
+		}
+
+		existing, err := getValueByNormalized(ctx, tx, in.AttributeID, in.Alias)
+		if err == nil {
+			return &model.DuplicateValueError{Existing: existing}
+		}
+		if !errors.Is(err, model.ErrNotFound) {
+			return err
+		}
+
+		query, args, err := sq.
+			Insert(attributeValueAliasesTable).
+			Columns("option_id", "attribute_id", "alias", "normalized_alias").
+			Values(in.OptionID, in.AttributeID, in.Alias, model.NormalizeValue(in.Alias)).
+			Suffix("ON CONFLICT (attribute_id, normalized_alias) DO NOTHING RETURNING id").
+			PlaceholderFormat(sq.Dollar).
+			ToSql()
+		if err != nil {
+			return fmt.Errorf("failed to build query: %v", err)
+		}
+
+		err = tx.GetContext(ctx, &id, query, args...)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				existing, err := getAliasedValue(ctx, tx, in.AttributeID, in.Alias, 0)
+				if err != nil {
+					if errors.Is(err, model.ErrNotFound) {
+						return fmt.Errorf("alias %q: %w", in.Alias, model.ErrAlreadyExists)
+					}
+					return err
+				}
+				return &model.DuplicateValueError{Existing: existing}
+			}
+			return fmt.Errorf("failed to add value alias: %v", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+func (r *Repository) DeleteValueAlias(ctx context.Context, id int64) error {
+	query, args, err := sq.
+		Delete(attributeValueAliasesTable).
+		Where(sq.Eq{"id": id}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %v", err)
+	}
+
+	res, err := r.db(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to delete value alias: %v", err)
+	}
+
+	return checkAffected(res)
+}
+
+// deleteOwnAlias удаляет синоним optionId, совпадающий с value после нормализации, если он есть
+func deleteOwnAlias(ctx context.Context, tx *sqlx.Tx, optionId int64, value string) error {
+	query, args, err := sq.
+		Delete(attributeValueAliasesTable).
+		Where(sq.Eq{"option_id": optionId, "normalized_alias": model.NormalizeValue(value)}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %v", err)
+	}
+
+	_, err = tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to delete value alias: %v", err)
+	}
+
+	return nil
+}
+
+// GetAliasedValue возвращает неудалённое значение атрибута, у которого есть синоним, совпадающий с value после нормализации
+func (r *Repository) GetAliasedValue(ctx context.Context, attributeId int64, value string) (model.AttributeValue, error) {
+	return getAliasedValue(ctx, r.db(ctx), attributeId, value, 0)
+}
+
+// getAliasedValue не учитывает синонимы значения exceptOptionId, 0 - учитывать все
+func getAliasedValue(ctx context.Context, q sqlx.QueryerContext, attributeId int64, value string, exceptOptionId int64) (model.AttributeValue, error) {
+	var res model.AttributeValue
+
+	where := sq.And{sq.Eq{"a.attribute_id": attributeId, "a.normalized_alias": model.NormalizeValue(value), "av.deleted_at": nil}}
+	if exceptOptionId != 0 {
+		where = append(where, sq.NotEq{"a.option_id": exceptOptionId})
+	}
+
+	query, args, err := sq.
+		Select(
+			"av.id",
+			"av.attribute_id",
+			"av.value",
+			"av.parent_id",
+			"av.deleted_at",
+		).
+		From(attributeValueAliasesTable + " a").
+		Join(attributeValuesTable + " av ON av.id = a.option_id").
+		Where(where).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return model.AttributeValue{}, fmt.Errorf("failed to build query: %v", err)
+	}
+
+	err = sqlx.GetContext(ctx, q, &res, query, args...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.AttributeValue{}, model.ErrNotFound
+		}
+		return model.AttributeValue{}, fmt.Errorf("failed to get aliased value: %v", err)
+	}
+
+	return res, nil
+}
+
+// getValueByNormalized возвращает неудалённое значение атрибута с любым родителем, совпадающее с value после нормализации
+func getValueByNormalized(ctx context.Context, q sqlx.QueryerContext, attributeId int64, value string) (model.AttributeValue, error) {
+	var res model.AttributeValue
+
+	query, args, err := sq.
+		Select(
+			"id",
+			"attribute_id",
+			"value",
+			"parent_id",
+			"deleted_at",
+		).
+		From(attributeValuesTable).
+		Where(sq.Eq{"attribute_id": attributeId, "normalized_value": model.NormalizeValue(value), "deleted_at": nil}).
+		OrderBy("id").
+		Limit(1).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return model.AttributeValue{}, fmt.Errorf("failed to build query: %v", err)
+	}
+
+	err = sqlx.GetContext(ctx, q, &res, query, args...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.AttributeValue{}, model.ErrNotFound
+		}
+		return model.AttributeValue{}, fmt.Errorf("failed to get value: %v", err)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,94 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/s21platform/optionhub-service/internal/model"
+)
+
+const auditLogTable = "audit_log"
+
+func (r *Repository) AddAuditRecord(ctx context.Context, in model.AuditRecord) error {
+	query, args, err := sq.
+		Insert(auditLogTable).
+		Columns("actor_uuid", "method", "entity_type", "entity_id", "before", "after", "request_id").
+		Values(in.ActorUuid, in.Method, in.EntityType, in.EntityID, jsonbValue(in.Before), jsonbValue(in.After), in.RequestID).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %v", err)
+	}
+
+	_, err = r.db(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to add audit record: %v", err)
+	}
+
+	return nil
+}
+
+// GetAuditLog возвращает до filter.PageSize+1 записей от новых к старым, чтобы вызывающий мог понять, есть ли следующая страница
+func (r *Repository) GetAuditLog(ctx context.Context, filter model.AuditLogFilter) (model.AuditRecordList, error) {
+	var res model.AuditRecordList
+
+	queryTmp := sq.
+		Select(
+			"id",
+			"actor_uuid",
+			"method",
+			"entity_type",
+			"entity_id",
+			"before",
+			"after",
+			"request_id",
+			"created_at",
+		).
+		From(auditLogTable)
+
+	if filter.EntityType != nil {
+		queryTmp = queryTmp.Where(sq.Eq{"entity_type": *filter.EntityType})
+	}
+	if filter.EntityID != nil {
+		queryTmp = queryTmp.Where(sq.Eq{"entity_id": *filter.EntityID})
+	}
+	if filter.ActorUuid != nil {
+		queryTmp = queryTmp.Where(sq.Eq{"actor_uuid": *filter.ActorUuid})
+	}
+	if filter.CreatedFrom != nil {
+		queryTmp = queryTmp.Where(sq.GtOrEq{"created_at": *filter.CreatedFrom})
+	}
+	if filter.CreatedTo != nil {
+		queryTmp = queryTmp.Where(sq.Lt{"created_at": *filter.CreatedTo})
+	}
+	if filter.BeforeID != nil {
+		queryTmp = queryTmp.Where(sq.Lt{"id": *filter.BeforeID})
+	}
+
+	query, args, err := queryTmp.
+		OrderBy("id DESC").
+		Limit(filter.PageSize + 1).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %v", err)
+	}
+
+	err = r.db(ctx).SelectContext(ctx, &res, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get audit log: %v", err)
+	}
+
+	return res, nil
+}
+
+// jsonbValue передаёт JSON строкой: []byte драйвер отправил бы как bytea
+func jsonbValue(data []byte) *string {
+	if data == nil {
+		return nil
+	}
+	s := string(data)
+	return &s
+}
@@ -0,0 +1,99 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/s21platform/optionhub-service/internal/model"
+)
+
+const attributeValueChangesTable = "attribute_value_changes"
+
+// GetAttributeValueChanges возвращает до limit изменений значений атрибута с версией больше afterVersion по порядку версий
+func (r *Repository) GetAttributeValueChanges(ctx context.Context, attributeId int64, afterVersion int64, limit uint64) ([]model.AttributeValueChange, error) {
+	var res []model.AttributeValueChange
+
+	query, args, err := sq.
+		Select(
+			"attribute_id",
+			"version",
+			"option_id",
+			"operation",
+			"value",
+			"parent_id",
+			"created_at",
+		).
+		From(attributeValueChangesTable).
+		Where(sq.Eq{"attribute_id": attributeId}).
+		Where(sq.Gt{"version": afterVersion}).
+		OrderBy("version").
+		Limit(limit).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %v", err)
+	}
+
+	err = r.db(ctx).SelectContext(ctx, &res, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get attribute value changes: %v", err)
+	}
+
+	return res, nil
+}
+
+// PruneAttributeValueChanges удаляет изменения каждого атрибута, кроме keep последних версий, и возвращает число удалённых.
+// Подписчику, отставшему сильнее, WatchAttribute вместо изменений отправит снимок
+func (r *Repository) PruneAttributeValueChanges(ctx context.Context, keep int64) (int64, error) {
+	query, args, err := sq.
+		Delete(attributeValueChangesTable+" c").
+		Suffix("USING "+attributesTable+" a WHERE a.id = c.attribute_id AND c.version <= a.version - ?", keep).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("failed to build query: %v", err)
+	}
+
+	res, err := r.db(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to prune attribute value changes: %v", err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get affected rows: %v", err)
+	}
+
+	return affected, nil
+}
+
+// LockAttributeVersion возвращает версию атрибута и до конца транзакции не даёт её изменить,
+// чтобы прочитанные следом значения соответствовали этой версии. Вызывается внутри WithTx
+func (r *Repository) LockAttributeVersion(ctx context.Context, attributeId int64) (int64, error) {
+	var version int64
+
+	query, args, err := sq.
+		Select("version").
+		From(attributesTable).
+		Where(sq.Eq{"id": attributeId}).
+		Suffix("FOR SHARE").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("failed to build query: %v", err)
+	}
+
+	err = r.db(ctx).GetContext(ctx, &version, query, args...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, model.ErrNotFound
+		}
+		return 0, fmt.Errorf("failed to lock attribute version: %v", err)
+	}
+
+	return version, nil
+}
@@ -0,0 +1,36 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+)
+
+const consumedMessagesTable = "consumed_messages"
+
+// MarkMessageConsumed запоминает id сообщения из Kafka; false означает, что сообщение уже было обработано
+func (r *Repository) MarkMessageConsumed(ctx context.Context, messageId string) (bool, error) {
+	query, args, err := sq.
+		Insert(consumedMessagesTable).
+		Columns("message_id").
+		Values(messageId).
+		Suffix("ON CONFLICT (message_id) DO NOTHING").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return false, fmt.Errorf("failed to build query: %v", err)
+	}
+
+	res, err := r.db(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		return false, fmt.Errorf("failed to mark message consumed: %v", err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get affected rows: %v", err)
+	}
+
+	return affected > 0, nil
+}
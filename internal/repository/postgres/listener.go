@@ -0,0 +1,57 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// канал, в который пишет триггер attribute_values_notify
+const attributeValuesChannel = "attribute_values_changed"
+
+const (
+	listenerMinReconnect = time.Second
+	listenerMaxReconnect = time.Minute
+	listenerPingInterval = 90 * time.Second
+)
+
+// ListenAttributeChanges вызывает onChange с id атрибута на каждое закоммиченное изменение его значений,
+// в том числе сделанное другими репликами. До подписки и между переподключениями уведомления могли потеряться,
+// поэтому после подписки и после каждого переподключения вызывается onReconnect. Блокируется до отмены ctx
+// или до ошибки подписки
+func (r *Repository) ListenAttributeChanges(ctx context.Context, onChange func(attributeId int64), onReconnect func()) error {
+	listener := pq.NewListener(r.conStr, listenerMinReconnect, listenerMaxReconnect, nil)
+	defer func() { _ = listener.Close() }()
+
+	err := listener.Listen(attributeValuesChannel)
+	if err != nil {
+		return fmt.Errorf("failed to listen %s: %v", attributeValuesChannel, err)
+	}
+	onReconnect()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case n := <-listener.Notify:
+			// nil приходит после переподключения
+			if n == nil {
+				onReconnect()
+				continue
+			}
+
+			attributeId, err := strconv.ParseInt(n.Extra, 10, 64)
+			if err != nil {
+				// уведомление не от attribute_values_notify, id атрибута неизвестен - сбрасываем всё
+				onReconnect()
+				continue
+			}
+			onChange(attributeId)
+		case <-time.After(listenerPingInterval):
+			go func() { _ = listener.Ping() }()
+		}
+	}
+}
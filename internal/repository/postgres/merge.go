@@ -0,0 +1,249 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+	"github.com/samber/lo"
+
+	"github.com/s21platform/optionhub-service/internal/model"
+)
+
+const attributeValueRedirectsTable = "attribute_value_redirects"
+
+// MergeAttributeValues сливает значение sourceId в targetId в одной транзакции: переносит потомков и синонимы source
+// под target, записывает редирект и помечает source удалённым.
+// Возвращает id перенесённых потомков. Если target лежит внутри source, возвращает model.ErrParentCycle.
+// Если у target уже есть потомок с тем же значением, что у потомка source, возвращает *model.DuplicateValueError
+// с потомком target - такие потомки нужно слить раньше
+func (r *Repository) MergeAttributeValues(ctx context.Context, sourceId, targetId int64) ([]int64, error) {
+	var moved []int64
+
+	err := r.withTx(ctx, func(tx *sqlx.Tx) error {
+		attributeId, err := getValueAttributeId(ctx, tx, sourceId)
+		if err != nil {
+			return err
+		}
+
+		// перенос потомков и синоним source требуют блокировки атрибута, берём её раньше строк
+		err = lockAttribute(ctx, tx, attributeId)
+		if err != nil {
+			return err
+		}
+
+		err = lockMergedValues(ctx, tx, sourceId, targetId)
+		if err != nil {
+			return err
+		}
+
+		// под блокировкой атрибута предков target никто не перепривяжет до конца слияния
+		var ancestors model.AttributeValueList
+		err = tx.SelectContext(ctx, &ancestors, ancestorsQuery, targetId)
+		if err != nil {
+			return fmt.Errorf("failed to get ancestors: %v", err)
+		}
+		if lo.ContainsBy(ancestors, func(a model.AttributeValue) bool { return a.Id == sourceId }) {
+			return fmt.Errorf("option %d is a descendant of option %d: %w", targetId, sourceId, model.ErrParentCycle)
+		}
+
+		existing, err := getChildConflict(ctx, tx, sourceId, targetId)
+		if err == nil {
+			return &model.DuplicateValueError{Existing: existing}
+		}
+		if !errors.Is(err, model.ErrNotFound) {
+			return err
+		}
+
+		// удалённые потомки тоже переезжают, чтобы их пути продолжали строиться через живое значение
+		query, args, err := sq.
+			Update(attributeValuesTable).
+			Set("parent_id", targetId).
+			Where(sq.Eq{"parent_id": sourceId}).
+			Suffix("RETURNING id").
+			PlaceholderFormat(sq.Dollar).
+			ToSql()
+		if err != nil {
+			return fmt.Errorf("failed to build query: %v", err)
+		}
+
+		err = tx.SelectContext(ctx, &moved, query, args...)
+		if err != nil {
+			if parentErr := parentConstraintError(err); parentErr != nil {
+				return parentErr
+			}
+			return fmt.Errorf("failed to move children: %v", err)
+		}
+
+		query, args, err = sq.
+			Update(attributeValueAliasesTable).
+			Set("option_id", targetId).
+			Where(sq.Eq{"option_id": sourceId}).
+			PlaceholderFormat(sq.Dollar).
+			ToSql()
+		if err != nil {
+			return fmt.Errorf("failed to build query: %v", err)
+		}
+
+		_, err = tx.ExecContext(ctx, query, args...)
+		if err != nil {
+			return fmt.Errorf("failed to move aliases: %v", err)
+		}
+
+		// значения, ранее слитые в source, теперь ведут сразу в target
+		query, args, err = sq.
+			Update(attributeValueRedirectsTable).
+			Set("target_id", targetId).
+			Where(sq.Eq{"target_id": sourceId}).
+			PlaceholderFormat(sq.Dollar).
+			ToSql()
+		if err != nil {
+			return fmt.Errorf("failed to build query: %v", err)
+		}
+
+		_, err = tx.ExecContext(ctx, query, args...)
+		if err != nil {
+			return fmt.Errorf("failed to update redirects: %v", err)
+		}
+
+		query, args, err = sq.
+			Insert(attributeValueRedirectsTable).
+			Columns("source_id", "target_id").
+			Values(sourceId, targetId).
+			PlaceholderFormat(sq.Dollar).
+			ToSql()
+		if err != nil {
+			return fmt.Errorf("failed to build query: %v", err)
+		}
+
+		_, err = tx.ExecContext(ctx, query, args...)
+		if err != nil {
+			return fmt.Errorf("failed to add redirect: %v", err)
+		}
+
+		query, args, err = sq.
+			Update(attributeValuesTable).
+			Set("deleted_at", sq.Expr("CURRENT_TIMESTAMP")).
+			Where(sq.Eq{"id": sourceId}).
+			PlaceholderFormat(sq.Dollar).
+			ToSql()
+		if err != nil {
+			return fmt.Errorf("failed to build query: %v", err)
+		}
+
+		_, err = tx.ExecContext(ctx, query, args...)
+		if err != nil {
+			return fmt.Errorf("failed to delete merged value: %v", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return moved, nil
+}
+
+// lockMergedValues блокирует оба значения в порядке id, чтобы встречные слияния не взаимоблокировались
+func lockMergedValues(ctx context.Context, tx *sqlx.Tx, sourceId, targetId int64) error {
+	var values model.AttributeValueList
+
+	query, args, err := sq.
+		Select(
+			"id",
+			"attribute_id",
+			"value",
+			"parent_id",
+			"deleted_at",
+		).
+		From(attributeValuesTable).
+		Where(sq.Eq{"id": []int64{sourceId, targetId}, "deleted_at": nil}).
+		OrderBy("id").
+		Suffix("FOR UPDATE").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %v", err)
+	}
+
+	err = tx.SelectContext(ctx, &values, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to lock attribute values: %v", err)
+	}
+	if len(values) != 2 {
+		return model.ErrNotFound
+	}
+
+	if values[0].Id != sourceId {
+		values[0], values[1] = values[1], values[0]
+	}
+	if values[0].AttributeId != values[1].AttributeId {
+		return fmt.Errorf("option %d belongs to attribute %d, option %d to %d: %w",
+			values[0].Id, values[0].AttributeId, values[1].Id, values[1].AttributeId, model.ErrParentAttributeMismatch)
+	}
+
+	return nil
+}
+
+// getChildConflict ищет неудалённого потомка target, с которым совпал бы потомок source после переноса
+func getChildConflict(ctx context.Context, q sqlx.QueryerContext, sourceId, targetId int64) (model.AttributeValue, error) {
+	var res model.AttributeValue
+
+	query, args, err := sq.
+		Select(
+			"t.id",
+			"t.attribute_id",
+			"t.value",
+			"t.parent_id",
+			"t.deleted_at",
+		).
+		From(attributeValuesTable + " t").
+		Join(attributeValuesTable + " s ON s.normalized_value = t.normalized_value").
+		Where(sq.Eq{"t.parent_id": targetId, "t.deleted_at": nil, "s.parent_id": sourceId, "s.deleted_at": nil}).
+		OrderBy("t.id").
+		Limit(1).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return model.AttributeValue{}, fmt.Errorf("failed to build query: %v", err)
+	}
+
+	err = sqlx.GetContext(ctx, q, &res, query, args...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.AttributeValue{}, model.ErrNotFound
+		}
+		return model.AttributeValue{}, fmt.Errorf("failed to check children: %v", err)
+	}
+
+	return res, nil
+}
+
+// GetValueRedirects возвращает редиректы для тех ids, которые были слиты в другие значения
+func (r *Repository) GetValueRedirects(ctx context.Context, ids []int64) ([]model.ValueRedirect, error) {
+	var res []model.ValueRedirect
+
+	query, args, err := sq.
+		Select(
+			"source_id",
+			"target_id",
+		).
+		From(attributeValueRedirectsTable).
+		Where(sq.Eq{"source_id": ids}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %v", err)
+	}
+
+	err = r.db(ctx).SelectContext(ctx, &res, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get value redirects: %v", err)
+	}
+
+	return res, nil
+}
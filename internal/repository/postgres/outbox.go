@@ -0,0 +1,248 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+	"github.com/samber/lo"
+
+	"github.com/s21platform/optionhub-service/internal/model"
+)
+
+const outboxTable = "outbox"
+
+func (r *Repository) AddOutboxMessage(ctx context.Context, msg model.OutboxMessage) error {
+	query, args, err := sq.
+		Insert(outboxTable).
+		Columns("topic", "message_key", "payload", "content_type").
+		Values(msg.Topic, msg.Key, string(msg.Payload), msg.ContentType).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %v", err)
+	}
+
+	_, err = r.db(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to add outbox message: %v", err)
+	}
+
+	return nil
+}
+
+// outboxLockNamespace - первый ключ advisory-блокировок outbox, второй - хэш топика и ключа сообщения
+const outboxLockNamespace = 1
+
+const (
+	// outboxClaimTimeout - на сколько пачка закрепляется за репликой
+	outboxClaimTimeout = 2 * time.Minute
+	// outboxPublishTimeout - сколько реплика публикует пачку. Меньше outboxClaimTimeout, чтобы успеть отметить
+	// результат, пока другая реплика не забрала те же сообщения
+	outboxPublishTimeout = time.Minute
+)
+
+// ProcessOutbox берёт до limit неотправленных сообщений и по порядку id передаёт их в handle.
+// Пачка закрепляется за репликой в короткой транзакции: на каждый ключ сообщения (для событий каталога - attribute_id)
+// берётся advisory-блокировка, а ключи, у которых уже есть закреплённые сообщения, пропускаются. Поэтому сообщения
+// одного ключа публикует только одна реплика, и блокировки не держатся, пока идёт запись в Kafka.
+// Результат отмечается второй транзакцией по id. После первой ошибки остальные сообщения этого ключа в пачке
+// не трогаются, чтобы не нарушить порядок: упавшее остаётся неотправленным, у него растёт счётчик попыток,
+// и следующий проход начнёт с него. Сообщения других ключей обрабатываются дальше, первая ошибка возвращается вызывающему
+func (r *Repository) ProcessOutbox(ctx context.Context, limit uint64, handle func(ctx context.Context, msg model.OutboxMessage) error) (int, error) {
+	messages, err := r.claimOutbox(ctx, limit)
+	if err != nil {
+		return 0, err
+	}
+	if len(messages) == 0 {
+		return 0, nil
+	}
+
+	var (
+		sentIds     []int64
+		releasedIds []int64
+		failed      = make(map[int64]error)
+		handleErr   error
+	)
+
+	publishCtx, cancel := context.WithTimeout(ctx, outboxPublishTimeout)
+	defer cancel()
+
+	failedKeys := make(map[string]struct{})
+	for _, msg := range messages {
+		key := msg.Topic + "/" + msg.Key
+		if _, ok := failedKeys[key]; ok {
+			releasedIds = append(releasedIds, msg.ID)
+			continue
+		}
+
+		err = handle(publishCtx, msg)
+		if err != nil {
+			failedKeys[key] = struct{}{}
+			failed[msg.ID] = err
+			if handleErr == nil {
+				handleErr = err
+			}
+			continue
+		}
+
+		sentIds = append(sentIds, msg.ID)
+	}
+
+	err = r.withTx(ctx, func(tx *sqlx.Tx) error {
+		err := markOutboxSent(ctx, tx, sentIds)
+		if err != nil {
+			return err
+		}
+
+		for id, cause := range failed {
+			err = markOutboxFailed(ctx, tx, id, cause)
+			if err != nil {
+				return err
+			}
+		}
+
+		return releaseOutbox(ctx, tx, releasedIds)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	if handleErr != nil {
+		return len(sentIds), fmt.Errorf("failed to handle outbox message: %w", handleErr)
+	}
+
+	return len(sentIds), nil
+}
+
+// claimOutbox закрепляет за репликой до limit сообщений на outboxClaimTimeout и сразу фиксирует транзакцию
+func (r *Repository) claimOutbox(ctx context.Context, limit uint64) ([]model.OutboxMessage, error) {
+	var messages []model.OutboxMessage
+
+	err := r.withTx(ctx, func(tx *sqlx.Tx) error {
+		query, args, err := sq.
+			Select(
+				"id",
+				"topic",
+				"message_key",
+				"payload",
+				"content_type",
+				"attempts",
+			).
+			From(outboxTable).
+			Where(sq.Eq{"sent_at": nil}).
+			Where("NOT EXISTS (SELECT 1 FROM outbox c WHERE c.topic = outbox.topic AND c.message_key = outbox.message_key "+
+				"AND c.sent_at IS NULL AND c.claimed_until > CURRENT_TIMESTAMP)").
+			Where("pg_try_advisory_xact_lock(?, hashtext(topic || '/' || message_key))", outboxLockNamespace).
+			OrderBy("id").
+			Limit(limit).
+			Suffix("FOR UPDATE SKIP LOCKED").
+			PlaceholderFormat(sq.Dollar).
+			ToSql()
+		if err != nil {
+			return fmt.Errorf("failed to build query: %v", err)
+		}
+
+		err = tx.SelectContext(ctx, &messages, query, args...)
+		if err != nil {
+			return fmt.Errorf("failed to get outbox messages: %v", err)
+		}
+		if len(messages) == 0 {
+			return nil
+		}
+
+		query, args, err = sq.
+			Update(outboxTable).
+			Set("claimed_until", sq.Expr("CURRENT_TIMESTAMP + make_interval(secs => ?)", outboxClaimTimeout.Seconds())).
+			Where(sq.Eq{"id": lo.Map(messages, func(m model.OutboxMessage, _ int) int64 { return m.ID })}).
+			PlaceholderFormat(sq.Dollar).
+			ToSql()
+		if err != nil {
+			return fmt.Errorf("failed to build query: %v", err)
+		}
+
+		_, err = tx.ExecContext(ctx, query, args...)
+		if err != nil {
+			return fmt.Errorf("failed to claim outbox messages: %v", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return messages, nil
+}
+
+func markOutboxSent(ctx context.Context, tx *sqlx.Tx, ids []int64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	query, args, err := sq.
+		Update(outboxTable).
+		Set("sent_at", sq.Expr("CURRENT_TIMESTAMP")).
+		Set("attempts", sq.Expr("attempts + 1")).
+		Set("claimed_until", nil).
+		Where(sq.Eq{"id": ids}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %v", err)
+	}
+
+	_, err = tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to mark outbox messages sent: %v", err)
+	}
+
+	return nil
+}
+
+func markOutboxFailed(ctx context.Context, tx *sqlx.Tx, id int64, cause error) error {
+	query, args, err := sq.
+		Update(outboxTable).
+		Set("attempts", sq.Expr("attempts + 1")).
+		Set("last_error", cause.Error()).
+		Set("claimed_until", nil).
+		Where(sq.Eq{"id": id}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %v", err)
+	}
+
+	_, err = tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to mark outbox message failed: %v", err)
+	}
+
+	return nil
+}
+
+// releaseOutbox снимает закрепление с сообщений, которые не публиковались из-за ошибки раньше них по ключу
+func releaseOutbox(ctx context.Context, tx *sqlx.Tx, ids []int64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	query, args, err := sq.
+		Update(outboxTable).
+		Set("claimed_until", nil).
+		Where(sq.Eq{"id": ids}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %v", err)
+	}
+
+	_, err = tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to release outbox messages: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,101 @@
+package postgres
+
+// path защищает от зацикливания, если в данных уже есть цикл
+const ancestorsQuery = `
+WITH RECURSIVE ancestors AS (
+    SELECT id, attribute_id, value, parent_id, deleted_at, 0 AS depth, ARRAY [id] AS path
+    FROM attribute_values
+    WHERE id = $1
+    UNION ALL
+    SELECT av.id, av.attribute_id, av.value, av.parent_id, av.deleted_at, a.depth + 1, a.path || av.id
+    FROM attribute_values av
+             JOIN ancestors a ON av.id = a.parent_id
+    WHERE NOT av.id = ANY (a.path)
+)
+SELECT id, attribute_id, value, parent_id, deleted_at
+FROM ancestors
+WHERE depth > 0
+ORDER BY depth DESC`
+
+// searchValuesQuery ищет значения атрибута по префиксу и триграммному сходству и собирает путь от корня до каждого найденного значения.
+// Совпадение с синонимом возвращает само значение; если совпало и значение, и синоним, берётся лучшее, при равенстве - значение.
+// $1 - attribute_id, $2 - запрос в нижнем регистре, $3 - LIKE-шаблон префикса, $4 - лимит
+const searchValuesQuery = `
+WITH RECURSIVE matches AS (
+    SELECT id, NULL::TEXT AS alias,
+           lower(value) LIKE $3 AS is_prefix,
+           similarity(lower(value), $2) AS score
+    FROM attribute_values
+    WHERE attribute_id = $1
+      AND deleted_at IS NULL
+      AND (lower(value) LIKE $3 OR lower(value) % $2)
+    UNION ALL
+    SELECT option_id, alias,
+           lower(alias) LIKE $3,
+           similarity(lower(alias), $2)
+    FROM attribute_value_aliases
+    WHERE attribute_id = $1
+      AND (lower(alias) LIKE $3 OR lower(alias) % $2)
+),
+best AS (
+    SELECT DISTINCT ON (id) id, alias, is_prefix, score
+    FROM matches
+    ORDER BY id, is_prefix DESC, score DESC, alias NULLS FIRST
+),
+hits AS (
+    SELECT av.id, av.value, av.parent_id, b.alias, b.is_prefix, b.score
+    FROM best b
+             JOIN attribute_values av ON av.id = b.id AND av.deleted_at IS NULL
+    ORDER BY b.is_prefix DESC, b.score DESC, av.value
+    LIMIT $4
+),
+paths AS (
+    SELECT h.id AS hit_id, h.parent_id AS next_id, ARRAY [h.value] AS names, ARRAY [h.id] AS ids
+    FROM hits h
+    UNION ALL
+    SELECT p.hit_id, av.parent_id, av.value || p.names, av.id || p.ids
+    FROM paths p
+             JOIN attribute_values av ON av.id = p.next_id
+    WHERE NOT av.id = ANY (p.ids)
+)
+SELECT h.id, h.value, h.parent_id, h.alias, p.names AS path, p.ids AS path_ids
+FROM hits h
+         JOIN paths p ON p.hit_id = h.id AND p.next_id IS NULL
+ORDER BY h.is_prefix DESC, h.score DESC, h.value`
+
+// subtreeQuery возвращает неудалённое значение и его потомков до глубины $2 (0 - без ограничения).
+// has_children показывает, есть ли у значения потомки, даже если они не вошли в выборку по глубине
+const subtreeQuery = `
+WITH RECURSIVE subtree AS (
+    SELECT id, attribute_id, value, parent_id, deleted_at, 0 AS depth, ARRAY [id] AS path
+    FROM attribute_values
+    WHERE id = $1
+      AND deleted_at IS NULL
+    UNION ALL
+    SELECT av.id, av.attribute_id, av.value, av.parent_id, av.deleted_at, s.depth + 1, s.path || av.id
+    FROM attribute_values av
+             JOIN subtree s ON av.parent_id = s.id
+    WHERE av.deleted_at IS NULL
+      AND NOT av.id = ANY (s.path)
+      AND ($2::int = 0 OR s.depth < $2::int)
+)
+SELECT id, attribute_id, value, parent_id, deleted_at,
+       EXISTS (SELECT 1 FROM attribute_values c WHERE c.parent_id = subtree.id AND c.deleted_at IS NULL) AS has_children
+FROM subtree
+ORDER BY depth, id`
+
+// pathsQuery собирает путь от корня для каждого значения из массива $1
+const pathsQuery = `
+WITH RECURSIVE paths AS (
+    SELECT av.id AS option_id, av.parent_id AS next_id, ARRAY [av.value] AS names, ARRAY [av.id] AS ids
+    FROM attribute_values av
+    WHERE av.id = ANY ($1)
+    UNION ALL
+    SELECT p.option_id, av.parent_id, av.value || p.names, av.id || p.ids
+    FROM paths p
+             JOIN attribute_values av ON av.id = p.next_id
+    WHERE NOT av.id = ANY (p.ids)
+)
+SELECT option_id, names AS path, ids AS path_ids
+FROM paths
+WHERE next_id IS NULL`
@@ -2,23 +2,48 @@ package postgres
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
 	"log"
+	"strings"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/jmoiron/sqlx"
-	_ "github.com/lib/pq" // Импорт драйвера PostgreSQL
+	"github.com/lib/pq" // Импорт драйвера PostgreSQL
 
 	"github.com/s21platform/optionhub-service/internal/config"
 	"github.com/s21platform/optionhub-service/internal/model"
 )
 
+// значения check_violation, которые выставляет триггер attribute_values_check_parent
 const (
+	parentAttributeConstraint = "attribute_values_parent_attribute_check"
+	parentCycleConstraint     = "attribute_values_parent_cycle_check"
+)
+
+// уникальный индекс по (attribute_id, parent_id, normalized_value) среди неудалённых значений
+const (
+	normalizedValueConstraint = "attribute_values_normalized_uniq"
+	normalizedValueConflict   = "ON CONFLICT (attribute_id, COALESCE(parent_id, 0), normalized_value) WHERE deleted_at IS NULL"
+	uniqueViolation           = "23505"
+)
+
+// частичный уникальный индекс option_requests_pending_uniq по тому же ключу среди заявок на рассмотрении
+const (
+	pendingRequestConstraint = "option_requests_pending_uniq"
+	pendingRequestConflict   = "ON CONFLICT (attribute_id, COALESCE(parent_id, 0), normalized_value) WHERE status = 'pending'"
+)
+
+const (
+	attributesTable      = "attributes"
 	attributeValuesTable = "attribute_values"
+	optionRequestsTable  = "option_requests"
 )
 
 type Repository struct {
 	connection *sqlx.DB
+	conStr     string
 }
 
 func New(cfg *config.Config) *Repository {
@@ -32,6 +57,7 @@ func New(cfg *config.Config) *Repository {
 
 	return &Repository{
 		connection: conn,
+		conStr:     conStr,
 	}
 }
 
@@ -53,7 +79,7 @@ func (r *Repository) GetAttributeValueById(ctx context.Context, ids []int64) ([]
 		return nil, fmt.Errorf("failed to build query: %v", err)
 	}
 
-	err = r.connection.SelectContext(ctx, &res, query, args...)
+	err = r.db(ctx).SelectContext(ctx, &res, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to execute query: %v", err)
 	}
@@ -61,78 +87,1147 @@ func (r *Repository) GetAttributeValueById(ctx context.Context, ids []int64) ([]
 	return res, nil
 }
 
-func (r *Repository) GetOptionRequests(ctx context.Context) (model.OptionRequestList, error) {
-	var res model.OptionRequestList
+// GetOptionRequests возвращает до filter.PageSize+1 заявок, чтобы вызывающий мог понять, есть ли следующая страница,
+// и общее число заявок, подходящих под фильтр
+func (r *Repository) GetOptionRequests(ctx context.Context, filter model.OptionRequestFilter) (model.OptionRequestList, int64, error) {
+	var (
+		res   model.OptionRequestList
+		total int64
+	)
 
-	query, args, err := sq.
+	where := optionRequestsWhere(filter)
+
+	countQuery, countArgs, err := sq.
+		Select("COUNT(*)").
+		From(optionRequestsTable).
+		Where(where).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to build count query: %v", err)
+	}
+
+	err = r.db(ctx).GetContext(ctx, &total, countQuery, countArgs...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count option requests: %v", err)
+	}
+
+	direction, cmp := "DESC", "<"
+	if filter.Ascending {
+		direction, cmp = "ASC", ">"
+	}
+
+	queryTmp := sq.
 		Select(
 			"id",
 			"attribute_id",
 			"value",
+			"parent_id",
 			"user_uuid",
+			"status",
 			"created_at",
 		).
-		From("option_requests").
-		OrderBy("id DESC").
+		From(optionRequestsTable).
+		Where(where)
+
+	switch filter.SortBy {
+	case model.OptionRequestSortByCreatedAt:
+		if filter.After != nil {
+			queryTmp = queryTmp.Where(sq.Expr("(created_at, id) "+cmp+" (?, ?)", filter.After.CreatedAt, filter.After.ID))
+		}
+		queryTmp = queryTmp.OrderBy("created_at "+direction, "id "+direction)
+	default:
+		if filter.After != nil {
+			queryTmp = queryTmp.Where(sq.Expr("id "+cmp+" ?", filter.After.ID))
+		}
+		queryTmp = queryTmp.OrderBy("id " + direction)
+	}
+
+	query, args, err := queryTmp.
+		Limit(filter.PageSize + 1).
 		PlaceholderFormat(sq.Dollar).
 		ToSql()
 
 	if err != nil {
-		return nil, fmt.Errorf("failed to build query: %v", err)
+		return nil, 0, fmt.Errorf("failed to build query: %v", err)
 	}
 
-	err = r.connection.SelectContext(ctx, &res, query, args...)
+	err = r.db(ctx).SelectContext(ctx, &res, query, args...)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get option requests: %v", err)
+		return nil, 0, fmt.Errorf("failed to get option requests: %v", err)
 	}
 
-	return res, nil
+	return res, total, nil
+}
+
+func optionRequestsWhere(filter model.OptionRequestFilter) sq.And {
+	where := sq.And{}
+
+	if filter.Status != nil {
+		where = append(where, sq.Eq{"status": *filter.Status})
+	}
+	if filter.AttributeID != nil {
+		where = append(where, sq.Eq{"attribute_id": *filter.AttributeID})
+	}
+	if filter.UserUuid != nil {
+		where = append(where, sq.Eq{"user_uuid": *filter.UserUuid})
+	}
+	if filter.CreatedFrom != nil {
+		where = append(where, sq.GtOrEq{"created_at": *filter.CreatedFrom})
+	}
+	if filter.CreatedTo != nil {
+		where = append(where, sq.Lt{"created_at": *filter.CreatedTo})
+	}
+
+	return where
+}
+
+func (r *Repository) AddAttributeValue(ctx context.Context, in model.AttributeValue) (int64, error) {
+	var id int64
+
+	err := r.withTx(ctx, func(tx *sqlx.Tx) error {
+		var err error
+		id, err = insertAttributeValue(ctx, tx, in)
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return id, nil
 }
 
-func (r *Repository) AddAttributeValue(ctx context.Context, in model.AttributeValue) error {
-	queryTmp := sq.Insert("attribute_values").
-		Columns("attribute_id", "value").
-		Values(in.AttributeId, in.Value)
+// insertAttributeValue при совпадении с существующим значением того же родителя или с синонимом атрибута после нормализации
+// возвращает *model.DuplicateValueError. ON CONFLICT не прерывает транзакцию, поэтому существующее значение можно прочитать в ней же
+func insertAttributeValue(ctx context.Context, tx *sqlx.Tx, in model.AttributeValue) (int64, error) {
+	var id int64
+
+	err := lockAttribute(ctx, tx, in.AttributeId)
+	if err != nil {
+		return 0, err
+	}
+
+	err = checkAliasConflict(ctx, tx, in.AttributeId, 0, in.Value)
+	if err != nil {
+		return 0, err
+	}
+
+	queryTmp := sq.Insert(attributeValuesTable).
+		Columns("attribute_id", "value", "normalized_value").
+		Values(in.AttributeId, in.Value, model.NormalizeValue(in.Value))
 
 	if in.ParentId != nil {
 		queryTmp = queryTmp.Columns("parent_id").Values(*in.ParentId)
 	}
 
-	sqlQuery, args, err := queryTmp.PlaceholderFormat(sq.Dollar).ToSql()
+	sqlQuery, args, err := queryTmp.Suffix(normalizedValueConflict + " DO NOTHING RETURNING id").PlaceholderFormat(sq.Dollar).ToSql()
+
+	if err != nil {
+		return 0, fmt.Errorf("failed to build SQL query: %v", err)
+	}
+
+	err = tx.GetContext(ctx, &id, sqlQuery, args...)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, duplicateValueError(ctx, tx, in)
+		}
+		if parentErr := parentConstraintError(err); parentErr != nil {
+			return 0, parentErr
+		}
+		return 0, fmt.Errorf("failed to add attribute into postgres: %v", err)
+	}
+
+	return id, nil
+}
+
+// GetDuplicateValue ищет неудалённое значение того же родителя, совпадающее с in после нормализации; само in не учитывается
+func (r *Repository) GetDuplicateValue(ctx context.Context, in model.AttributeValue) (model.AttributeValue, error) {
+	return getDuplicateValue(ctx, r.db(ctx), in)
+}
+
+func getDuplicateValue(ctx context.Context, q sqlx.QueryerContext, in model.AttributeValue) (model.AttributeValue, error) {
+	var res model.AttributeValue
+
+	query, args, err := sq.
+		Select(
+			"id",
+			"attribute_id",
+			"value",
+			"parent_id",
+			"deleted_at",
+		).
+		From(attributeValuesTable).
+		Where(sq.Eq{"attribute_id": in.AttributeId, "normalized_value": model.NormalizeValue(in.Value), "deleted_at": nil}).
+		Where("parent_id IS NOT DISTINCT FROM ?", in.ParentId).
+		Where(sq.NotEq{"id": in.Id}).
+		Limit(1).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return model.AttributeValue{}, fmt.Errorf("failed to build query: %v", err)
+	}
+
+	err = sqlx.GetContext(ctx, q, &res, query, args...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.AttributeValue{}, model.ErrNotFound
+		}
+		return model.AttributeValue{}, fmt.Errorf("failed to get duplicate value: %v", err)
+	}
+
+	return res, nil
+}
+
+// checkAliasConflict проверяет, что value не совпадает с синонимом другого неудалённого значения, кроме optionId.
+// Вызывается под lockAttribute
+func checkAliasConflict(ctx context.Context, tx *sqlx.Tx, attributeId, optionId int64, value string) error {
+	existing, err := getAliasedValue(ctx, tx, attributeId, value, optionId)
+	if err == nil {
+		return &model.DuplicateValueError{Existing: existing}
+	}
+	if !errors.Is(err, model.ErrNotFound) {
+		return err
+	}
+
+	return nil
+}
+
+func getValueAttributeId(ctx context.Context, q sqlx.QueryerContext, id int64) (int64, error) {
+	var attributeId int64
+
+	err := sqlx.GetContext(ctx, q, &attributeId, "SELECT attribute_id FROM attribute_values WHERE id = $1", id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, model.ErrNotFound
+		}
+		return 0, fmt.Errorf("failed to get attribute value: %v", err)
+	}
+
+	return attributeId, nil
+}
+
+func duplicateValueError(ctx context.Context, q sqlx.QueryerContext, in model.AttributeValue) error {
+	existing, err := getDuplicateValue(ctx, q, in)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			// дубль успели удалить между вставкой и чтением
+			return fmt.Errorf("value %q: %w", in.Value, model.ErrAlreadyExists)
+		}
+		return err
+	}
+	return &model.DuplicateValueError{Existing: existing}
+}
+
+// normalizeLockKey - advisory-блокировка заполнения normalized_value, чтобы реплики не сливали одни и те же дубли
+const normalizeLockKey = 2
+
+// GetUnnormalizedValues возвращает до limit значений без normalized_value по возрастанию id. Вызывается в транзакции:
+// берёт advisory-блокировку до её конца, поэтому заполнением ключей одновременно занимается одна реплика
+func (r *Repository) GetUnnormalizedValues(ctx context.Context, limit uint64) (model.AttributeValueList, error) {
+	var res model.AttributeValueList
+
+	_, err := r.db(ctx).ExecContext(ctx, "SELECT pg_advisory_xact_lock($1)", normalizeLockKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to lock normalization: %v", err)
+	}
+
+	query, args, err := sq.
+		Select(
+			"id",
+			"attribute_id",
+			"value",
+			"parent_id",
+			"deleted_at",
+		).
+		From(attributeValuesTable).
+		Where(sq.Eq{"normalized_value": nil}).
+		OrderBy("id").
+		Limit(limit).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %v", err)
+	}
 
+	err = r.db(ctx).SelectContext(ctx, &res, query, args...)
 	if err != nil {
-		return fmt.Errorf("failed to build SQL query: %v", err)
+		return nil, fmt.Errorf("failed to get unnormalized values: %v", err)
 	}
 
-	_, err = r.connection.ExecContext(ctx, sqlQuery, args...)
+	return res, nil
+}
+
+// SetNormalizedValue проставляет ключ значению, у которого его ещё нет
+func (r *Repository) SetNormalizedValue(ctx context.Context, in model.AttributeValue) error {
+	query, args, err := sq.
+		Update(attributeValuesTable).
+		Set("normalized_value", model.NormalizeValue(in.Value)).
+		Where(sq.Eq{"id": in.Id, "normalized_value": nil}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %v", err)
+	}
 
+	_, err = r.db(ctx).ExecContext(ctx, query, args...)
 	if err != nil {
-		return fmt.Errorf("failed to add attribute into postgres: %v", err)
+		if isUniqueViolation(err, normalizedValueConstraint) {
+			return fmt.Errorf("option %d: %w", in.Id, model.ErrAlreadyExists)
+		}
+		return fmt.Errorf("failed to set normalized value: %v", err)
 	}
 
 	return nil
 }
 
-func (r *Repository) GetValuesByAttributeId(ctx context.Context, attributeId int64) (model.AttributeValueList, error) {
+func (r *Repository) GetValuesByAttributeId(ctx context.Context, attributeId int64, includeDeleted bool) (model.AttributeValueList, error) {
 	var values model.AttributeValueList
 
-	query, args, err := sq.
+	queryTmp := sq.
+		Select(
+			"id",
+			"value",
+			"parent_id",
+			"deleted_at",
+		).
+		From(attributeValuesTable).
+		Where(sq.Eq{"attribute_id": attributeId})
+
+	if !includeDeleted {
+		queryTmp = queryTmp.Where(sq.Eq{"deleted_at": nil})
+	}
+
+	query, args, err := queryTmp.
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %v", err)
+	}
+
+	err = r.db(ctx).SelectContext(ctx, &values, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %v", err)
+	}
+	return values, nil
+}
+
+// GetAllAttributeValues возвращает значения всех атрибутов одним запросом
+func (r *Repository) GetAllAttributeValues(ctx context.Context, includeDeleted bool) (model.AttributeValueList, error) {
+	var values model.AttributeValueList
+
+	queryTmp := sq.
 		Select(
 			"id",
+			"attribute_id",
 			"value",
 			"parent_id",
+			"deleted_at",
 		).
 		From(attributeValuesTable).
-		Where(sq.Eq{"attribute_id": attributeId}).
+		OrderBy("attribute_id", "id")
+
+	if !includeDeleted {
+		queryTmp = queryTmp.Where(sq.Eq{"deleted_at": nil})
+	}
+
+	query, args, err := queryTmp.
 		PlaceholderFormat(sq.Dollar).
 		ToSql()
 	if err != nil {
 		return nil, fmt.Errorf("failed to build query: %v", err)
 	}
 
-	err = r.connection.SelectContext(ctx, &values, query, args...)
+	err = r.db(ctx).SelectContext(ctx, &values, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to execute query: %v", err)
 	}
 	return values, nil
 }
+
+func (r *Repository) CreateAttribute(ctx context.Context, in model.Attribute) (int64, error) {
+	var id int64
+
+	query, args, err := sq.
+		Insert(attributesTable).
+		Columns("name", "type").
+		Values(in.Name, in.Type).
+		Suffix("RETURNING id").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("failed to build query: %v", err)
+	}
+
+	err = r.db(ctx).GetContext(ctx, &id, query, args...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create attribute: %v", err)
+	}
+
+	return id, nil
+}
+
+func (r *Repository) GetAttribute(ctx context.Context, id int64) (model.Attribute, error) {
+	var res model.Attribute
+
+	query, args, err := sq.
+		Select(
+			"id",
+			"name",
+			"type",
+			"created_at",
+			"version",
+		).
+		From(attributesTable).
+		Where(sq.Eq{"id": id}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return model.Attribute{}, fmt.Errorf("failed to build query: %v", err)
+	}
+
+	err = r.db(ctx).GetContext(ctx, &res, query, args...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.Attribute{}, model.ErrNotFound
+		}
+		return model.Attribute{}, fmt.Errorf("failed to get attribute: %v", err)
+	}
+
+	return res, nil
+}
+
+func (r *Repository) ListAttributes(ctx context.Context) (model.AttributeList, error) {
+	var res model.AttributeList
+
+	query, args, err := sq.
+		Select(
+			"id",
+			"name",
+			"type",
+			"created_at",
+			"version",
+		).
+		From(attributesTable).
+		OrderBy("id").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %v", err)
+	}
+
+	err = r.db(ctx).SelectContext(ctx, &res, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list attributes: %v", err)
+	}
+
+	return res, nil
+}
+
+func (r *Repository) UpdateAttribute(ctx context.Context, id int64, name string) error {
+	query, args, err := sq.
+		Update(attributesTable).
+		Set("name", name).
+		Where(sq.Eq{"id": id}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %v", err)
+	}
+
+	res, err := r.db(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to update attribute: %v", err)
+	}
+
+	return checkAffected(res)
+}
+
+// DeleteAttribute удаляет атрибут без значений. Удаление каскадно стёрло бы значения вместе с редиректами и историей,
+// и сохранённые у потребителей id перестали бы разрешаться, поэтому при любых значениях, включая удалённые,
+// возвращает model.ErrHasValues
+func (r *Repository) DeleteAttribute(ctx context.Context, id int64) error {
+	return r.withTx(ctx, func(tx *sqlx.Tx) error {
+		// блокировка не даёт добавить значение между проверкой и удалением
+		err := lockAttribute(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+
+		var hasValues bool
+
+		query, args, err := sq.
+			Select("1").
+			Prefix("SELECT EXISTS (").
+			From(attributeValuesTable).
+			Where(sq.Eq{"attribute_id": id}).
+			Suffix(")").
+			PlaceholderFormat(sq.Dollar).
+			ToSql()
+		if err != nil {
+			return fmt.Errorf("failed to build query: %v", err)
+		}
+
+		err = tx.GetContext(ctx, &hasValues, query, args...)
+		if err != nil {
+			return fmt.Errorf("failed to check values: %v", err)
+		}
+		if hasValues {
+			return model.ErrHasValues
+		}
+
+		query, args, err = sq.
+			Delete(attributesTable).
+			Where(sq.Eq{"id": id}).
+			PlaceholderFormat(sq.Dollar).
+			ToSql()
+		if err != nil {
+			return fmt.Errorf("failed to build query: %v", err)
+		}
+
+		res, err := tx.ExecContext(ctx, query, args...)
+		if err != nil {
+			return fmt.Errorf("failed to delete attribute: %v", err)
+		}
+
+		return checkAffected(res)
+	})
+}
+
+func (r *Repository) GetAttributeValue(ctx context.Context, id int64) (model.AttributeValue, error) {
+	var res model.AttributeValue
+
+	query, args, err := sq.
+		Select(
+			"id",
+			"attribute_id",
+			"value",
+			"parent_id",
+			"deleted_at",
+		).
+		From(attributeValuesTable).
+		Where(sq.Eq{"id": id}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return model.AttributeValue{}, fmt.Errorf("failed to build query: %v", err)
+	}
+
+	err = r.db(ctx).GetContext(ctx, &res, query, args...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.AttributeValue{}, model.ErrNotFound
+		}
+		return model.AttributeValue{}, fmt.Errorf("failed to get attribute value: %v", err)
+	}
+
+	return res, nil
+}
+
+func (r *Repository) UpdateAttributeValue(ctx context.Context, in model.AttributeValueUpdate) error {
+	return r.withTx(ctx, func(tx *sqlx.Tx) error {
+		attributeId, err := getValueAttributeId(ctx, tx, in.ID)
+		if err != nil {
+			return err
+		}
+
+		// триггер attribute_values_check_parent берёт ту же блокировку, берём её раньше строки значения
+		err = lockAttribute(ctx, tx, attributeId)
+		if err != nil {
+			return err
+		}
+
+		queryTmp := sq.
+			Update(attributeValuesTable).
+			Where(sq.Eq{"id": in.ID, "deleted_at": nil})
+
+		if in.Value != nil {
+			err = checkAliasConflict(ctx, tx, attributeId, in.ID, *in.Value)
+			if err != nil {
+				return err
+			}
+
+			// значение переименовали в собственный синоним, он больше не нужен
+			err = deleteOwnAlias(ctx, tx, in.ID, *in.Value)
+			if err != nil {
+				return err
+			}
+
+			queryTmp = queryTmp.
+				Set("value", *in.Value).
+				Set("normalized_value", model.NormalizeValue(*in.Value))
+		}
+		if in.ClearParent {
+			queryTmp = queryTmp.Set("parent_id", nil)
+		} else if in.ParentId != nil {
+			queryTmp = queryTmp.Set("parent_id", *in.ParentId)
+		}
+
+		query, args, err := queryTmp.PlaceholderFormat(sq.Dollar).ToSql()
+		if err != nil {
+			return fmt.Errorf("failed to build query: %v", err)
+		}
+
+		res, err := tx.ExecContext(ctx, query, args...)
+		if err != nil {
+			if parentErr := parentConstraintError(err); parentErr != nil {
+				return parentErr
+			}
+			// сервис проверяет дубли заранее через GetDuplicateValue, сюда попадает только гонка
+			if isUniqueViolation(err, normalizedValueConstraint) {
+				return fmt.Errorf("option %d: %w", in.ID, model.ErrAlreadyExists)
+			}
+			return fmt.Errorf("failed to update attribute value: %v", err)
+		}
+
+		return checkAffected(res)
+	})
+}
+
+// GetAncestors возвращает предков значения от корня к непосредственному родителю, само значение не входит
+func (r *Repository) GetAncestors(ctx context.Context, id int64) (model.AttributeValueList, error) {
+	var res model.AttributeValueList
+
+	err := r.db(ctx).SelectContext(ctx, &res, ancestorsQuery, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ancestors: %v", err)
+	}
+
+	return res, nil
+}
+
+func (r *Repository) GetSubtree(ctx context.Context, id int64, depth int32) (model.AttributeValueList, error) {
+	var res model.AttributeValueList
+
+	err := r.db(ctx).SelectContext(ctx, &res, subtreeQuery, id, depth)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get subtree: %v", err)
+	}
+
+	return res, nil
+}
+
+func (r *Repository) GetChildren(ctx context.Context, id int64) (model.AttributeValueList, error) {
+	var res model.AttributeValueList
+
+	query, args, err := sq.
+		Select(
+			"id",
+			"attribute_id",
+			"value",
+			"parent_id",
+			"deleted_at",
+			"EXISTS (SELECT 1 FROM attribute_values c WHERE c.parent_id = attribute_values.id AND c.deleted_at IS NULL) AS has_children",
+		).
+		From(attributeValuesTable).
+		Where(sq.Eq{"parent_id": id, "deleted_at": nil}).
+		OrderBy("id").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %v", err)
+	}
+
+	err = r.db(ctx).SelectContext(ctx, &res, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get children: %v", err)
+	}
+
+	return res, nil
+}
+
+func (r *Repository) GetAttributeValuesByIds(ctx context.Context, ids []int64) (model.AttributeValueList, error) {
+	var res model.AttributeValueList
+
+	query, args, err := sq.
+		Select(
+			"id",
+			"attribute_id",
+			"value",
+			"parent_id",
+			"deleted_at",
+		).
+		From(attributeValuesTable).
+		Where(sq.Eq{"id": ids}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %v", err)
+	}
+
+	err = r.db(ctx).SelectContext(ctx, &res, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %v", err)
+	}
+
+	return res, nil
+}
+
+func (r *Repository) GetPaths(ctx context.Context, ids []int64) ([]model.OptionPath, error) {
+	var res []model.OptionPath
+
+	err := r.db(ctx).SelectContext(ctx, &res, pathsQuery, pq.Array(ids))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get paths: %v", err)
+	}
+
+	return res, nil
+}
+
+func (r *Repository) SearchAttributeValues(ctx context.Context, attributeId int64, query string, limit uint64) (model.SearchHitList, error) {
+	var res model.SearchHitList
+
+	query = strings.ToLower(query)
+
+	err := r.db(ctx).SelectContext(ctx, &res, searchValuesQuery, attributeId, query, escapeLike(query)+"%", limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search attribute values: %v", err)
+	}
+
+	return res, nil
+}
+
+// DeleteAttributeValue помечает значение удалённым; строка остаётся, чтобы ссылки на id продолжали работать.
+// Синонимы значения удаляются, чтобы освободить их для других значений атрибута
+func (r *Repository) DeleteAttributeValue(ctx context.Context, id int64) error {
+	return r.withTx(ctx, func(tx *sqlx.Tx) error {
+		var hasChildren bool
+
+		// блокируем значение, чтобы к нему не добавили потомка, пока идёт удаление
+		query, args, err := sq.
+			Select("id").
+			From(attributeValuesTable).
+			Where(sq.Eq{"id": id, "deleted_at": nil}).
+			Suffix("FOR UPDATE").
+			PlaceholderFormat(sq.Dollar).
+			ToSql()
+		if err != nil {
+			return fmt.Errorf("failed to build query: %v", err)
+		}
+
+		var lockedID int64
+		err = tx.GetContext(ctx, &lockedID, query, args...)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return model.ErrNotFound
+			}
+			return fmt.Errorf("failed to lock attribute value: %v", err)
+		}
+
+		query, args, err = sq.
+			Select("1").
+			Prefix("SELECT EXISTS (").
+			From(attributeValuesTable).
+			Where(sq.Eq{"parent_id": id, "deleted_at": nil}).
+			Suffix(")").
+			PlaceholderFormat(sq.Dollar).
+			ToSql()
+		if err != nil {
+			return fmt.Errorf("failed to build query: %v", err)
+		}
+
+		err = tx.GetContext(ctx, &hasChildren, query, args...)
+		if err != nil {
+			return fmt.Errorf("failed to check children: %v", err)
+		}
+		if hasChildren {
+			return model.ErrHasChildren
+		}
+
+		query, args, err = sq.
+			Update(attributeValuesTable).
+			Set("deleted_at", sq.Expr("CURRENT_TIMESTAMP")).
+			Where(sq.Eq{"id": id}).
+			PlaceholderFormat(sq.Dollar).
+			ToSql()
+		if err != nil {
+			return fmt.Errorf("failed to build query: %v", err)
+		}
+
+		_, err = tx.ExecContext(ctx, query, args...)
+		if err != nil {
+			return fmt.Errorf("failed to delete attribute value: %v", err)
+		}
+
+		query, args, err = sq.
+			Delete(attributeValueAliasesTable).
+			Where(sq.Eq{"option_id": id}).
+			PlaceholderFormat(sq.Dollar).
+			ToSql()
+		if err != nil {
+			return fmt.Errorf("failed to build query: %v", err)
+		}
+
+		_, err = tx.ExecContext(ctx, query, args...)
+		if err != nil {
+			return fmt.Errorf("failed to delete value aliases: %v", err)
+		}
+
+		return nil
+	})
+}
+
+func (r *Repository) GetOptionRequest(ctx context.Context, id int64) (model.OptionRequest, error) {
+	var res model.OptionRequest
+
+	query, args, err := sq.
+		Select(
+			"id",
+			"attribute_id",
+			"value",
+			"parent_id",
+			"user_uuid",
+			"status",
+			"created_at",
+		).
+		From(optionRequestsTable).
+		Where(sq.Eq{"id": id}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return model.OptionRequest{}, fmt.Errorf("failed to build query: %v", err)
+	}
+
+	err = r.db(ctx).GetContext(ctx, &res, query, args...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.OptionRequest{}, model.ErrNotFound
+		}
+		return model.OptionRequest{}, fmt.Errorf("failed to get option request: %v", err)
+	}
+
+	return res, nil
+}
+
+func (r *Repository) CreateOptionRequest(ctx context.Context, in model.OptionRequest) (int64, error) {
+	var id int64
+
+	existing, err := r.GetDuplicateValue(ctx, model.AttributeValue{
+		AttributeId: in.AttributeID,
+		Value:       in.Value,
+		ParentId:    in.ParentId,
+	})
+	if err == nil {
+		return 0, &model.DuplicateValueError{Existing: existing}
+	}
+	if !errors.Is(err, model.ErrNotFound) {
+		return 0, err
+	}
+
+	// синоним указывает на значение в любом месте дерева, поэтому родитель заявки тут не важен
+	existing, err = r.GetAliasedValue(ctx, in.AttributeID, in.Value)
+	if err == nil {
+		return 0, &model.DuplicateValueError{Existing: existing}
+	}
+	if !errors.Is(err, model.ErrNotFound) {
+		return 0, err
+	}
+
+	// при гонке двух одинаковых заявок вторую отсекает частичный уникальный индекс option_requests_pending_uniq
+	query, args, err := sq.
+		Insert(optionRequestsTable).
+		Columns("attribute_id", "value", "parent_id", "user_uuid", "normalized_value").
+		Values(in.AttributeID, in.Value, in.ParentId, in.UserUuid, model.NormalizeValue(in.Value)).
+		Suffix(pendingRequestConflict + " DO NOTHING RETURNING id").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("failed to build query: %v", err)
+	}
+
+	err = r.db(ctx).GetContext(ctx, &id, query, args...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, fmt.Errorf("value %q is already requested: %w", in.Value, model.ErrAlreadyExists)
+		}
+		return 0, fmt.Errorf("failed to create option request: %v", err)
+	}
+
+	return id, nil
+}
+
+// GetUnnormalizedOptionRequests - то же, что GetUnnormalizedValues, для заявок
+func (r *Repository) GetUnnormalizedOptionRequests(ctx context.Context, limit uint64) (model.OptionRequestList, error) {
+	var res model.OptionRequestList
+
+	_, err := r.db(ctx).ExecContext(ctx, "SELECT pg_advisory_xact_lock($1)", normalizeLockKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to lock normalization: %v", err)
+	}
+
+	query, args, err := sq.
+		Select(
+			"id",
+			"attribute_id",
+			"value",
+			"parent_id",
+			"user_uuid",
+			"status",
+			"created_at",
+		).
+		From(optionRequestsTable).
+		Where(sq.Eq{"normalized_value": nil}).
+		OrderBy("id").
+		Limit(limit).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %v", err)
+	}
+
+	err = r.db(ctx).SelectContext(ctx, &res, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get unnormalized option requests: %v", err)
+	}
+
+	return res, nil
+}
+
+// GetPendingDuplicateRequest ищет другую заявку на рассмотрении с тем же родителем и значением после нормализации
+func (r *Repository) GetPendingDuplicateRequest(ctx context.Context, in model.OptionRequest) (model.OptionRequest, error) {
+	var res model.OptionRequest
+
+	query, args, err := sq.
+		Select(
+			"id",
+			"attribute_id",
+			"value",
+			"parent_id",
+			"user_uuid",
+			"status",
+			"created_at",
+		).
+		From(optionRequestsTable).
+		Where(sq.Eq{
+			"attribute_id":     in.AttributeID,
+			"normalized_value": model.NormalizeValue(in.Value),
+			"status":           model.OptionRequestStatusPending,
+		}).
+		Where("parent_id IS NOT DISTINCT FROM ?", in.ParentId).
+		Where(sq.NotEq{"id": in.ID}).
+		Limit(1).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return model.OptionRequest{}, fmt.Errorf("failed to build query: %v", err)
+	}
+
+	err = r.db(ctx).GetContext(ctx, &res, query, args...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.OptionRequest{}, model.ErrNotFound
+		}
+		return model.OptionRequest{}, fmt.Errorf("failed to get duplicate option request: %v", err)
+	}
+
+	return res, nil
+}
+
+// SetOptionRequestNormalizedValue проставляет ключ заявке, у которой его ещё нет
+func (r *Repository) SetOptionRequestNormalizedValue(ctx context.Context, in model.OptionRequest) error {
+	query, args, err := sq.
+		Update(optionRequestsTable).
+		Set("normalized_value", model.NormalizeValue(in.Value)).
+		Where(sq.Eq{"id": in.ID, "normalized_value": nil}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %v", err)
+	}
+
+	_, err = r.db(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to set option request normalized value: %v", err)
+	}
+
+	return nil
+}
+
+// GetPendingRequestsByParent возвращает ожидающие заявки на потомков parentId и блокирует их до конца транзакции
+func (r *Repository) GetPendingRequestsByParent(ctx context.Context, parentId int64) (model.OptionRequestList, error) {
+	var res model.OptionRequestList
+
+	query, args, err := sq.
+		Select(
+			"id",
+			"attribute_id",
+			"value",
+			"parent_id",
+			"user_uuid",
+			"status",
+			"created_at",
+		).
+		From(optionRequestsTable).
+		Where(sq.Eq{"parent_id": parentId, "status": model.OptionRequestStatusPending}).
+		OrderBy("id").
+		Suffix("FOR UPDATE").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %v", err)
+	}
+
+	err = r.db(ctx).SelectContext(ctx, &res, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get option requests by parent: %v", err)
+	}
+
+	return res, nil
+}
+
+func (r *Repository) SetOptionRequestParent(ctx context.Context, id int64, parentId int64) error {
+	query, args, err := sq.
+		Update(optionRequestsTable).
+		Set("parent_id", parentId).
+		Where(sq.Eq{"id": id, "status": model.OptionRequestStatusPending}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %v", err)
+	}
+
+	res, err := r.db(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		if isUniqueViolation(err, pendingRequestConstraint) {
+			return fmt.Errorf("option request %d: %w", id, model.ErrAlreadyExists)
+		}
+		return fmt.Errorf("failed to set option request parent: %v", err)
+	}
+
+	return checkAffected(res)
+}
+
+// ApproveOptionRequest создаёт значение атрибута и закрывает заявку в одной транзакции
+func (r *Repository) ApproveOptionRequest(ctx context.Context, resolution model.OptionRequestResolution, value model.AttributeValue) (int64, error) {
+	var optionID int64
+
+	err := r.withTx(ctx, func(tx *sqlx.Tx) error {
+		err := lockOptionRequest(ctx, tx, resolution.RequestID, resolution.Status)
+		if err != nil {
+			return err
+		}
+
+		optionID, err = insertAttributeValue(ctx, tx, value)
+		if err != nil {
+			return err
+		}
+
+		resolution.OptionID = &optionID
+		return updateOptionRequestStatus(ctx, tx, resolution)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return optionID, nil
+}
+
+// ResolveOptionRequest закрывает заявку без создания нового значения (отклонение или слияние)
+func (r *Repository) ResolveOptionRequest(ctx context.Context, resolution model.OptionRequestResolution) error {
+	return r.withTx(ctx, func(tx *sqlx.Tx) error {
+		err := lockOptionRequest(ctx, tx, resolution.RequestID, resolution.Status)
+		if err != nil {
+			return err
+		}
+
+		return updateOptionRequestStatus(ctx, tx, resolution)
+	})
+}
+
+// lockOptionRequest блокирует строку заявки и проверяет, что переход в новый статус допустим
+func lockOptionRequest(ctx context.Context, tx *sqlx.Tx, id int64, next model.OptionRequestStatus) error {
+	var current model.OptionRequestStatus
+
+	query, args, err := sq.
+		Select("status").
+		From(optionRequestsTable).
+		Where(sq.Eq{"id": id}).
+		Suffix("FOR UPDATE").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %v", err)
+	}
+
+	err = tx.GetContext(ctx, &current, query, args...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.ErrNotFound
+		}
+		return fmt.Errorf("failed to lock option request: %v", err)
+	}
+
+	if !current.CanTransitionTo(next) {
+		return model.ErrInvalidStatusTransition
+	}
+
+	return nil
+}
+
+func updateOptionRequestStatus(ctx context.Context, tx *sqlx.Tx, resolution model.OptionRequestResolution) error {
+	// заявки, закрытые самим сервисом (см. Service.NormalizeLegacyOptionRequests), остаются без модератора
+	var moderatorUuid *string
+	if resolution.ModeratorUuid != "" {
+		moderatorUuid = &resolution.ModeratorUuid
+	}
+
+	query, args, err := sq.
+		Update(optionRequestsTable).
+		Set("status", resolution.Status).
+		Set("reject_reason", resolution.Reason).
+		Set("option_id", resolution.OptionID).
+		Set("moderator_uuid", moderatorUuid).
+		Set("resolved_at", sq.Expr("CURRENT_TIMESTAMP")).
+		Where(sq.Eq{"id": resolution.RequestID}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %v", err)
+	}
+
+	_, err = tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to update option request status: %v", err)
+	}
+
+	return nil
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+func escapeLike(s string) string {
+	return likeEscaper.Replace(s)
+}
+
+// parentConstraintError переводит ошибки триггера attribute_values_check_parent в ошибки модели
+func parentConstraintError(err error) error {
+	var pqErr *pq.Error
+	if !errors.As(err, &pqErr) {
+		return nil
+	}
+
+	switch pqErr.Constraint {
+	case parentAttributeConstraint:
+		return fmt.Errorf("%s: %w", pqErr.Message, model.ErrParentAttributeMismatch)
+	case parentCycleConstraint:
+		return fmt.Errorf("%s: %w", pqErr.Message, model.ErrParentCycle)
+	default:
+		return nil
+	}
+}
+
+func isUniqueViolation(err error, constraint string) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == uniqueViolation && pqErr.Constraint == constraint
+}
+
+func checkAffected(res sql.Result) error {
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get affected rows: %v", err)
+	}
+	if affected == 0 {
+		return model.ErrNotFound
+	}
+	return nil
+}
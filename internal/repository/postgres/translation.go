@@ -0,0 +1,141 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/s21platform/optionhub-service/internal/model"
+)
+
+const (
+	attributeTranslationsTable      = "attribute_translations"
+	attributeValueTranslationsTable = "attribute_value_translations"
+)
+
+func (r *Repository) GetAttributeTranslations(ctx context.Context, ids []int64) (model.AttributeTranslationList, error) {
+	var res model.AttributeTranslationList
+
+	query, args, err := sq.
+		Select(
+			"attribute_id",
+			"locale",
+			"name",
+		).
+		From(attributeTranslationsTable).
+		Where(sq.Eq{"attribute_id": ids}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %v", err)
+	}
+
+	err = r.db(ctx).SelectContext(ctx, &res, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get attribute translations: %v", err)
+	}
+
+	return res, nil
+}
+
+func (r *Repository) SetAttributeTranslation(ctx context.Context, in model.AttributeTranslation) error {
+	query, args, err := sq.
+		Insert(attributeTranslationsTable).
+		Columns("attribute_id", "locale", "name").
+		Values(in.AttributeID, in.Locale, in.Name).
+		Suffix("ON CONFLICT (attribute_id, locale) DO UPDATE SET name = EXCLUDED.name, updated_at = CURRENT_TIMESTAMP").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %v", err)
+	}
+
+	_, err = r.db(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to set attribute translation: %v", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) DeleteAttributeTranslation(ctx context.Context, attributeId int64, locale string) error {
+	query, args, err := sq.
+		Delete(attributeTranslationsTable).
+		Where(sq.Eq{"attribute_id": attributeId, "locale": locale}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %v", err)
+	}
+
+	res, err := r.db(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to delete attribute translation: %v", err)
+	}
+
+	return checkAffected(res)
+}
+
+func (r *Repository) GetValueTranslations(ctx context.Context, ids []int64) (model.ValueTranslationList, error) {
+	var res model.ValueTranslationList
+
+	query, args, err := sq.
+		Select(
+			"option_id",
+			"locale",
+			"value",
+		).
+		From(attributeValueTranslationsTable).
+		Where(sq.Eq{"option_id": ids}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %v", err)
+	}
+
+	err = r.db(ctx).SelectContext(ctx, &res, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get value translations: %v", err)
+	}
+
+	return res, nil
+}
+
+func (r *Repository) SetValueTranslation(ctx context.Context, in model.ValueTranslation) error {
+	query, args, err := sq.
+		Insert(attributeValueTranslationsTable).
+		Columns("option_id", "locale", "value").
+		Values(in.OptionID, in.Locale, in.Value).
+		Suffix("ON CONFLICT (option_id, locale) DO UPDATE SET value = EXCLUDED.value, updated_at = CURRENT_TIMESTAMP").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %v", err)
+	}
+
+	_, err = r.db(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to set value translation: %v", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) DeleteValueTranslation(ctx context.Context, optionId int64, locale string) error {
+	query, args, err := sq.
+		Delete(attributeValueTranslationsTable).
+		Where(sq.Eq{"option_id": optionId, "locale": locale}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %v", err)
+	}
+
+	res, err := r.db(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to delete value translation: %v", err)
+	}
+
+	return checkAffected(res)
+}
@@ -5,15 +5,67 @@ import (
 	"context"
 
 	"github.com/s21platform/optionhub-service/internal/model"
+	"github.com/s21platform/optionhub-service/pkg/optionhub"
 )
 
 type DBRepo interface {
-	GetOptionRequests(ctx context.Context) (model.OptionRequestList, error)
+	WithTx(ctx context.Context, fn func(ctx context.Context) error) error
+	AddOutboxMessage(ctx context.Context, msg model.OutboxMessage) error
+	GetOptionRequests(ctx context.Context, filter model.OptionRequestFilter) (model.OptionRequestList, int64, error)
 	GetAttributeValueById(ctx context.Context, ids []int64) ([]model.Attribute, error)
-	GetValuesByAttributeId(ctx context.Context, attributeId int64) (model.AttributeValueList, error)
-	AddAttributeValue(ctx context.Context, in model.AttributeValue) error
+	GetValuesByAttributeId(ctx context.Context, attributeId int64, includeDeleted bool) (model.AttributeValueList, error)
+	GetAllAttributeValues(ctx context.Context, includeDeleted bool) (model.AttributeValueList, error)
+	AddAttributeValue(ctx context.Context, in model.AttributeValue) (int64, error)
+	GetDuplicateValue(ctx context.Context, in model.AttributeValue) (model.AttributeValue, error)
+	GetUnnormalizedValues(ctx context.Context, limit uint64) (model.AttributeValueList, error)
+	SetNormalizedValue(ctx context.Context, in model.AttributeValue) error
+	CreateAttribute(ctx context.Context, in model.Attribute) (int64, error)
+	GetAttribute(ctx context.Context, id int64) (model.Attribute, error)
+	ListAttributes(ctx context.Context) (model.AttributeList, error)
+	UpdateAttribute(ctx context.Context, id int64, name string) error
+	DeleteAttribute(ctx context.Context, id int64) error
+	GetAttributeValue(ctx context.Context, id int64) (model.AttributeValue, error)
+	UpdateAttributeValue(ctx context.Context, in model.AttributeValueUpdate) error
+	GetAncestors(ctx context.Context, id int64) (model.AttributeValueList, error)
+	GetSubtree(ctx context.Context, id int64, depth int32) (model.AttributeValueList, error)
+	GetChildren(ctx context.Context, id int64) (model.AttributeValueList, error)
+	GetAttributeValuesByIds(ctx context.Context, ids []int64) (model.AttributeValueList, error)
+	GetPaths(ctx context.Context, ids []int64) ([]model.OptionPath, error)
+	SearchAttributeValues(ctx context.Context, attributeId int64, query string, limit uint64) (model.SearchHitList, error)
+	DeleteAttributeValue(ctx context.Context, id int64) error
+	MergeAttributeValues(ctx context.Context, sourceId, targetId int64) ([]int64, error)
+	GetValueRedirects(ctx context.Context, ids []int64) ([]model.ValueRedirect, error)
+	GetOptionRequest(ctx context.Context, id int64) (model.OptionRequest, error)
+	CreateOptionRequest(ctx context.Context, in model.OptionRequest) (int64, error)
+	GetUnnormalizedOptionRequests(ctx context.Context, limit uint64) (model.OptionRequestList, error)
+	GetPendingDuplicateRequest(ctx context.Context, in model.OptionRequest) (model.OptionRequest, error)
+	SetOptionRequestNormalizedValue(ctx context.Context, in model.OptionRequest) error
+	GetPendingRequestsByParent(ctx context.Context, parentId int64) (model.OptionRequestList, error)
+	SetOptionRequestParent(ctx context.Context, id int64, parentId int64) error
+	ApproveOptionRequest(ctx context.Context, resolution model.OptionRequestResolution, value model.AttributeValue) (int64, error)
+	ResolveOptionRequest(ctx context.Context, resolution model.OptionRequestResolution) error
+	AddAuditRecord(ctx context.Context, in model.AuditRecord) error
+	GetAuditLog(ctx context.Context, filter model.AuditLogFilter) (model.AuditRecordList, error)
+	GetAttributeValueChanges(ctx context.Context, attributeId int64, afterVersion int64, limit uint64) ([]model.AttributeValueChange, error)
+	LockAttributeVersion(ctx context.Context, attributeId int64) (int64, error)
+	GetAttributeTranslations(ctx context.Context, ids []int64) (model.AttributeTranslationList, error)
+	SetAttributeTranslation(ctx context.Context, in model.AttributeTranslation) error
+	DeleteAttributeTranslation(ctx context.Context, attributeId int64, locale string) error
+	GetValueTranslations(ctx context.Context, ids []int64) (model.ValueTranslationList, error)
+	SetValueTranslation(ctx context.Context, in model.ValueTranslation) error
+	DeleteValueTranslation(ctx context.Context, optionId int64, locale string) error
+	GetValueAliases(ctx context.Context, optionId int64) (model.AttributeValueAliasList, error)
+	GetAttributeAliases(ctx context.Context, attributeId int64) (model.AttributeValueAliasList, error)
+	GetValueAlias(ctx context.Context, id int64) (model.AttributeValueAlias, error)
+	AddValueAlias(ctx context.Context, in model.AttributeValueAlias) (int64, error)
+	DeleteValueAlias(ctx context.Context, id int64) error
 }
 
-type SetAttributeProducer interface {
-	ProduceMessage(ctx context.Context, message any, key any) error
+type TreeCache interface {
+	GetOrLoad(attributeId int64, version int64, includeDeleted bool, locale string, load func() ([]*optionhub.Option, error)) ([]*optionhub.Option, error)
+	Invalidate(attributeId int64)
+}
+
+type AttributeNotifier interface {
+	Subscribe(attributeId int64) (<-chan struct{}, func())
 }
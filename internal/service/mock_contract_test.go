@@ -10,6 +10,7 @@ import (
 
 	gomock "github.com/golang/mock/gomock"
 	model "github.com/s21platform/optionhub-service/internal/model"
+	optionhub "github.com/s21platform/optionhub-service/pkg/optionhub"
 )
 
 // MockDBRepo is a mock of DBRepo interface.
@@ -36,11 +37,12 @@ func (m *MockDBRepo) EXPECT() *MockDBRepoMockRecorder {
 }
 
 // AddAttributeValue mocks base method.
-func (m *MockDBRepo) AddAttributeValue(ctx context.Context, in model.AttributeValue) error {
+func (m *MockDBRepo) AddAttributeValue(ctx context.Context, in model.AttributeValue) (int64, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "AddAttributeValue", ctx, in)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // AddAttributeValue indicates an expected call of AddAttributeValue.
@@ -49,6 +51,254 @@ func (mr *MockDBRepoMockRecorder) AddAttributeValue(ctx, in interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAttributeValue", reflect.TypeOf((*MockDBRepo)(nil).AddAttributeValue), ctx, in)
 }
 
+// AddAuditRecord mocks base method.
+func (m *MockDBRepo) AddAuditRecord(ctx context.Context, in model.AuditRecord) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddAuditRecord", ctx, in)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddAuditRecord indicates an expected call of AddAuditRecord.
+func (mr *MockDBRepoMockRecorder) AddAuditRecord(ctx, in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAuditRecord", reflect.TypeOf((*MockDBRepo)(nil).AddAuditRecord), ctx, in)
+}
+
+// AddOutboxMessage mocks base method.
+func (m *MockDBRepo) AddOutboxMessage(ctx context.Context, msg model.OutboxMessage) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddOutboxMessage", ctx, msg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddOutboxMessage indicates an expected call of AddOutboxMessage.
+func (mr *MockDBRepoMockRecorder) AddOutboxMessage(ctx, msg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddOutboxMessage", reflect.TypeOf((*MockDBRepo)(nil).AddOutboxMessage), ctx, msg)
+}
+
+// AddValueAlias mocks base method.
+func (m *MockDBRepo) AddValueAlias(ctx context.Context, in model.AttributeValueAlias) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddValueAlias", ctx, in)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddValueAlias indicates an expected call of AddValueAlias.
+func (mr *MockDBRepoMockRecorder) AddValueAlias(ctx, in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddValueAlias", reflect.TypeOf((*MockDBRepo)(nil).AddValueAlias), ctx, in)
+}
+
+// ApproveOptionRequest mocks base method.
+func (m *MockDBRepo) ApproveOptionRequest(ctx context.Context, resolution model.OptionRequestResolution, value model.AttributeValue) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApproveOptionRequest", ctx, resolution, value)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApproveOptionRequest indicates an expected call of ApproveOptionRequest.
+func (mr *MockDBRepoMockRecorder) ApproveOptionRequest(ctx, resolution, value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApproveOptionRequest", reflect.TypeOf((*MockDBRepo)(nil).ApproveOptionRequest), ctx, resolution, value)
+}
+
+// CreateAttribute mocks base method.
+func (m *MockDBRepo) CreateAttribute(ctx context.Context, in model.Attribute) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAttribute", ctx, in)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAttribute indicates an expected call of CreateAttribute.
+func (mr *MockDBRepoMockRecorder) CreateAttribute(ctx, in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAttribute", reflect.TypeOf((*MockDBRepo)(nil).CreateAttribute), ctx, in)
+}
+
+// CreateOptionRequest mocks base method.
+func (m *MockDBRepo) CreateOptionRequest(ctx context.Context, in model.OptionRequest) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOptionRequest", ctx, in)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOptionRequest indicates an expected call of CreateOptionRequest.
+func (mr *MockDBRepoMockRecorder) CreateOptionRequest(ctx, in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOptionRequest", reflect.TypeOf((*MockDBRepo)(nil).CreateOptionRequest), ctx, in)
+}
+
+// DeleteAttribute mocks base method.
+func (m *MockDBRepo) DeleteAttribute(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAttribute", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAttribute indicates an expected call of DeleteAttribute.
+func (mr *MockDBRepoMockRecorder) DeleteAttribute(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAttribute", reflect.TypeOf((*MockDBRepo)(nil).DeleteAttribute), ctx, id)
+}
+
+// DeleteAttributeTranslation mocks base method.
+func (m *MockDBRepo) DeleteAttributeTranslation(ctx context.Context, attributeId int64, locale string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAttributeTranslation", ctx, attributeId, locale)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAttributeTranslation indicates an expected call of DeleteAttributeTranslation.
+func (mr *MockDBRepoMockRecorder) DeleteAttributeTranslation(ctx, attributeId, locale interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAttributeTranslation", reflect.TypeOf((*MockDBRepo)(nil).DeleteAttributeTranslation), ctx, attributeId, locale)
+}
+
+// DeleteAttributeValue mocks base method.
+func (m *MockDBRepo) DeleteAttributeValue(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAttributeValue", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAttributeValue indicates an expected call of DeleteAttributeValue.
+func (mr *MockDBRepoMockRecorder) DeleteAttributeValue(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAttributeValue", reflect.TypeOf((*MockDBRepo)(nil).DeleteAttributeValue), ctx, id)
+}
+
+// DeleteValueAlias mocks base method.
+func (m *MockDBRepo) DeleteValueAlias(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteValueAlias", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteValueAlias indicates an expected call of DeleteValueAlias.
+func (mr *MockDBRepoMockRecorder) DeleteValueAlias(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteValueAlias", reflect.TypeOf((*MockDBRepo)(nil).DeleteValueAlias), ctx, id)
+}
+
+// DeleteValueTranslation mocks base method.
+func (m *MockDBRepo) DeleteValueTranslation(ctx context.Context, optionId int64, locale string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteValueTranslation", ctx, optionId, locale)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteValueTranslation indicates an expected call of DeleteValueTranslation.
+func (mr *MockDBRepoMockRecorder) DeleteValueTranslation(ctx, optionId, locale interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteValueTranslation", reflect.TypeOf((*MockDBRepo)(nil).DeleteValueTranslation), ctx, optionId, locale)
+}
+
+// GetAllAttributeValues mocks base method.
+func (m *MockDBRepo) GetAllAttributeValues(ctx context.Context, includeDeleted bool) (model.AttributeValueList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllAttributeValues", ctx, includeDeleted)
+	ret0, _ := ret[0].(model.AttributeValueList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllAttributeValues indicates an expected call of GetAllAttributeValues.
+func (mr *MockDBRepoMockRecorder) GetAllAttributeValues(ctx, includeDeleted interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllAttributeValues", reflect.TypeOf((*MockDBRepo)(nil).GetAllAttributeValues), ctx, includeDeleted)
+}
+
+// GetAncestors mocks base method.
+func (m *MockDBRepo) GetAncestors(ctx context.Context, id int64) (model.AttributeValueList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAncestors", ctx, id)
+	ret0, _ := ret[0].(model.AttributeValueList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAncestors indicates an expected call of GetAncestors.
+func (mr *MockDBRepoMockRecorder) GetAncestors(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAncestors", reflect.TypeOf((*MockDBRepo)(nil).GetAncestors), ctx, id)
+}
+
+// GetAttribute mocks base method.
+func (m *MockDBRepo) GetAttribute(ctx context.Context, id int64) (model.Attribute, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttribute", ctx, id)
+	ret0, _ := ret[0].(model.Attribute)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttribute indicates an expected call of GetAttribute.
+func (mr *MockDBRepoMockRecorder) GetAttribute(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttribute", reflect.TypeOf((*MockDBRepo)(nil).GetAttribute), ctx, id)
+}
+
+// GetAttributeAliases mocks base method.
+func (m *MockDBRepo) GetAttributeAliases(ctx context.Context, attributeId int64) (model.AttributeValueAliasList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttributeAliases", ctx, attributeId)
+	ret0, _ := ret[0].(model.AttributeValueAliasList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttributeAliases indicates an expected call of GetAttributeAliases.
+func (mr *MockDBRepoMockRecorder) GetAttributeAliases(ctx, attributeId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttributeAliases", reflect.TypeOf((*MockDBRepo)(nil).GetAttributeAliases), ctx, attributeId)
+}
+
+// GetAttributeTranslations mocks base method.
+func (m *MockDBRepo) GetAttributeTranslations(ctx context.Context, ids []int64) (model.AttributeTranslationList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttributeTranslations", ctx, ids)
+	ret0, _ := ret[0].(model.AttributeTranslationList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttributeTranslations indicates an expected call of GetAttributeTranslations.
+func (mr *MockDBRepoMockRecorder) GetAttributeTranslations(ctx, ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttributeTranslations", reflect.TypeOf((*MockDBRepo)(nil).GetAttributeTranslations), ctx, ids)
+}
+
+// GetAttributeValue mocks base method.
+func (m *MockDBRepo) GetAttributeValue(ctx context.Context, id int64) (model.AttributeValue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttributeValue", ctx, id)
+	ret0, _ := ret[0].(model.AttributeValue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttributeValue indicates an expected call of GetAttributeValue.
+func (mr *MockDBRepoMockRecorder) GetAttributeValue(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttributeValue", reflect.TypeOf((*MockDBRepo)(nil).GetAttributeValue), ctx, id)
+}
+
 // GetAttributeValueById mocks base method.
 func (m *MockDBRepo) GetAttributeValueById(ctx context.Context, ids []int64) ([]model.Attribute, error) {
 	m.ctrl.T.Helper()
@@ -64,69 +314,547 @@ func (mr *MockDBRepoMockRecorder) GetAttributeValueById(ctx, ids interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttributeValueById", reflect.TypeOf((*MockDBRepo)(nil).GetAttributeValueById), ctx, ids)
 }
 
+// GetAttributeValueChanges mocks base method.
+func (m *MockDBRepo) GetAttributeValueChanges(ctx context.Context, attributeId, afterVersion int64, limit uint64) ([]model.AttributeValueChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttributeValueChanges", ctx, attributeId, afterVersion, limit)
+	ret0, _ := ret[0].([]model.AttributeValueChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttributeValueChanges indicates an expected call of GetAttributeValueChanges.
+func (mr *MockDBRepoMockRecorder) GetAttributeValueChanges(ctx, attributeId, afterVersion, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttributeValueChanges", reflect.TypeOf((*MockDBRepo)(nil).GetAttributeValueChanges), ctx, attributeId, afterVersion, limit)
+}
+
+// GetAttributeValuesByIds mocks base method.
+func (m *MockDBRepo) GetAttributeValuesByIds(ctx context.Context, ids []int64) (model.AttributeValueList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttributeValuesByIds", ctx, ids)
+	ret0, _ := ret[0].(model.AttributeValueList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttributeValuesByIds indicates an expected call of GetAttributeValuesByIds.
+func (mr *MockDBRepoMockRecorder) GetAttributeValuesByIds(ctx, ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttributeValuesByIds", reflect.TypeOf((*MockDBRepo)(nil).GetAttributeValuesByIds), ctx, ids)
+}
+
+// GetAuditLog mocks base method.
+func (m *MockDBRepo) GetAuditLog(ctx context.Context, filter model.AuditLogFilter) (model.AuditRecordList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuditLog", ctx, filter)
+	ret0, _ := ret[0].(model.AuditRecordList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAuditLog indicates an expected call of GetAuditLog.
+func (mr *MockDBRepoMockRecorder) GetAuditLog(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuditLog", reflect.TypeOf((*MockDBRepo)(nil).GetAuditLog), ctx, filter)
+}
+
+// GetChildren mocks base method.
+func (m *MockDBRepo) GetChildren(ctx context.Context, id int64) (model.AttributeValueList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChildren", ctx, id)
+	ret0, _ := ret[0].(model.AttributeValueList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChildren indicates an expected call of GetChildren.
+func (mr *MockDBRepoMockRecorder) GetChildren(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChildren", reflect.TypeOf((*MockDBRepo)(nil).GetChildren), ctx, id)
+}
+
+// GetDuplicateValue mocks base method.
+func (m *MockDBRepo) GetDuplicateValue(ctx context.Context, in model.AttributeValue) (model.AttributeValue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDuplicateValue", ctx, in)
+	ret0, _ := ret[0].(model.AttributeValue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDuplicateValue indicates an expected call of GetDuplicateValue.
+func (mr *MockDBRepoMockRecorder) GetDuplicateValue(ctx, in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDuplicateValue", reflect.TypeOf((*MockDBRepo)(nil).GetDuplicateValue), ctx, in)
+}
+
+// GetOptionRequest mocks base method.
+func (m *MockDBRepo) GetOptionRequest(ctx context.Context, id int64) (model.OptionRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOptionRequest", ctx, id)
+	ret0, _ := ret[0].(model.OptionRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOptionRequest indicates an expected call of GetOptionRequest.
+func (mr *MockDBRepoMockRecorder) GetOptionRequest(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOptionRequest", reflect.TypeOf((*MockDBRepo)(nil).GetOptionRequest), ctx, id)
+}
+
 // GetOptionRequests mocks base method.
-func (m *MockDBRepo) GetOptionRequests(ctx context.Context) (model.OptionRequestList, error) {
+func (m *MockDBRepo) GetOptionRequests(ctx context.Context, filter model.OptionRequestFilter) (model.OptionRequestList, int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOptionRequests", ctx)
+	ret := m.ctrl.Call(m, "GetOptionRequests", ctx, filter)
 	ret0, _ := ret[0].(model.OptionRequestList)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetOptionRequests indicates an expected call of GetOptionRequests.
+func (mr *MockDBRepoMockRecorder) GetOptionRequests(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOptionRequests", reflect.TypeOf((*MockDBRepo)(nil).GetOptionRequests), ctx, filter)
+}
+
+// GetPaths mocks base method.
+func (m *MockDBRepo) GetPaths(ctx context.Context, ids []int64) ([]model.OptionPath, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPaths", ctx, ids)
+	ret0, _ := ret[0].([]model.OptionPath)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetOptionRequests indicates an expected call of GetOptionRequests.
-func (mr *MockDBRepoMockRecorder) GetOptionRequests(ctx interface{}) *gomock.Call {
+// GetPaths indicates an expected call of GetPaths.
+func (mr *MockDBRepoMockRecorder) GetPaths(ctx, ids interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOptionRequests", reflect.TypeOf((*MockDBRepo)(nil).GetOptionRequests), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaths", reflect.TypeOf((*MockDBRepo)(nil).GetPaths), ctx, ids)
+}
+
+// GetPendingDuplicateRequest mocks base method.
+func (m *MockDBRepo) GetPendingDuplicateRequest(ctx context.Context, in model.OptionRequest) (model.OptionRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPendingDuplicateRequest", ctx, in)
+	ret0, _ := ret[0].(model.OptionRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPendingDuplicateRequest indicates an expected call of GetPendingDuplicateRequest.
+func (mr *MockDBRepoMockRecorder) GetPendingDuplicateRequest(ctx, in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingDuplicateRequest", reflect.TypeOf((*MockDBRepo)(nil).GetPendingDuplicateRequest), ctx, in)
+}
+
+// GetPendingRequestsByParent mocks base method.
+func (m *MockDBRepo) GetPendingRequestsByParent(ctx context.Context, parentId int64) (model.OptionRequestList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPendingRequestsByParent", ctx, parentId)
+	ret0, _ := ret[0].(model.OptionRequestList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPendingRequestsByParent indicates an expected call of GetPendingRequestsByParent.
+func (mr *MockDBRepoMockRecorder) GetPendingRequestsByParent(ctx, parentId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingRequestsByParent", reflect.TypeOf((*MockDBRepo)(nil).GetPendingRequestsByParent), ctx, parentId)
+}
+
+// GetSubtree mocks base method.
+func (m *MockDBRepo) GetSubtree(ctx context.Context, id int64, depth int32) (model.AttributeValueList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubtree", ctx, id, depth)
+	ret0, _ := ret[0].(model.AttributeValueList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSubtree indicates an expected call of GetSubtree.
+func (mr *MockDBRepoMockRecorder) GetSubtree(ctx, id, depth interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubtree", reflect.TypeOf((*MockDBRepo)(nil).GetSubtree), ctx, id, depth)
+}
+
+// GetUnnormalizedOptionRequests mocks base method.
+func (m *MockDBRepo) GetUnnormalizedOptionRequests(ctx context.Context, limit uint64) (model.OptionRequestList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnnormalizedOptionRequests", ctx, limit)
+	ret0, _ := ret[0].(model.OptionRequestList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnnormalizedOptionRequests indicates an expected call of GetUnnormalizedOptionRequests.
+func (mr *MockDBRepoMockRecorder) GetUnnormalizedOptionRequests(ctx, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnnormalizedOptionRequests", reflect.TypeOf((*MockDBRepo)(nil).GetUnnormalizedOptionRequests), ctx, limit)
+}
+
+// GetUnnormalizedValues mocks base method.
+func (m *MockDBRepo) GetUnnormalizedValues(ctx context.Context, limit uint64) (model.AttributeValueList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnnormalizedValues", ctx, limit)
+	ret0, _ := ret[0].(model.AttributeValueList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnnormalizedValues indicates an expected call of GetUnnormalizedValues.
+func (mr *MockDBRepoMockRecorder) GetUnnormalizedValues(ctx, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnnormalizedValues", reflect.TypeOf((*MockDBRepo)(nil).GetUnnormalizedValues), ctx, limit)
+}
+
+// GetValueAlias mocks base method.
+func (m *MockDBRepo) GetValueAlias(ctx context.Context, id int64) (model.AttributeValueAlias, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetValueAlias", ctx, id)
+	ret0, _ := ret[0].(model.AttributeValueAlias)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetValueAlias indicates an expected call of GetValueAlias.
+func (mr *MockDBRepoMockRecorder) GetValueAlias(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetValueAlias", reflect.TypeOf((*MockDBRepo)(nil).GetValueAlias), ctx, id)
+}
+
+// GetValueAliases mocks base method.
+func (m *MockDBRepo) GetValueAliases(ctx context.Context, optionId int64) (model.AttributeValueAliasList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetValueAliases", ctx, optionId)
+	ret0, _ := ret[0].(model.AttributeValueAliasList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetValueAliases indicates an expected call of GetValueAliases.
+func (mr *MockDBRepoMockRecorder) GetValueAliases(ctx, optionId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetValueAliases", reflect.TypeOf((*MockDBRepo)(nil).GetValueAliases), ctx, optionId)
+}
+
+// GetValueRedirects mocks base method.
+func (m *MockDBRepo) GetValueRedirects(ctx context.Context, ids []int64) ([]model.ValueRedirect, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetValueRedirects", ctx, ids)
+	ret0, _ := ret[0].([]model.ValueRedirect)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetValueRedirects indicates an expected call of GetValueRedirects.
+func (mr *MockDBRepoMockRecorder) GetValueRedirects(ctx, ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetValueRedirects", reflect.TypeOf((*MockDBRepo)(nil).GetValueRedirects), ctx, ids)
+}
+
+// GetValueTranslations mocks base method.
+func (m *MockDBRepo) GetValueTranslations(ctx context.Context, ids []int64) (model.ValueTranslationList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetValueTranslations", ctx, ids)
+	ret0, _ := ret[0].(model.ValueTranslationList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetValueTranslations indicates an expected call of GetValueTranslations.
+func (mr *MockDBRepoMockRecorder) GetValueTranslations(ctx, ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetValueTranslations", reflect.TypeOf((*MockDBRepo)(nil).GetValueTranslations), ctx, ids)
 }
 
 // GetValuesByAttributeId mocks base method.
-func (m *MockDBRepo) GetValuesByAttributeId(ctx context.Context, attributeId int64) (model.AttributeValueList, error) {
+func (m *MockDBRepo) GetValuesByAttributeId(ctx context.Context, attributeId int64, includeDeleted bool) (model.AttributeValueList, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetValuesByAttributeId", ctx, attributeId)
+	ret := m.ctrl.Call(m, "GetValuesByAttributeId", ctx, attributeId, includeDeleted)
 	ret0, _ := ret[0].(model.AttributeValueList)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetValuesByAttributeId indicates an expected call of GetValuesByAttributeId.
-func (mr *MockDBRepoMockRecorder) GetValuesByAttributeId(ctx, attributeId interface{}) *gomock.Call {
+func (mr *MockDBRepoMockRecorder) GetValuesByAttributeId(ctx, attributeId, includeDeleted interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetValuesByAttributeId", reflect.TypeOf((*MockDBRepo)(nil).GetValuesByAttributeId), ctx, attributeId, includeDeleted)
+}
+
+// ListAttributes mocks base method.
+func (m *MockDBRepo) ListAttributes(ctx context.Context) (model.AttributeList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAttributes", ctx)
+	ret0, _ := ret[0].(model.AttributeList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAttributes indicates an expected call of ListAttributes.
+func (mr *MockDBRepoMockRecorder) ListAttributes(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAttributes", reflect.TypeOf((*MockDBRepo)(nil).ListAttributes), ctx)
+}
+
+// LockAttributeVersion mocks base method.
+func (m *MockDBRepo) LockAttributeVersion(ctx context.Context, attributeId int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockAttributeVersion", ctx, attributeId)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockAttributeVersion indicates an expected call of LockAttributeVersion.
+func (mr *MockDBRepoMockRecorder) LockAttributeVersion(ctx, attributeId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockAttributeVersion", reflect.TypeOf((*MockDBRepo)(nil).LockAttributeVersion), ctx, attributeId)
+}
+
+// MergeAttributeValues mocks base method.
+func (m *MockDBRepo) MergeAttributeValues(ctx context.Context, sourceId, targetId int64) ([]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergeAttributeValues", ctx, sourceId, targetId)
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MergeAttributeValues indicates an expected call of MergeAttributeValues.
+func (mr *MockDBRepoMockRecorder) MergeAttributeValues(ctx, sourceId, targetId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeAttributeValues", reflect.TypeOf((*MockDBRepo)(nil).MergeAttributeValues), ctx, sourceId, targetId)
+}
+
+// ResolveOptionRequest mocks base method.
+func (m *MockDBRepo) ResolveOptionRequest(ctx context.Context, resolution model.OptionRequestResolution) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveOptionRequest", ctx, resolution)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResolveOptionRequest indicates an expected call of ResolveOptionRequest.
+func (mr *MockDBRepoMockRecorder) ResolveOptionRequest(ctx, resolution interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveOptionRequest", reflect.TypeOf((*MockDBRepo)(nil).ResolveOptionRequest), ctx, resolution)
+}
+
+// SearchAttributeValues mocks base method.
+func (m *MockDBRepo) SearchAttributeValues(ctx context.Context, attributeId int64, query string, limit uint64) (model.SearchHitList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchAttributeValues", ctx, attributeId, query, limit)
+	ret0, _ := ret[0].(model.SearchHitList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchAttributeValues indicates an expected call of SearchAttributeValues.
+func (mr *MockDBRepoMockRecorder) SearchAttributeValues(ctx, attributeId, query, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchAttributeValues", reflect.TypeOf((*MockDBRepo)(nil).SearchAttributeValues), ctx, attributeId, query, limit)
+}
+
+// SetAttributeTranslation mocks base method.
+func (m *MockDBRepo) SetAttributeTranslation(ctx context.Context, in model.AttributeTranslation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetAttributeTranslation", ctx, in)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetAttributeTranslation indicates an expected call of SetAttributeTranslation.
+func (mr *MockDBRepoMockRecorder) SetAttributeTranslation(ctx, in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAttributeTranslation", reflect.TypeOf((*MockDBRepo)(nil).SetAttributeTranslation), ctx, in)
+}
+
+// SetNormalizedValue mocks base method.
+func (m *MockDBRepo) SetNormalizedValue(ctx context.Context, in model.AttributeValue) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetNormalizedValue", ctx, in)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetNormalizedValue indicates an expected call of SetNormalizedValue.
+func (mr *MockDBRepoMockRecorder) SetNormalizedValue(ctx, in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetNormalizedValue", reflect.TypeOf((*MockDBRepo)(nil).SetNormalizedValue), ctx, in)
+}
+
+// SetOptionRequestNormalizedValue mocks base method.
+func (m *MockDBRepo) SetOptionRequestNormalizedValue(ctx context.Context, in model.OptionRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetOptionRequestNormalizedValue", ctx, in)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetOptionRequestNormalizedValue indicates an expected call of SetOptionRequestNormalizedValue.
+func (mr *MockDBRepoMockRecorder) SetOptionRequestNormalizedValue(ctx, in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetOptionRequestNormalizedValue", reflect.TypeOf((*MockDBRepo)(nil).SetOptionRequestNormalizedValue), ctx, in)
+}
+
+// SetOptionRequestParent mocks base method.
+func (m *MockDBRepo) SetOptionRequestParent(ctx context.Context, id, parentId int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetOptionRequestParent", ctx, id, parentId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetOptionRequestParent indicates an expected call of SetOptionRequestParent.
+func (mr *MockDBRepoMockRecorder) SetOptionRequestParent(ctx, id, parentId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetOptionRequestParent", reflect.TypeOf((*MockDBRepo)(nil).SetOptionRequestParent), ctx, id, parentId)
+}
+
+// SetValueTranslation mocks base method.
+func (m *MockDBRepo) SetValueTranslation(ctx context.Context, in model.ValueTranslation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetValueTranslation", ctx, in)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetValueTranslation indicates an expected call of SetValueTranslation.
+func (mr *MockDBRepoMockRecorder) SetValueTranslation(ctx, in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetValueTranslation", reflect.TypeOf((*MockDBRepo)(nil).SetValueTranslation), ctx, in)
+}
+
+// UpdateAttribute mocks base method.
+func (m *MockDBRepo) UpdateAttribute(ctx context.Context, id int64, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAttribute", ctx, id, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAttribute indicates an expected call of UpdateAttribute.
+func (mr *MockDBRepoMockRecorder) UpdateAttribute(ctx, id, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAttribute", reflect.TypeOf((*MockDBRepo)(nil).UpdateAttribute), ctx, id, name)
+}
+
+// UpdateAttributeValue mocks base method.
+func (m *MockDBRepo) UpdateAttributeValue(ctx context.Context, in model.AttributeValueUpdate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAttributeValue", ctx, in)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAttributeValue indicates an expected call of UpdateAttributeValue.
+func (mr *MockDBRepoMockRecorder) UpdateAttributeValue(ctx, in interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetValuesByAttributeId", reflect.TypeOf((*MockDBRepo)(nil).GetValuesByAttributeId), ctx, attributeId)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAttributeValue", reflect.TypeOf((*MockDBRepo)(nil).UpdateAttributeValue), ctx, in)
 }
 
-// MockSetAttributeProducer is a mock of SetAttributeProducer interface.
-type MockSetAttributeProducer struct {
+// WithTx mocks base method.
+func (m *MockDBRepo) WithTx(ctx context.Context, fn func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithTx", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WithTx indicates an expected call of WithTx.
+func (mr *MockDBRepoMockRecorder) WithTx(ctx, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithTx", reflect.TypeOf((*MockDBRepo)(nil).WithTx), ctx, fn)
+}
+
+// MockTreeCache is a mock of TreeCache interface.
+type MockTreeCache struct {
 	ctrl     *gomock.Controller
-	recorder *MockSetAttributeProducerMockRecorder
+	recorder *MockTreeCacheMockRecorder
 }
 
-// MockSetAttributeProducerMockRecorder is the mock recorder for MockSetAttributeProducer.
-type MockSetAttributeProducerMockRecorder struct {
-	mock *MockSetAttributeProducer
+// MockTreeCacheMockRecorder is the mock recorder for MockTreeCache.
+type MockTreeCacheMockRecorder struct {
+	mock *MockTreeCache
 }
 
-// NewMockSetAttributeProducer creates a new mock instance.
-func NewMockSetAttributeProducer(ctrl *gomock.Controller) *MockSetAttributeProducer {
-	mock := &MockSetAttributeProducer{ctrl: ctrl}
-	mock.recorder = &MockSetAttributeProducerMockRecorder{mock}
+// NewMockTreeCache creates a new mock instance.
+func NewMockTreeCache(ctrl *gomock.Controller) *MockTreeCache {
+	mock := &MockTreeCache{ctrl: ctrl}
+	mock.recorder = &MockTreeCacheMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockSetAttributeProducer) EXPECT() *MockSetAttributeProducerMockRecorder {
+func (m *MockTreeCache) EXPECT() *MockTreeCacheMockRecorder {
 	return m.recorder
 }
 
-// ProduceMessage mocks base method.
-func (m *MockSetAttributeProducer) ProduceMessage(ctx context.Context, message, key any) error {
+// GetOrLoad mocks base method.
+func (m *MockTreeCache) GetOrLoad(attributeId, version int64, includeDeleted bool, locale string, load func() ([]*optionhub.Option, error)) ([]*optionhub.Option, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ProduceMessage", ctx, message, key)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "GetOrLoad", attributeId, version, includeDeleted, locale, load)
+	ret0, _ := ret[0].([]*optionhub.Option)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrLoad indicates an expected call of GetOrLoad.
+func (mr *MockTreeCacheMockRecorder) GetOrLoad(attributeId, version, includeDeleted, locale, load interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrLoad", reflect.TypeOf((*MockTreeCache)(nil).GetOrLoad), attributeId, version, includeDeleted, locale, load)
+}
+
+// Invalidate mocks base method.
+func (m *MockTreeCache) Invalidate(attributeId int64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Invalidate", attributeId)
+}
+
+// Invalidate indicates an expected call of Invalidate.
+func (mr *MockTreeCacheMockRecorder) Invalidate(attributeId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Invalidate", reflect.TypeOf((*MockTreeCache)(nil).Invalidate), attributeId)
+}
+
+// MockAttributeNotifier is a mock of AttributeNotifier interface.
+type MockAttributeNotifier struct {
+	ctrl     *gomock.Controller
+	recorder *MockAttributeNotifierMockRecorder
+}
+
+// MockAttributeNotifierMockRecorder is the mock recorder for MockAttributeNotifier.
+type MockAttributeNotifierMockRecorder struct {
+	mock *MockAttributeNotifier
+}
+
+// NewMockAttributeNotifier creates a new mock instance.
+func NewMockAttributeNotifier(ctrl *gomock.Controller) *MockAttributeNotifier {
+	mock := &MockAttributeNotifier{ctrl: ctrl}
+	mock.recorder = &MockAttributeNotifierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAttributeNotifier) EXPECT() *MockAttributeNotifierMockRecorder {
+	return m.recorder
+}
+
+// Subscribe mocks base method.
+func (m *MockAttributeNotifier) Subscribe(attributeId int64) (<-chan struct{}, func()) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscribe", attributeId)
+	ret0, _ := ret[0].(<-chan struct{})
+	ret1, _ := ret[1].(func())
+	return ret0, ret1
 }
 
-// ProduceMessage indicates an expected call of ProduceMessage.
-func (mr *MockSetAttributeProducerMockRecorder) ProduceMessage(ctx, message, key interface{}) *gomock.Call {
+// Subscribe indicates an expected call of Subscribe.
+func (mr *MockAttributeNotifierMockRecorder) Subscribe(attributeId interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProduceMessage", reflect.TypeOf((*MockSetAttributeProducer)(nil).ProduceMessage), ctx, message, key)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockAttributeNotifier)(nil).Subscribe), attributeId)
 }
@@ -2,12 +2,16 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/samber/lo"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	logger_lib "github.com/s21platform/logger-lib"
 	"github.com/s21platform/optionhub-service/pkg/optionhub"
@@ -16,39 +20,102 @@ import (
 	"github.com/s21platform/optionhub-service/internal/model"
 )
 
+const (
+	defaultSearchLimit = 10
+	maxSearchLimit     = 50
+	maxResolveIds      = 1000
+	maxImportValues    = 10000
+	normalizeBatchSize = 500
+
+	watchBatchSize    = 100
+	watchPollInterval = 30 * time.Second // на случай потерянного уведомления
+)
+
 type Service struct {
 	optionhub.UnimplementedOptionhubServiceServer
-	dbR      DBRepo
-	setAttrP SetAttributeProducer
+	dbR    DBRepo
+	treeC  TreeCache
+	watchN AttributeNotifier
 }
 
-func NewService(repo DBRepo, setAttributeProducer SetAttributeProducer) *Service {
-	return &Service{dbR: repo, setAttrP: setAttributeProducer}
+func NewService(repo DBRepo, treeCache TreeCache, notifier AttributeNotifier) *Service {
+	return &Service{dbR: repo, treeC: treeCache, watchN: notifier}
 }
 
 func (s *Service) GetAttributeValues(ctx context.Context, in *optionhub.GetAttributeValuesIn) (*optionhub.GetAttributeValuesOut, error) {
 	logger := logger_lib.FromContext(ctx, config.KeyLogger)
 	logger.AddFuncName("GetAttributeValues")
 
-	values, err := s.dbR.GetValuesByAttributeId(ctx, in.AttributeId)
+	chain, err := model.ParseLocaleChain(in.Locale)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid locale: %v", err)
+	}
+
+	attribute, err := s.dbR.GetAttribute(ctx, in.AttributeId)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "attribute %d not found", in.AttributeId)
+		}
+		logger.Error(fmt.Sprintf("failed to get attribute: %v", err))
+		return nil, status.Errorf(codes.Internal, "failed to get attribute: %v", err)
+	}
+
+	if in.IfVersion != nil && *in.IfVersion == attribute.Version {
+		return &optionhub.GetAttributeValuesOut{Version: attribute.Version, NotModified: true}, nil
+	}
+
+	options, err := s.treeC.GetOrLoad(in.AttributeId, attribute.Version, in.IncludeDeleted, chain.String(), func() ([]*optionhub.Option, error) {
+		values, err := s.dbR.GetValuesByAttributeId(ctx, in.AttributeId, in.IncludeDeleted)
+		if err != nil {
+			return nil, err
+		}
+
+		err = s.localizeValues(ctx, values, chain)
+		if err != nil {
+			return nil, err
+		}
+
+		return values.FromDTO(in.IncludeDeleted), nil
+	})
 	if err != nil {
 		logger.Error(fmt.Sprintf("failed to get attribute values: %v", err))
 		return nil, status.Errorf(codes.Internal, "failed to get attribute values: %v", err)
 	}
 
-	return &optionhub.GetAttributeValuesOut{OptionList: values.FromDTO()}, nil
+	return &optionhub.GetAttributeValuesOut{OptionList: options, Version: attribute.Version}, nil
 }
 
-func (s *Service) GetOptionRequests(ctx context.Context, _ *emptypb.Empty) (*optionhub.GetOptionRequestsOut, error) {
+func (s *Service) GetOptionRequests(ctx context.Context, in *optionhub.GetOptionRequestsIn) (*optionhub.GetOptionRequestsOut, error) {
 	logger := logger_lib.FromContext(ctx, config.KeyLogger)
 	logger.AddFuncName("GetOptionRequests")
 
-	requests, err := s.dbR.GetOptionRequests(ctx)
+	filter, err := model.NewOptionRequestFilter(in)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
+	}
+
+	// заявки других пользователей видят только модераторы
+	role, _ := ctx.Value(config.KeyRole).(model.Role)
+	if !role.Allows(model.RoleModerator) {
+		userUuid, _ := ctx.Value(config.KeyUUID).(string)
+		if filter.UserUuid != nil && *filter.UserUuid != userUuid {
+			return nil, status.Error(codes.PermissionDenied, "only moderators can see option requests of other users")
+		}
+		filter.UserUuid = &userUuid
+	}
+
+	requests, total, err := s.dbR.GetOptionRequests(ctx, filter)
 	if err != nil {
 		logger.Error(fmt.Sprintf("failed to get option requests: %v", err))
 		return nil, status.Errorf(codes.Internal, "failed to get option requests: %v", err)
 	}
 
+	var nextPageToken string
+	if uint64(len(requests)) > filter.PageSize {
+		requests = requests[:filter.PageSize]
+		nextPageToken = filter.NextCursor(requests[len(requests)-1]).Encode()
+	}
+
 	attributes, err := s.dbR.GetAttributeValueById(ctx, lo.Map(requests, func(o model.OptionRequest, _ int) int64 { return o.AttributeID }))
 	if err != nil {
 		logger.Error(fmt.Sprintf("failed to get attribute value by id: %v", err))
@@ -66,6 +133,8 @@ func (s *Service) GetOptionRequests(ctx context.Context, _ *emptypb.Empty) (*opt
 
 	return &optionhub.GetOptionRequestsOut{
 		OptionRequestItem: resp,
+		NextPageToken:     nextPageToken,
+		TotalCount:        total,
 	}, nil
 }
 
@@ -81,19 +150,2037 @@ func (s *Service) AddAttributeValue(ctx context.Context, in *optionhub.AddAttrib
 		return &emptypb.Empty{}, fmt.Errorf("failed to convert grpc message to dto: %v", err)
 	}
 
-	err = s.dbR.AddAttributeValue(ctx, attributeObj)
+	attribute, err := s.dbR.GetAttribute(ctx, in.AttributeId)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return &emptypb.Empty{}, status.Errorf(codes.NotFound, "attribute %d not found", in.AttributeId)
+		}
+		logger.Error(fmt.Sprintf("failed to get attribute: %v", err))
+		return &emptypb.Empty{}, status.Errorf(codes.Internal, "failed to get attribute: %v", err)
+	}
+
+	err = attribute.Type.ValidateValue(attributeObj)
+	if err != nil {
+		return &emptypb.Empty{}, status.Errorf(codes.InvalidArgument, "invalid attribute value: %v", err)
+	}
+
+	err = s.validateParent(ctx, logger, attributeObj)
 	if err != nil {
+		return &emptypb.Empty{}, err
+	}
+
+	message, err := model.NewOutboxMessage(model.OutboxTopicSetAttribute, "set_new_attribute", &optionhub.SetNewAttribute{AttributeId: in.AttributeId})
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to build kafka message: %v", err))
+		return &emptypb.Empty{}, status.Errorf(codes.Internal, "failed to build kafka message: %v", err)
+	}
+
+	// значение и события о нём сохраняются атомарно, события отправит outbox relay
+	err = s.dbR.WithTx(ctx, func(ctx context.Context) error {
+		id, err := s.dbR.AddAttributeValue(ctx, attributeObj)
+		if err != nil {
+			return err
+		}
+
+		err = s.dbR.AddOutboxMessage(ctx, message)
+		if err != nil {
+			return err
+		}
+
+		added := attributeObj
+		added.Id = id
+		err = s.addAuditRecord(ctx, "AddAttributeValue", model.AuditEntityAttributeValue, id, nil, added)
+		if err != nil {
+			return err
+		}
+
+		return s.addCatalogEvent(ctx, &optionhub.CatalogEvent{
+			Type:        optionhub.CatalogEventType_CATALOG_EVENT_TYPE_ATTRIBUTE_VALUE_ADDED,
+			AttributeId: attributeObj.AttributeId,
+			AttributeValueAdded: &optionhub.AttributeValueAdded{
+				OptionId: id,
+				Value:    attributeObj.Value,
+				ParentId: attributeObj.ParentId,
+			},
+		})
+	})
+	if err != nil {
+		if parentErr := parentStatus(err); parentErr != nil {
+			return &emptypb.Empty{}, parentErr
+		}
+		if duplicateErr := duplicateStatus(err); duplicateErr != nil {
+			return &emptypb.Empty{}, duplicateErr
+		}
 		logger.Error(fmt.Sprintf("failed to add new attribute: %v", err))
 		return &emptypb.Empty{}, status.Errorf(codes.Aborted, "failed to add new attribute: %v", err)
 	}
 
-	message := &optionhub.SetNewAttribute{AttributeId: in.AttributeId}
+	s.treeC.Invalidate(attributeObj.AttributeId)
+
+	return &emptypb.Empty{}, nil
+}
+
+// ImportAttributeValues создаёт недостающие значения из файла в одной транзакции. Существующие значения пропускаются,
+// конфликтующие попадают в отчёт вместе со своими потомками. При dry_run каталог не меняется
+func (s *Service) ImportAttributeValues(ctx context.Context, in *optionhub.ImportAttributeValuesIn) (*optionhub.ImportAttributeValuesOut, error) {
+	logger := logger_lib.FromContext(ctx, config.KeyLogger)
+	logger.AddFuncName("ImportAttributeValues")
+
+	var (
+		roots model.ImportNodeList
+		err   error
+	)
+	switch in.Format {
+	case optionhub.ImportFormat_IMPORT_FORMAT_CSV:
+		roots, err = model.ParseImportCSV(in.Data)
+	case optionhub.ImportFormat_IMPORT_FORMAT_JSON:
+		roots, err = model.ParseImportJSON(in.Data)
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown import format: %s", in.Format)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid import data: %v", err)
+	}
+
+	if count := roots.Count(); count > maxImportValues {
+		return nil, status.Errorf(codes.InvalidArgument, "too many values to import: %d, max %d", count, maxImportValues)
+	}
 
-	err = s.setAttrP.ProduceMessage(ctx, message, "set_new_attribute")
+	attribute, err := s.dbR.GetAttribute(ctx, in.AttributeId)
 	if err != nil {
-		logger.Error(fmt.Sprintf("failed to produce kafka message: %v", err))
-		return &emptypb.Empty{}, status.Errorf(codes.Aborted, "failed to produce kafka message: %v", err)
+		if errors.Is(err, model.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "attribute %d not found", in.AttributeId)
+		}
+		logger.Error(fmt.Sprintf("failed to get attribute: %v", err))
+		return nil, status.Errorf(codes.Internal, "failed to get attribute: %v", err)
 	}
 
-	return &emptypb.Empty{}, nil
+	var plan model.ImportPlan
+	err = s.dbR.WithTx(ctx, func(ctx context.Context) error {
+		existing, err := s.dbR.GetValuesByAttributeId(ctx, in.AttributeId, true)
+		if err != nil {
+			return err
+		}
+		if in.RecreateDeleted {
+			existing = lo.Filter(existing, func(v model.AttributeValue, _ int) bool { return v.DeletedAt == nil })
+		}
+
+		aliases, err := s.dbR.GetAttributeAliases(ctx, in.AttributeId)
+		if err != nil {
+			return err
+		}
+
+		plan = model.PlanImport(roots, existing, aliases, attribute.Type)
+		if in.DryRun || plan.Count(model.ImportStatusCreated) == 0 {
+			return nil
+		}
+
+		for _, step := range plan {
+			if step.Status != model.ImportStatusCreated {
+				continue
+			}
+
+			value := model.AttributeValue{
+				AttributeId: in.AttributeId,
+				Value:       step.Value,
+				ParentId:    step.ParentID(),
+			}
+			value.Id, err = s.dbR.AddAttributeValue(ctx, value)
+			if err != nil {
+				return err
+			}
+			step.OptionID = value.Id
+
+			err = s.addAuditRecord(ctx, "ImportAttributeValues", model.AuditEntityAttributeValue, value.Id, nil, value)
+			if err != nil {
+				return err
+			}
+
+			err = s.addCatalogEvent(ctx, &optionhub.CatalogEvent{
+				Type:        optionhub.CatalogEventType_CATALOG_EVENT_TYPE_ATTRIBUTE_VALUE_ADDED,
+				AttributeId: in.AttributeId,
+				AttributeValueAdded: &optionhub.AttributeValueAdded{
+					OptionId: value.Id,
+					Value:    value.Value,
+					ParentId: value.ParentId,
+				},
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		message, err := model.NewOutboxMessage(model.OutboxTopicSetAttribute, "set_new_attribute", &optionhub.SetNewAttribute{AttributeId: in.AttributeId})
+		if err != nil {
+			return err
+		}
+
+		return s.dbR.AddOutboxMessage(ctx, message)
+	})
+	if err != nil {
+		if parentErr := parentStatus(err); parentErr != nil {
+			return nil, parentErr
+		}
+		if duplicateErr := duplicateStatus(err); duplicateErr != nil {
+			return nil, duplicateErr
+		}
+		logger.Error(fmt.Sprintf("failed to import attribute values: %v", err))
+		return nil, status.Errorf(codes.Aborted, "failed to import attribute values: %v", err)
+	}
+
+	if !in.DryRun && plan.Count(model.ImportStatusCreated) > 0 {
+		s.treeC.Invalidate(in.AttributeId)
+	}
+
+	return plan.ToDTO(), nil
+}
+
+func (s *Service) GetSubtree(ctx context.Context, in *optionhub.GetSubtreeIn) (*optionhub.GetSubtreeOut, error) {
+	logger := logger_lib.FromContext(ctx, config.KeyLogger)
+	logger.AddFuncName("GetSubtree")
+
+	if in.Depth < 0 {
+		return nil, status.Error(codes.InvalidArgument, "depth must not be negative")
+	}
+
+	chain, err := model.ParseLocaleChain(in.Locale)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid locale: %v", err)
+	}
+
+	values, err := s.dbR.GetSubtree(ctx, in.OptionId, in.Depth)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to get subtree: %v", err))
+		return nil, status.Errorf(codes.Internal, "failed to get subtree: %v", err)
+	}
+
+	err = s.localizeValues(ctx, values, chain)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	root := values.SubtreeFromDTO(in.OptionId)
+	if root == nil {
+		return nil, status.Errorf(codes.NotFound, "option %d not found", in.OptionId)
+	}
+
+	return &optionhub.GetSubtreeOut{Option: root}, nil
+}
+
+func (s *Service) GetAncestors(ctx context.Context, in *optionhub.GetAncestorsIn) (*optionhub.GetAncestorsOut, error) {
+	logger := logger_lib.FromContext(ctx, config.KeyLogger)
+	logger.AddFuncName("GetAncestors")
+
+	chain, err := model.ParseLocaleChain(in.Locale)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid locale: %v", err)
+	}
+
+	option, err := s.dbR.GetAttributeValue(ctx, in.OptionId)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "option %d not found", in.OptionId)
+		}
+		logger.Error(fmt.Sprintf("failed to get attribute value: %v", err))
+		return nil, status.Errorf(codes.Internal, "failed to get attribute value: %v", err)
+	}
+
+	ancestors, err := s.dbR.GetAncestors(ctx, option.Id)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to get ancestors: %v", err))
+		return nil, status.Errorf(codes.Internal, "failed to get ancestors: %v", err)
+	}
+
+	err = s.localizeValues(ctx, ancestors, chain)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &optionhub.GetAncestorsOut{Ancestors: ancestors.FlatFromDTO()}, nil
+}
+
+func (s *Service) GetChildren(ctx context.Context, in *optionhub.GetChildrenIn) (*optionhub.GetChildrenOut, error) {
+	logger := logger_lib.FromContext(ctx, config.KeyLogger)
+	logger.AddFuncName("GetChildren")
+
+	chain, err := model.ParseLocaleChain(in.Locale)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid locale: %v", err)
+	}
+
+	option, err := s.dbR.GetAttributeValue(ctx, in.OptionId)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "option %d not found", in.OptionId)
+		}
+		logger.Error(fmt.Sprintf("failed to get attribute value: %v", err))
+		return nil, status.Errorf(codes.Internal, "failed to get attribute value: %v", err)
+	}
+
+	// у удалённого значения не бывает живых потомков, пустой список спутал бы его с листом
+	if option.DeletedAt != nil {
+		return nil, status.Errorf(codes.NotFound, "option %d not found", in.OptionId)
+	}
+
+	children, err := s.dbR.GetChildren(ctx, option.Id)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to get children: %v", err))
+		return nil, status.Errorf(codes.Internal, "failed to get children: %v", err)
+	}
+
+	err = s.localizeValues(ctx, children, chain)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &optionhub.GetChildrenOut{Children: children.FlatFromDTO()}, nil
+}
+
+func (s *Service) ResolveOptions(ctx context.Context, in *optionhub.ResolveOptionsIn) (*optionhub.ResolveOptionsOut, error) {
+	logger := logger_lib.FromContext(ctx, config.KeyLogger)
+	logger.AddFuncName("ResolveOptions")
+
+	ids := lo.Uniq(in.OptionIds)
+	if len(ids) > maxResolveIds {
+		return nil, status.Errorf(codes.InvalidArgument, "too many option ids: %d, max %d", len(ids), maxResolveIds)
+	}
+
+	chain, err := model.ParseLocaleChain(in.Locale)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid locale: %v", err)
+	}
+
+	if len(ids) == 0 {
+		return &optionhub.ResolveOptionsOut{}, nil
+	}
+
+	redirects, err := s.dbR.GetValueRedirects(ctx, ids)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to get value redirects: %v", err))
+		return nil, status.Errorf(codes.Internal, "failed to get value redirects: %v", err)
+	}
+
+	// слитые значения резолвятся в то, во что их слили
+	redirectMap := lo.SliceToMap(redirects, func(r model.ValueRedirect) (int64, int64) { return r.SourceID, r.TargetID })
+	canonical := func(id int64) int64 {
+		if target, ok := redirectMap[id]; ok {
+			return target
+		}
+		return id
+	}
+	lookupIds := lo.Uniq(lo.Map(ids, func(id int64, _ int) int64 { return canonical(id) }))
+
+	values, err := s.dbR.GetAttributeValuesByIds(ctx, lookupIds)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to get attribute values by ids: %v", err))
+		return nil, status.Errorf(codes.Internal, "failed to get attribute values by ids: %v", err)
+	}
+
+	attributeIds := lo.Uniq(lo.Map(values, func(v model.AttributeValue, _ int) int64 { return v.AttributeId }))
+	attributes, err := s.dbR.GetAttributeValueById(ctx, attributeIds)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to get attribute value by id: %v", err))
+		return nil, status.Errorf(codes.Internal, "failed to get attribute value by id: %v", err)
+	}
+
+	paths, err := s.dbR.GetPaths(ctx, lookupIds)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to get paths: %v", err))
+		return nil, status.Errorf(codes.Internal, "failed to get paths: %v", err)
+	}
+
+	pathIds := lo.Uniq(lo.FlatMap(paths, func(p model.OptionPath, _ int) []int64 { return p.PathIDs }))
+	valueTranslations, err := s.valueTranslations(ctx, pathIds, chain)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	values.Localize(valueTranslations, chain)
+
+	attributeTranslations, err := s.attributeTranslations(ctx, attributeIds, chain)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	for i := range attributes {
+		attributes[i].Localize(attributeTranslations[attributes[i].ID], chain)
+	}
+
+	valueMap := lo.KeyBy(values, func(v model.AttributeValue) int64 { return v.Id })
+	attributeMap := lo.KeyBy(attributes, func(a model.Attribute) int64 { return a.ID })
+	pathMap := lo.KeyBy(paths, func(p model.OptionPath) int64 { return p.OptionID })
+
+	resolved := make(model.ResolvedOptionList, 0, len(ids))
+	notFound := make([]int64, 0)
+	for _, id := range ids {
+		lookupId := canonical(id)
+		value, ok := valueMap[lookupId]
+		if !ok {
+			notFound = append(notFound, id)
+			continue
+		}
+
+		option := model.ResolvedOption{
+			AttributeValue: value,
+			AttributeName:  attributeMap[value.AttributeId].Name,
+			Path:           pathMap[lookupId].Localize(valueTranslations, chain),
+		}
+		if lookupId != id {
+			option.RedirectedFrom = lo.ToPtr(id)
+		}
+		resolved = append(resolved, option)
+	}
+
+	return &optionhub.ResolveOptionsOut{
+		Options:     resolved.ToDTO(),
+		NotFoundIds: notFound,
+	}, nil
+}
+
+func (s *Service) SearchAttributeValues(ctx context.Context, in *optionhub.SearchAttributeValuesIn) (*optionhub.SearchAttributeValuesOut, error) {
+	logger := logger_lib.FromContext(ctx, config.KeyLogger)
+	logger.AddFuncName("SearchAttributeValues")
+
+	query := strings.TrimSpace(in.Query)
+	if query == "" {
+		return nil, status.Error(codes.InvalidArgument, "search query is empty")
+	}
+
+	chain, err := model.ParseLocaleChain(in.Locale)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid locale: %v", err)
+	}
+
+	limit := uint64(defaultSearchLimit)
+	switch {
+	case in.Limit < 0:
+		return nil, status.Error(codes.InvalidArgument, "limit must not be negative")
+	case in.Limit > maxSearchLimit:
+		limit = maxSearchLimit
+	case in.Limit > 0:
+		limit = uint64(in.Limit)
+	}
+
+	hits, err := s.dbR.SearchAttributeValues(ctx, in.AttributeId, query, limit)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to search attribute values: %v", err))
+		return nil, status.Errorf(codes.Internal, "failed to search attribute values: %v", err)
+	}
+
+	translations, err := s.valueTranslations(ctx, hits.Ids(), chain)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	hits.Localize(translations, chain)
+
+	return &optionhub.SearchAttributeValuesOut{Hits: hits.ToDTO()}, nil
+}
+
+// WatchAttribute отправляет снимок дерева значений атрибута, а затем его изменения по мере коммита.
+// С from_version стрим продолжается с изменений после этой версии; если их уже нет в истории, отправляется снимок
+func (s *Service) WatchAttribute(in *optionhub.WatchAttributeIn, stream optionhub.OptionhubService_WatchAttributeServer) error {
+	ctx := stream.Context()
+	logger := logger_lib.FromContext(ctx, config.KeyLogger)
+	logger.AddFuncName("WatchAttribute")
+
+	chain, err := model.ParseLocaleChain(in.Locale)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid locale: %v", err)
+	}
+
+	attribute, err := s.dbR.GetAttribute(ctx, in.AttributeId)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return status.Errorf(codes.NotFound, "attribute %d not found", in.AttributeId)
+		}
+		logger.Error(fmt.Sprintf("failed to get attribute: %v", err))
+		return status.Errorf(codes.Internal, "failed to get attribute: %v", err)
+	}
+
+	if in.FromVersion != nil && *in.FromVersion > attribute.Version {
+		return status.Errorf(codes.InvalidArgument, "from_version %d is ahead of attribute version %d", *in.FromVersion, attribute.Version)
+	}
+
+	// подписываемся до первого чтения, чтобы не пропустить изменения между чтением и ожиданием
+	updates, unsubscribe := s.watchN.Subscribe(in.AttributeId)
+	defer unsubscribe()
+
+	var version int64
+	if in.FromVersion != nil {
+		version, err = s.sendAttributeChanges(ctx, logger, stream, in.AttributeId, chain, *in.FromVersion)
+		if err == nil && version < attribute.Version {
+			// изменений до attribute.Version нет в истории
+			version, err = s.sendAttributeSnapshot(ctx, logger, stream, in.AttributeId, chain)
+		}
+	} else {
+		version, err = s.sendAttributeSnapshot(ctx, logger, stream, in.AttributeId, chain)
+	}
+	if err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-updates:
+		case <-time.After(watchPollInterval):
+		}
+
+		version, err = s.sendAttributeChanges(ctx, logger, stream, in.AttributeId, chain, version)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (s *Service) UpdateAttributeValue(ctx context.Context, in *optionhub.UpdateAttributeValueIn) (*emptypb.Empty, error) {
+	logger := logger_lib.FromContext(ctx, config.KeyLogger)
+	logger.AddFuncName("UpdateAttributeValue")
+
+	update := model.AttributeValueUpdate{
+		ID:          in.OptionId,
+		ParentId:    in.ParentId,
+		ClearParent: in.ClearParent,
+	}
+	if in.Value != nil {
+		update.Value = lo.ToPtr(model.CleanValue(*in.Value))
+	}
+
+	if update.Value == nil && update.ParentId == nil && !update.ClearParent {
+		return nil, status.Error(codes.InvalidArgument, "nothing to update")
+	}
+
+	current, err := s.dbR.GetAttributeValue(ctx, in.OptionId)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "option %d not found", in.OptionId)
+		}
+		logger.Error(fmt.Sprintf("failed to get attribute value: %v", err))
+		return nil, status.Errorf(codes.Internal, "failed to get attribute value: %v", err)
+	}
+	if current.DeletedAt != nil {
+		return nil, status.Errorf(codes.NotFound, "option %d not found", in.OptionId)
+	}
+
+	attribute, err := s.dbR.GetAttribute(ctx, current.AttributeId)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to get attribute: %v", err))
+		return nil, status.Errorf(codes.Internal, "failed to get attribute: %v", err)
+	}
+
+	updated := update.Apply(current)
+
+	err = attribute.Type.ValidateValue(updated)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid attribute value: %v", err)
+	}
+
+	if update.ParentId != nil && !update.ClearParent {
+		err = s.validateParent(ctx, logger, updated)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = s.dbR.WithTx(ctx, func(ctx context.Context) error {
+		duplicate, err := s.dbR.GetDuplicateValue(ctx, updated)
+		if err == nil {
+			return &model.DuplicateValueError{Existing: duplicate}
+		}
+		if !errors.Is(err, model.ErrNotFound) {
+			return err
+		}
+
+		err = s.dbR.UpdateAttributeValue(ctx, update)
+		if err != nil {
+			return err
+		}
+
+		err = s.addAuditRecord(ctx, "UpdateAttributeValue", model.AuditEntityAttributeValue, current.Id, current, updated)
+		if err != nil {
+			return err
+		}
+
+		return s.addCatalogEvent(ctx, &optionhub.CatalogEvent{
+			Type:        optionhub.CatalogEventType_CATALOG_EVENT_TYPE_ATTRIBUTE_VALUE_UPDATED,
+			AttributeId: current.AttributeId,
+			AttributeValueUpdated: &optionhub.AttributeValueUpdated{
+				OptionId:    current.Id,
+				OldValue:    current.Value,
+				NewValue:    updated.Value,
+				OldParentId: current.ParentId,
+				NewParentId: updated.ParentId,
+			},
+		})
+	})
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "option %d not found", in.OptionId)
+		}
+		if parentErr := parentStatus(err); parentErr != nil {
+			return nil, parentErr
+		}
+		if duplicateErr := duplicateStatus(err); duplicateErr != nil {
+			return nil, duplicateErr
+		}
+		logger.Error(fmt.Sprintf("failed to update attribute value: %v", err))
+		return nil, status.Errorf(codes.Aborted, "failed to update attribute value: %v", err)
+	}
+
+	s.treeC.Invalidate(current.AttributeId)
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Service) DeleteAttributeValue(ctx context.Context, in *optionhub.DeleteAttributeValueIn) (*emptypb.Empty, error) {
+	logger := logger_lib.FromContext(ctx, config.KeyLogger)
+	logger.AddFuncName("DeleteAttributeValue")
+
+	current, err := s.dbR.GetAttributeValue(ctx, in.OptionId)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "option %d not found", in.OptionId)
+		}
+		logger.Error(fmt.Sprintf("failed to get attribute value: %v", err))
+		return nil, status.Errorf(codes.Internal, "failed to get attribute value: %v", err)
+	}
+	if current.DeletedAt != nil {
+		return nil, status.Errorf(codes.NotFound, "option %d not found", in.OptionId)
+	}
+
+	err = s.dbR.WithTx(ctx, func(ctx context.Context) error {
+		err := s.dbR.DeleteAttributeValue(ctx, in.OptionId)
+		if err != nil {
+			return err
+		}
+
+		err = s.addAuditRecord(ctx, "DeleteAttributeValue", model.AuditEntityAttributeValue, current.Id, current, nil)
+		if err != nil {
+			return err
+		}
+
+		return s.addCatalogEvent(ctx, &optionhub.CatalogEvent{
+			Type:        optionhub.CatalogEventType_CATALOG_EVENT_TYPE_ATTRIBUTE_VALUE_DELETED,
+			AttributeId: current.AttributeId,
+			AttributeValueDeleted: &optionhub.AttributeValueDeleted{
+				OptionId: current.Id,
+				Value:    current.Value,
+				ParentId: current.ParentId,
+			},
+		})
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrNotFound):
+			return nil, status.Errorf(codes.NotFound, "option %d not found", in.OptionId)
+		case errors.Is(err, model.ErrHasChildren):
+			return nil, status.Errorf(codes.FailedPrecondition, "option %d has children, delete them first", in.OptionId)
+		}
+		logger.Error(fmt.Sprintf("failed to delete attribute value: %v", err))
+		return nil, status.Errorf(codes.Aborted, "failed to delete attribute value: %v", err)
+	}
+
+	s.treeC.Invalidate(current.AttributeId)
+
+	return &emptypb.Empty{}, nil
+}
+
+// MergeAttributeValues сливает дубль source в target. Старый id продолжает резолвиться через ResolveOptions,
+// а событие ATTRIBUTE_VALUE_MERGED говорит сервисам, хранящим id значений, заменить source на target
+func (s *Service) MergeAttributeValues(ctx context.Context, in *optionhub.MergeAttributeValuesIn) (*optionhub.MergeAttributeValuesOut, error) {
+	logger := logger_lib.FromContext(ctx, config.KeyLogger)
+	logger.AddFuncName("MergeAttributeValues")
+
+	if in.SourceOptionId == in.TargetOptionId {
+		return nil, status.Errorf(codes.InvalidArgument, "option %d cannot be merged into itself", in.SourceOptionId)
+	}
+
+	source, err := s.getLiveAttributeValue(ctx, logger, in.SourceOptionId)
+	if err != nil {
+		return nil, err
+	}
+
+	target, err := s.getLiveAttributeValue(ctx, logger, in.TargetOptionId)
+	if err != nil {
+		return nil, err
+	}
+
+	if source.AttributeId != target.AttributeId {
+		return nil, status.Errorf(codes.InvalidArgument, "option %d belongs to attribute %d, option %d to attribute %d",
+			source.Id, source.AttributeId, target.Id, target.AttributeId)
+	}
+
+	var moved []int64
+	err = s.dbR.WithTx(ctx, func(ctx context.Context) error {
+		var err error
+		moved, err = s.mergeValues(ctx, "MergeAttributeValues", source, target)
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "option %d or %d not found", source.Id, target.Id)
+		}
+		if parentErr := parentStatus(err); parentErr != nil {
+			return nil, parentErr
+		}
+		if duplicateErr := duplicateStatus(err); duplicateErr != nil {
+			return nil, duplicateErr
+		}
+		logger.Error(fmt.Sprintf("failed to merge attribute values: %v", err))
+		return nil, status.Errorf(codes.Aborted, "failed to merge attribute values: %v", err)
+	}
+
+	s.treeC.Invalidate(source.AttributeId)
+
+	return &optionhub.MergeAttributeValuesOut{MovedChildIds: moved}, nil
+}
+
+// mergeValues сливает source в target, переносит заявки на потомков source под target, пишет аудит и событие.
+// Вызывается в транзакции
+func (s *Service) mergeValues(ctx context.Context, method string, source, target model.AttributeValue) ([]int64, error) {
+	moved, err := s.dbR.MergeAttributeValues(ctx, source.Id, target.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.moveOptionRequests(ctx, method, source.Id, target.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.addAuditRecord(ctx, method, model.AuditEntityAttributeValue, source.Id, source, model.AttributeValueMerge{
+		Source:        source,
+		Target:        target,
+		MovedChildIds: moved,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.addCatalogEvent(ctx, &optionhub.CatalogEvent{
+		Type:        optionhub.CatalogEventType_CATALOG_EVENT_TYPE_ATTRIBUTE_VALUE_MERGED,
+		AttributeId: source.AttributeId,
+		AttributeValueMerged: &optionhub.AttributeValueMerged{
+			SourceOptionId: source.Id,
+			SourceValue:    source.Value,
+			TargetOptionId: target.Id,
+			TargetValue:    target.Value,
+			MovedChildIds:  moved,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return moved, nil
+}
+
+// moveOptionRequests перепривязывает ожидающие заявки на потомков sourceId к targetId. Заявка, совпавшая
+// с ожидающей заявкой под targetId, отклоняется как её дубль. Вызывается в транзакции
+func (s *Service) moveOptionRequests(ctx context.Context, method string, sourceId, targetId int64) error {
+	requests, err := s.dbR.GetPendingRequestsByParent(ctx, sourceId)
+	if err != nil {
+		return err
+	}
+
+	for _, request := range requests {
+		moved := request
+		moved.ParentId = lo.ToPtr(targetId)
+
+		duplicate, err := s.dbR.GetPendingDuplicateRequest(ctx, moved)
+		if err == nil {
+			err = s.rejectOptionRequest(ctx, method, request, fmt.Sprintf("duplicate of option request %d", duplicate.ID))
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		if !errors.Is(err, model.ErrNotFound) {
+			return err
+		}
+
+		err = s.dbR.SetOptionRequestParent(ctx, request.ID, targetId)
+		if err != nil {
+			return err
+		}
+
+		err = s.addAuditRecord(ctx, method, model.AuditEntityOptionRequest, request.ID, request, moved)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// NormalizeLegacyValues проставляет normalized_value значениям, созданным до его появления, и возвращает их число.
+// Живое значение, совпавшее после нормализации с уже проверенным, сливается в него так же, как через MergeAttributeValues,
+// поэтому потребители получают ATTRIBUTE_VALUE_MERGED. Значения идут по возрастанию id, так что остаётся самое старое.
+// Вызывается при старте сервиса; когда все ключи проставлены, это один пустой запрос
+func (s *Service) NormalizeLegacyValues(ctx context.Context) (int, error) {
+	var total int
+
+	for {
+		var batch int
+		err := s.dbR.WithTx(ctx, func(ctx context.Context) error {
+			values, err := s.dbR.GetUnnormalizedValues(ctx, normalizeBatchSize)
+			if err != nil {
+				return err
+			}
+			batch = len(values)
+
+			for _, value := range values {
+				err = s.normalizeLegacyValue(ctx, value.Id)
+				if err != nil {
+					return err
+				}
+			}
+
+			return nil
+		})
+		if err != nil {
+			return total, fmt.Errorf("failed to normalize values: %w", err)
+		}
+
+		total += batch
+		if uint64(batch) < normalizeBatchSize {
+			return total, nil
+		}
+	}
+}
+
+// NormalizeLegacyOptionRequests проставляет normalized_value заявкам, созданным до его появления, и возвращает их число.
+// Заявка на рассмотрении, совпавшая после нормализации с более ранней, отклоняется как дубль
+func (s *Service) NormalizeLegacyOptionRequests(ctx context.Context) (int, error) {
+	var total int
+
+	for {
+		var batch int
+		err := s.dbR.WithTx(ctx, func(ctx context.Context) error {
+			requests, err := s.dbR.GetUnnormalizedOptionRequests(ctx, normalizeBatchSize)
+			if err != nil {
+				return err
+			}
+			batch = len(requests)
+
+			for _, request := range requests {
+				err = s.normalizeLegacyOptionRequest(ctx, request)
+				if err != nil {
+					return err
+				}
+			}
+
+			return nil
+		})
+		if err != nil {
+			return total, fmt.Errorf("failed to normalize option requests: %w", err)
+		}
+
+		total += batch
+		if uint64(batch) < normalizeBatchSize {
+			return total, nil
+		}
+	}
+}
+
+func (s *Service) normalizeLegacyOptionRequest(ctx context.Context, request model.OptionRequest) error {
+	if request.Status == model.OptionRequestStatusPending {
+		duplicate, err := s.dbR.GetPendingDuplicateRequest(ctx, request)
+		if err == nil {
+			err = s.rejectOptionRequest(ctx, "NormalizeLegacyOptionRequests", request,
+				fmt.Sprintf("duplicate of option request %d", duplicate.ID))
+			if err != nil {
+				return err
+			}
+		} else if !errors.Is(err, model.ErrNotFound) {
+			return err
+		}
+	}
+
+	return s.dbR.SetOptionRequestNormalizedValue(ctx, request)
+}
+
+func (s *Service) normalizeLegacyValue(ctx context.Context, id int64) error {
+	// слияние предыдущих значений пачки могло перенести это значение под другого родителя
+	value, err := s.dbR.GetAttributeValue(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if value.DeletedAt == nil {
+		existing, err := s.dbR.GetDuplicateValue(ctx, value)
+		if err == nil {
+			err = s.mergeDuplicate(ctx, value, existing)
+			if err != nil {
+				return err
+			}
+		} else if !errors.Is(err, model.ErrNotFound) {
+			return err
+		}
+	}
+
+	// слитое значение уже удалено, ключ ему нужен только для полноты
+	return s.dbR.SetNormalizedValue(ctx, value)
+}
+
+// mergeDuplicate сливает source в target. Если у них есть одинаковые потомки, сначала сливает их
+func (s *Service) mergeDuplicate(ctx context.Context, source, target model.AttributeValue) error {
+	for {
+		_, err := s.mergeValues(ctx, "NormalizeLegacyValues", source, target)
+
+		var duplicate *model.DuplicateValueError
+		if !errors.As(err, &duplicate) {
+			return err
+		}
+
+		child, err := s.dbR.GetDuplicateValue(ctx, model.AttributeValue{
+			AttributeId: source.AttributeId,
+			Value:       duplicate.Existing.Value,
+			ParentId:    &source.Id,
+		})
+		if err != nil {
+			return err
+		}
+
+		err = s.mergeDuplicate(ctx, child, duplicate.Existing)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (s *Service) CreateAttribute(ctx context.Context, in *optionhub.CreateAttributeIn) (*optionhub.CreateAttributeOut, error) {
+	logger := logger_lib.FromContext(ctx, config.KeyLogger)
+	logger.AddFuncName("CreateAttribute")
+
+	name := strings.TrimSpace(in.Name)
+	if name == "" {
+		return nil, status.Error(codes.InvalidArgument, "attribute name is empty")
+	}
+
+	attributeType, err := model.AttributeTypeFromDTO(in.Type)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid attribute type: %v", err)
+	}
+
+	var id int64
+	err = s.dbR.WithTx(ctx, func(ctx context.Context) error {
+		var err error
+		id, err = s.dbR.CreateAttribute(ctx, model.Attribute{Name: name, Type: attributeType})
+		if err != nil {
+			return err
+		}
+
+		err = s.addAuditRecord(ctx, "CreateAttribute", model.AuditEntityAttribute, id, nil, model.Attribute{ID: id, Name: name, Type: attributeType})
+		if err != nil {
+			return err
+		}
+
+		return s.addCatalogEvent(ctx, &optionhub.CatalogEvent{
+			Type:        optionhub.CatalogEventType_CATALOG_EVENT_TYPE_ATTRIBUTE_CREATED,
+			AttributeId: id,
+			AttributeCreated: &optionhub.AttributeCreated{
+				Name: name,
+				Type: attributeType.ToDTO(),
+			},
+		})
+	})
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to create attribute: %v", err))
+		return nil, status.Errorf(codes.Internal, "failed to create attribute: %v", err)
+	}
+
+	return &optionhub.CreateAttributeOut{AttributeId: id}, nil
+}
+
+func (s *Service) GetAttribute(ctx context.Context, in *optionhub.GetAttributeIn) (*optionhub.Attribute, error) {
+	logger := logger_lib.FromContext(ctx, config.KeyLogger)
+	logger.AddFuncName("GetAttribute")
+
+	chain, err := model.ParseLocaleChain(in.Locale)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid locale: %v", err)
+	}
+
+	attribute, err := s.dbR.GetAttribute(ctx, in.AttributeId)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "attribute %d not found", in.AttributeId)
+		}
+		logger.Error(fmt.Sprintf("failed to get attribute: %v", err))
+		return nil, status.Errorf(codes.Internal, "failed to get attribute: %v", err)
+	}
+
+	translations, err := s.dbR.GetAttributeTranslations(ctx, []int64{attribute.ID})
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to get attribute translations: %v", err))
+		return nil, status.Errorf(codes.Internal, "failed to get attribute translations: %v", err)
+	}
+
+	attribute.Localize(translations.ByAttribute()[attribute.ID], chain)
+
+	return attribute.ToDTO(), nil
+}
+
+func (s *Service) ListAttributes(ctx context.Context, in *optionhub.ListAttributesIn) (*optionhub.ListAttributesOut, error) {
+	logger := logger_lib.FromContext(ctx, config.KeyLogger)
+	logger.AddFuncName("ListAttributes")
+
+	chain, err := model.ParseLocaleChain(in.Locale)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid locale: %v", err)
+	}
+
+	attributes, err := s.dbR.ListAttributes(ctx)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to list attributes: %v", err))
+		return nil, status.Errorf(codes.Internal, "failed to list attributes: %v", err)
+	}
+
+	translations, err := s.dbR.GetAttributeTranslations(ctx, lo.Map(attributes, func(a model.Attribute, _ int) int64 { return a.ID }))
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to get attribute translations: %v", err))
+		return nil, status.Errorf(codes.Internal, "failed to get attribute translations: %v", err)
+	}
+
+	byAttribute := translations.ByAttribute()
+	for i := range attributes {
+		attributes[i].Localize(byAttribute[attributes[i].ID], chain)
+	}
+
+	return &optionhub.ListAttributesOut{Attributes: attributes.ToDTO()}, nil
+}
+
+func (s *Service) UpdateAttribute(ctx context.Context, in *optionhub.UpdateAttributeIn) (*emptypb.Empty, error) {
+	logger := logger_lib.FromContext(ctx, config.KeyLogger)
+	logger.AddFuncName("UpdateAttribute")
+
+	name := strings.TrimSpace(in.Name)
+	if name == "" {
+		return nil, status.Error(codes.InvalidArgument, "attribute name is empty")
+	}
+
+	current, err := s.dbR.GetAttribute(ctx, in.AttributeId)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "attribute %d not found", in.AttributeId)
+		}
+		logger.Error(fmt.Sprintf("failed to get attribute: %v", err))
+		return nil, status.Errorf(codes.Internal, "failed to get attribute: %v", err)
+	}
+
+	err = s.dbR.WithTx(ctx, func(ctx context.Context) error {
+		err := s.dbR.UpdateAttribute(ctx, in.AttributeId, name)
+		if err != nil {
+			return err
+		}
+
+		updated := current
+		updated.Name = name
+		err = s.addAuditRecord(ctx, "UpdateAttribute", model.AuditEntityAttribute, current.ID, current, updated)
+		if err != nil {
+			return err
+		}
+
+		return s.addCatalogEvent(ctx, &optionhub.CatalogEvent{
+			Type:        optionhub.CatalogEventType_CATALOG_EVENT_TYPE_ATTRIBUTE_UPDATED,
+			AttributeId: current.ID,
+			AttributeUpdated: &optionhub.AttributeUpdated{
+				OldName: current.Name,
+				NewName: name,
+			},
+		})
+	})
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "attribute %d not found", in.AttributeId)
+		}
+		logger.Error(fmt.Sprintf("failed to update attribute: %v", err))
+		return nil, status.Errorf(codes.Internal, "failed to update attribute: %v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Service) DeleteAttribute(ctx context.Context, in *optionhub.DeleteAttributeIn) (*emptypb.Empty, error) {
+	logger := logger_lib.FromContext(ctx, config.KeyLogger)
+	logger.AddFuncName("DeleteAttribute")
+
+	current, err := s.dbR.GetAttribute(ctx, in.AttributeId)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "attribute %d not found", in.AttributeId)
+		}
+		logger.Error(fmt.Sprintf("failed to get attribute: %v", err))
+		return nil, status.Errorf(codes.Internal, "failed to get attribute: %v", err)
+	}
+
+	err = s.dbR.WithTx(ctx, func(ctx context.Context) error {
+		err := s.dbR.DeleteAttribute(ctx, in.AttributeId)
+		if err != nil {
+			return err
+		}
+
+		err = s.addAuditRecord(ctx, "DeleteAttribute", model.AuditEntityAttribute, current.ID, current, nil)
+		if err != nil {
+			return err
+		}
+
+		return s.addCatalogEvent(ctx, &optionhub.CatalogEvent{
+			Type:        optionhub.CatalogEventType_CATALOG_EVENT_TYPE_ATTRIBUTE_DELETED,
+			AttributeId: current.ID,
+			AttributeDeleted: &optionhub.AttributeDeleted{
+				Name: current.Name,
+				Type: current.Type.ToDTO(),
+			},
+		})
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrNotFound):
+			return nil, status.Errorf(codes.NotFound, "attribute %d not found", in.AttributeId)
+		case errors.Is(err, model.ErrHasValues):
+			return nil, status.Errorf(codes.FailedPrecondition, "attribute %d has values, their ids must keep resolving", in.AttributeId)
+		}
+		logger.Error(fmt.Sprintf("failed to delete attribute: %v", err))
+		return nil, status.Errorf(codes.Internal, "failed to delete attribute: %v", err)
+	}
+
+	s.treeC.Invalidate(current.ID)
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Service) CreateOptionRequest(ctx context.Context, in *optionhub.CreateOptionRequestIn) (*optionhub.CreateOptionRequestOut, error) {
+	logger := logger_lib.FromContext(ctx, config.KeyLogger)
+	logger.AddFuncName("CreateOptionRequest")
+
+	userUuid, ok := ctx.Value(config.KeyUUID).(string)
+	if !ok || userUuid == "" {
+		return nil, status.Error(codes.Unauthenticated, "no uuid in context")
+	}
+
+	request := model.OptionRequest{
+		AttributeID: in.AttributeId,
+		Value:       model.CleanValue(in.Value),
+		ParentId:    in.ParentId,
+		UserUuid:    userUuid,
+	}
+
+	attribute, err := s.dbR.GetAttribute(ctx, in.AttributeId)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "attribute %d not found", in.AttributeId)
+		}
+		logger.Error(fmt.Sprintf("failed to get attribute: %v", err))
+		return nil, status.Errorf(codes.Internal, "failed to get attribute: %v", err)
+	}
+
+	proposed := model.AttributeValue{AttributeId: request.AttributeID, Value: request.Value, ParentId: request.ParentId}
+
+	err = attribute.Type.ValidateValue(proposed)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid attribute value: %v", err)
+	}
+
+	err = s.validateParent(ctx, logger, proposed)
+	if err != nil {
+		return nil, err
+	}
+
+	var id int64
+	err = s.dbR.WithTx(ctx, func(ctx context.Context) error {
+		var err error
+		id, err = s.dbR.CreateOptionRequest(ctx, request)
+		if err != nil {
+			return err
+		}
+
+		created := request
+		created.ID = id
+		created.Status = model.OptionRequestStatusPending
+		return s.addAuditRecord(ctx, "CreateOptionRequest", model.AuditEntityOptionRequest, id, nil, created)
+	})
+	if err != nil {
+		if duplicateErr := duplicateStatus(err); duplicateErr != nil {
+			return nil, duplicateErr
+		}
+		logger.Error(fmt.Sprintf("failed to create option request: %v", err))
+		return nil, status.Errorf(codes.Internal, "failed to create option request: %v", err)
+	}
+
+	return &optionhub.CreateOptionRequestOut{OptionRequestId: id}, nil
+}
+
+func (s *Service) ApproveOptionRequest(ctx context.Context, in *optionhub.ApproveOptionRequestIn) (*optionhub.ApproveOptionRequestOut, error) {
+	logger := logger_lib.FromContext(ctx, config.KeyLogger)
+	logger.AddFuncName("ApproveOptionRequest")
+
+	request, err := s.getOptionRequestForResolution(ctx, logger, in.OptionRequestId, model.OptionRequestStatusApproved)
+	if err != nil {
+		return nil, err
+	}
+
+	attribute, err := s.dbR.GetAttribute(ctx, request.AttributeID)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to get attribute: %v", err))
+		return nil, status.Errorf(codes.Internal, "failed to get attribute: %v", err)
+	}
+
+	value := model.AttributeValue{
+		AttributeId: request.AttributeID,
+		Value:       model.CleanValue(request.Value),
+		ParentId:    request.ParentId,
+	}
+	if in.ParentId != nil {
+		value.ParentId = in.ParentId
+	}
+
+	err = attribute.Type.ValidateValue(value)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid attribute value: %v", err)
+	}
+
+	err = s.validateParent(ctx, logger, value)
+	if err != nil {
+		return nil, err
+	}
+
+	message, err := model.NewOutboxMessage(model.OutboxTopicSetAttribute, "set_new_attribute", &optionhub.SetNewAttribute{AttributeId: request.AttributeID})
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to build kafka message: %v", err))
+		return nil, status.Errorf(codes.Internal, "failed to build kafka message: %v", err)
+	}
+
+	moderatorUuid, _ := ctx.Value(config.KeyUUID).(string)
+
+	var optionID int64
+	err = s.dbR.WithTx(ctx, func(ctx context.Context) error {
+		var err error
+		optionID, err = s.dbR.ApproveOptionRequest(ctx, model.OptionRequestResolution{
+			RequestID:     in.OptionRequestId,
+			Status:        model.OptionRequestStatusApproved,
+			ModeratorUuid: moderatorUuid,
+		}, value)
+		if err != nil {
+			return err
+		}
+
+		err = s.dbR.AddOutboxMessage(ctx, message)
+		if err != nil {
+			return err
+		}
+
+		approved := request
+		approved.Status = model.OptionRequestStatusApproved
+		err = s.addAuditRecord(ctx, "ApproveOptionRequest", model.AuditEntityOptionRequest, request.ID, request, approved)
+		if err != nil {
+			return err
+		}
+
+		added := value
+		added.Id = optionID
+		err = s.addAuditRecord(ctx, "ApproveOptionRequest", model.AuditEntityAttributeValue, optionID, nil, added)
+		if err != nil {
+			return err
+		}
+
+		err = s.addCatalogEvent(ctx, &optionhub.CatalogEvent{
+			Type:        optionhub.CatalogEventType_CATALOG_EVENT_TYPE_ATTRIBUTE_VALUE_ADDED,
+			AttributeId: value.AttributeId,
+			AttributeValueAdded: &optionhub.AttributeValueAdded{
+				OptionId: optionID,
+				Value:    value.Value,
+				ParentId: value.ParentId,
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		return s.addCatalogEvent(ctx, &optionhub.CatalogEvent{
+			Type:        optionhub.CatalogEventType_CATALOG_EVENT_TYPE_OPTION_REQUEST_APPROVED,
+			AttributeId: value.AttributeId,
+			OptionRequestApproved: &optionhub.OptionRequestApproved{
+				OptionRequestId: request.ID,
+				OptionId:        optionID,
+				Value:           value.Value,
+				ParentId:        value.ParentId,
+				UserUuid:        request.UserUuid,
+			},
+		})
+	})
+	if err != nil {
+		return nil, resolutionError(logger, err, in.OptionRequestId, "failed to approve option request")
+	}
+
+	s.treeC.Invalidate(value.AttributeId)
+
+	return &optionhub.ApproveOptionRequestOut{OptionId: optionID}, nil
+}
+
+func (s *Service) RejectOptionRequest(ctx context.Context, in *optionhub.RejectOptionRequestIn) (*emptypb.Empty, error) {
+	logger := logger_lib.FromContext(ctx, config.KeyLogger)
+	logger.AddFuncName("RejectOptionRequest")
+
+	reason := strings.TrimSpace(in.Reason)
+	if reason == "" {
+		return nil, status.Error(codes.InvalidArgument, "reject reason is empty")
+	}
+
+	request, err := s.getOptionRequestForResolution(ctx, logger, in.OptionRequestId, model.OptionRequestStatusRejected)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.dbR.WithTx(ctx, func(ctx context.Context) error {
+		return s.rejectOptionRequest(ctx, "RejectOptionRequest", request, reason)
+	})
+	if err != nil {
+		return nil, resolutionError(logger, err, in.OptionRequestId, "failed to reject option request")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// rejectOptionRequest отклоняет заявку, пишет аудит и событие. Вызывается в транзакции
+func (s *Service) rejectOptionRequest(ctx context.Context, method string, request model.OptionRequest, reason string) error {
+	moderatorUuid, _ := ctx.Value(config.KeyUUID).(string)
+	err := s.dbR.ResolveOptionRequest(ctx, model.OptionRequestResolution{
+		RequestID:     request.ID,
+		Status:        model.OptionRequestStatusRejected,
+		ModeratorUuid: moderatorUuid,
+		Reason:        &reason,
+	})
+	if err != nil {
+		return err
+	}
+
+	rejected := request
+	rejected.Status = model.OptionRequestStatusRejected
+	err = s.addAuditRecord(ctx, method, model.AuditEntityOptionRequest, request.ID, request, rejected)
+	if err != nil {
+		return err
+	}
+
+	return s.addCatalogEvent(ctx, &optionhub.CatalogEvent{
+		Type:        optionhub.CatalogEventType_CATALOG_EVENT_TYPE_OPTION_REQUEST_REJECTED,
+		AttributeId: request.AttributeID,
+		OptionRequestRejected: &optionhub.OptionRequestRejected{
+			OptionRequestId: request.ID,
+			Value:           request.Value,
+			Reason:          reason,
+			UserUuid:        request.UserUuid,
+		},
+	})
+}
+
+func (s *Service) MergeOptionRequest(ctx context.Context, in *optionhub.MergeOptionRequestIn) (*emptypb.Empty, error) {
+	logger := logger_lib.FromContext(ctx, config.KeyLogger)
+	logger.AddFuncName("MergeOptionRequest")
+
+	request, err := s.getOptionRequestForResolution(ctx, logger, in.OptionRequestId, model.OptionRequestStatusMerged)
+	if err != nil {
+		return nil, err
+	}
+
+	option, err := s.dbR.GetAttributeValue(ctx, in.OptionId)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "option %d not found", in.OptionId)
+		}
+		logger.Error(fmt.Sprintf("failed to get attribute value: %v", err))
+		return nil, status.Errorf(codes.Internal, "failed to get attribute value: %v", err)
+	}
+
+	if option.DeletedAt != nil {
+		return nil, status.Errorf(codes.NotFound, "option %d not found", in.OptionId)
+	}
+
+	if option.AttributeId != request.AttributeID {
+		return nil, status.Errorf(codes.InvalidArgument, "option %d belongs to attribute %d, request is for attribute %d",
+			option.Id, option.AttributeId, request.AttributeID)
+	}
+
+	moderatorUuid, _ := ctx.Value(config.KeyUUID).(string)
+	err = s.dbR.WithTx(ctx, func(ctx context.Context) error {
+		err := s.dbR.ResolveOptionRequest(ctx, model.OptionRequestResolution{
+			RequestID:     in.OptionRequestId,
+			Status:        model.OptionRequestStatusMerged,
+			ModeratorUuid: moderatorUuid,
+			OptionID:      &option.Id,
+		})
+		if err != nil {
+			return err
+		}
+
+		merged := request
+		merged.Status = model.OptionRequestStatusMerged
+		err = s.addAuditRecord(ctx, "MergeOptionRequest", model.AuditEntityOptionRequest, request.ID, request, merged)
+		if err != nil {
+			return err
+		}
+
+		return s.addCatalogEvent(ctx, &optionhub.CatalogEvent{
+			Type:        optionhub.CatalogEventType_CATALOG_EVENT_TYPE_OPTION_REQUEST_MERGED,
+			AttributeId: request.AttributeID,
+			OptionRequestMerged: &optionhub.OptionRequestMerged{
+				OptionRequestId: request.ID,
+				OptionId:        option.Id,
+				Value:           request.Value,
+				UserUuid:        request.UserUuid,
+			},
+		})
+	})
+	if err != nil {
+		return nil, resolutionError(logger, err, in.OptionRequestId, "failed to merge option request")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Service) SetAttributeTranslation(ctx context.Context, in *optionhub.SetAttributeTranslationIn) (*emptypb.Empty, error) {
+	logger := logger_lib.FromContext(ctx, config.KeyLogger)
+	logger.AddFuncName("SetAttributeTranslation")
+
+	locale, err := model.NormalizeLocale(in.Locale)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid locale: %v", err)
+	}
+
+	translation := model.AttributeTranslation{
+		AttributeID: in.AttributeId,
+		Locale:      locale,
+		Name:        strings.TrimSpace(in.Name),
+	}
+	if translation.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "translation is empty")
+	}
+
+	_, err = s.dbR.GetAttribute(ctx, in.AttributeId)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "attribute %d not found", in.AttributeId)
+		}
+		logger.Error(fmt.Sprintf("failed to get attribute: %v", err))
+		return nil, status.Errorf(codes.Internal, "failed to get attribute: %v", err)
+	}
+
+	err = s.dbR.WithTx(ctx, func(ctx context.Context) error {
+		current, err := s.dbR.GetAttributeTranslations(ctx, []int64{in.AttributeId})
+		if err != nil {
+			return err
+		}
+
+		err = s.dbR.SetAttributeTranslation(ctx, translation)
+		if err != nil {
+			return err
+		}
+
+		var before any
+		if previous, ok := lo.Find(current, func(t model.AttributeTranslation) bool { return t.Locale == locale }); ok {
+			before = previous
+		}
+		return s.addAuditRecord(ctx, "SetAttributeTranslation", model.AuditEntityAttribute, in.AttributeId, before, translation)
+	})
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to set attribute translation: %v", err))
+		return nil, status.Errorf(codes.Aborted, "failed to set attribute translation: %v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Service) DeleteAttributeTranslation(ctx context.Context, in *optionhub.DeleteAttributeTranslationIn) (*emptypb.Empty, error) {
+	logger := logger_lib.FromContext(ctx, config.KeyLogger)
+	logger.AddFuncName("DeleteAttributeTranslation")
+
+	locale, err := model.NormalizeLocale(in.Locale)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid locale: %v", err)
+	}
+
+	err = s.dbR.WithTx(ctx, func(ctx context.Context) error {
+		current, err := s.dbR.GetAttributeTranslations(ctx, []int64{in.AttributeId})
+		if err != nil {
+			return err
+		}
+
+		err = s.dbR.DeleteAttributeTranslation(ctx, in.AttributeId, locale)
+		if err != nil {
+			return err
+		}
+
+		before, _ := lo.Find(current, func(t model.AttributeTranslation) bool { return t.Locale == locale })
+		return s.addAuditRecord(ctx, "DeleteAttributeTranslation", model.AuditEntityAttribute, in.AttributeId, before, nil)
+	})
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "translation of attribute %d to %s not found", in.AttributeId, locale)
+		}
+		logger.Error(fmt.Sprintf("failed to delete attribute translation: %v", err))
+		return nil, status.Errorf(codes.Aborted, "failed to delete attribute translation: %v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Service) SetAttributeValueTranslation(ctx context.Context, in *optionhub.SetAttributeValueTranslationIn) (*emptypb.Empty, error) {
+	logger := logger_lib.FromContext(ctx, config.KeyLogger)
+	logger.AddFuncName("SetAttributeValueTranslation")
+
+	locale, err := model.NormalizeLocale(in.Locale)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid locale: %v", err)
+	}
+
+	translation := model.ValueTranslation{
+		OptionID: in.OptionId,
+		Locale:   locale,
+		Value:    strings.TrimSpace(in.Value),
+	}
+	if translation.Value == "" {
+		return nil, status.Error(codes.InvalidArgument, "translation is empty")
+	}
+
+	option, err := s.dbR.GetAttributeValue(ctx, in.OptionId)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "option %d not found", in.OptionId)
+		}
+		logger.Error(fmt.Sprintf("failed to get attribute value: %v", err))
+		return nil, status.Errorf(codes.Internal, "failed to get attribute value: %v", err)
+	}
+	if option.DeletedAt != nil {
+		return nil, status.Errorf(codes.NotFound, "option %d not found", in.OptionId)
+	}
+
+	err = s.dbR.WithTx(ctx, func(ctx context.Context) error {
+		current, err := s.dbR.GetValueTranslations(ctx, []int64{in.OptionId})
+		if err != nil {
+			return err
+		}
+
+		err = s.dbR.SetValueTranslation(ctx, translation)
+		if err != nil {
+			return err
+		}
+
+		var before any
+		if previous, ok := lo.Find(current, func(t model.ValueTranslation) bool { return t.Locale == locale }); ok {
+			before = previous
+		}
+		return s.addAuditRecord(ctx, "SetAttributeValueTranslation", model.AuditEntityAttributeValue, in.OptionId, before, translation)
+	})
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to set value translation: %v", err))
+		return nil, status.Errorf(codes.Aborted, "failed to set value translation: %v", err)
+	}
+
+	s.treeC.Invalidate(option.AttributeId)
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Service) DeleteAttributeValueTranslation(ctx context.Context, in *optionhub.DeleteAttributeValueTranslationIn) (*emptypb.Empty, error) {
+	logger := logger_lib.FromContext(ctx, config.KeyLogger)
+	logger.AddFuncName("DeleteAttributeValueTranslation")
+
+	locale, err := model.NormalizeLocale(in.Locale)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid locale: %v", err)
+	}
+
+	option, err := s.dbR.GetAttributeValue(ctx, in.OptionId)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "option %d not found", in.OptionId)
+		}
+		logger.Error(fmt.Sprintf("failed to get attribute value: %v", err))
+		return nil, status.Errorf(codes.Internal, "failed to get attribute value: %v", err)
+	}
+
+	err = s.dbR.WithTx(ctx, func(ctx context.Context) error {
+		current, err := s.dbR.GetValueTranslations(ctx, []int64{in.OptionId})
+		if err != nil {
+			return err
+		}
+
+		err = s.dbR.DeleteValueTranslation(ctx, in.OptionId, locale)
+		if err != nil {
+			return err
+		}
+
+		before, _ := lo.Find(current, func(t model.ValueTranslation) bool { return t.Locale == locale })
+		return s.addAuditRecord(ctx, "DeleteAttributeValueTranslation", model.AuditEntityAttributeValue, in.OptionId, before, nil)
+	})
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "translation of option %d to %s not found", in.OptionId, locale)
+		}
+		logger.Error(fmt.Sprintf("failed to delete value translation: %v", err))
+		return nil, status.Errorf(codes.Aborted, "failed to delete value translation: %v", err)
+	}
+
+	s.treeC.Invalidate(option.AttributeId)
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Service) GetAttributeValueAliases(ctx context.Context, in *optionhub.GetAttributeValueAliasesIn) (*optionhub.GetAttributeValueAliasesOut, error) {
+	logger := logger_lib.FromContext(ctx, config.KeyLogger)
+	logger.AddFuncName("GetAttributeValueAliases")
+
+	_, err := s.dbR.GetAttributeValue(ctx, in.OptionId)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "option %d not found", in.OptionId)
+		}
+		logger.Error(fmt.Sprintf("failed to get attribute value: %v", err))
+		return nil, status.Errorf(codes.Internal, "failed to get attribute value: %v", err)
+	}
+
+	aliases, err := s.dbR.GetValueAliases(ctx, in.OptionId)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to get value aliases: %v", err))
+		return nil, status.Errorf(codes.Internal, "failed to get value aliases: %v", err)
+	}
+
+	return &optionhub.GetAttributeValueAliasesOut{Aliases: aliases.ToDTO()}, nil
+}
+
+// AddAttributeValueAlias добавляет синоним значения. Синоним не может совпадать с другим синонимом или значением
+// того же атрибута: иначе поиск и проверка заявок не смогут выбрать одно значение
+func (s *Service) AddAttributeValueAlias(ctx context.Context, in *optionhub.AddAttributeValueAliasIn) (*optionhub.AddAttributeValueAliasOut, error) {
+	logger := logger_lib.FromContext(ctx, config.KeyLogger)
+	logger.AddFuncName("AddAttributeValueAlias")
+
+	alias := model.CleanValue(in.Alias)
+	if alias == "" {
+		return nil, status.Error(codes.InvalidArgument, "alias is empty")
+	}
+
+	option, err := s.dbR.GetAttributeValue(ctx, in.OptionId)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "option %d not found", in.OptionId)
+		}
+		logger.Error(fmt.Sprintf("failed to get attribute value: %v", err))
+		return nil, status.Errorf(codes.Internal, "failed to get attribute value: %v", err)
+	}
+	if option.DeletedAt != nil {
+		return nil, status.Errorf(codes.NotFound, "option %d not found", in.OptionId)
+	}
+	if model.NormalizeValue(alias) == model.NormalizeValue(option.Value) {
+		return nil, status.Error(codes.InvalidArgument, "alias matches the value itself")
+	}
+
+	added := model.AttributeValueAlias{
+		OptionID:    option.Id,
+		AttributeID: option.AttributeId,
+		Alias:       alias,
+	}
+	err = s.dbR.WithTx(ctx, func(ctx context.Context) error {
+		var err error
+		added.ID, err = s.dbR.AddValueAlias(ctx, added)
+		if err != nil {
+			return err
+		}
+
+		return s.addAuditRecord(ctx, "AddAttributeValueAlias", model.AuditEntityAttributeValue, option.Id, nil, added)
+	})
+	if err != nil {
+		if duplicateErr := duplicateStatus(err); duplicateErr != nil {
+			return nil, duplicateErr
+		}
+		logger.Error(fmt.Sprintf("failed to add value alias: %v", err))
+		return nil, status.Errorf(codes.Aborted, "failed to add value alias: %v", err)
+	}
+
+	return &optionhub.AddAttributeValueAliasOut{AliasId: added.ID}, nil
+}
+
+func (s *Service) DeleteAttributeValueAlias(ctx context.Context, in *optionhub.DeleteAttributeValueAliasIn) (*emptypb.Empty, error) {
+	logger := logger_lib.FromContext(ctx, config.KeyLogger)
+	logger.AddFuncName("DeleteAttributeValueAlias")
+
+	current, err := s.dbR.GetValueAlias(ctx, in.AliasId)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "alias %d not found", in.AliasId)
+		}
+		logger.Error(fmt.Sprintf("failed to get value alias: %v", err))
+		return nil, status.Errorf(codes.Internal, "failed to get value alias: %v", err)
+	}
+
+	err = s.dbR.WithTx(ctx, func(ctx context.Context) error {
+		err := s.dbR.DeleteValueAlias(ctx, in.AliasId)
+		if err != nil {
+			return err
+		}
+
+		return s.addAuditRecord(ctx, "DeleteAttributeValueAlias", model.AuditEntityAttributeValue, current.OptionID, current, nil)
+	})
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "alias %d not found", in.AliasId)
+		}
+		logger.Error(fmt.Sprintf("failed to delete value alias: %v", err))
+		return nil, status.Errorf(codes.Aborted, "failed to delete value alias: %v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Service) GetAuditLog(ctx context.Context, in *optionhub.GetAuditLogIn) (*optionhub.GetAuditLogOut, error) {
+	logger := logger_lib.FromContext(ctx, config.KeyLogger)
+	logger.AddFuncName("GetAuditLog")
+
+	filter, err := model.NewAuditLogFilter(in)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
+	}
+
+	records, err := s.dbR.GetAuditLog(ctx, filter)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to get audit log: %v", err))
+		return nil, status.Errorf(codes.Internal, "failed to get audit log: %v", err)
+	}
+
+	var nextPageToken string
+	if uint64(len(records)) > filter.PageSize {
+		records = records[:filter.PageSize]
+		nextPageToken = model.AuditLogPageToken(records[len(records)-1])
+	}
+
+	return &optionhub.GetAuditLogOut{
+		Records:       records.ToDTO(),
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// ExportCatalog выгружает все атрибуты с деревьями значений в стабильном порядке, чтобы выгрузки можно было сравнивать
+func (s *Service) ExportCatalog(ctx context.Context, in *optionhub.ExportCatalogIn) (*optionhub.ExportCatalogOut, error) {
+	logger := logger_lib.FromContext(ctx, config.KeyLogger)
+	logger.AddFuncName("ExportCatalog")
+
+	var encode func(model.Catalog) ([]byte, error)
+	switch in.Format {
+	case optionhub.ExportFormat_EXPORT_FORMAT_JSON:
+		encode = model.Catalog.JSON
+	case optionhub.ExportFormat_EXPORT_FORMAT_CSV:
+		encode = model.Catalog.CSV
+	case optionhub.ExportFormat_EXPORT_FORMAT_YAML:
+		encode = model.Catalog.YAML
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown export format: %s", in.Format)
+	}
+
+	attributes, err := s.dbR.ListAttributes(ctx)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to list attributes: %v", err))
+		return nil, status.Errorf(codes.Internal, "failed to list attributes: %v", err)
+	}
+
+	values, err := s.dbR.GetAllAttributeValues(ctx, in.IncludeDeleted)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to get attribute values: %v", err))
+		return nil, status.Errorf(codes.Internal, "failed to get attribute values: %v", err)
+	}
+
+	data, err := encode(model.NewCatalog(attributes, values, in.IncludeDeleted))
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to encode catalog: %v", err))
+		return nil, status.Errorf(codes.Internal, "failed to encode catalog: %v", err)
+	}
+
+	return &optionhub.ExportCatalogOut{Data: data}, nil
+}
+
+// localizeValues загружает переводы значений и подставляет их по цепочке локалей
+func (s *Service) localizeValues(ctx context.Context, values model.AttributeValueList, chain model.LocaleChain) error {
+	if len(values) == 0 {
+		return nil
+	}
+
+	translations, err := s.dbR.GetValueTranslations(ctx, values.Ids())
+	if err != nil {
+		return fmt.Errorf("failed to get value translations: %v", err)
+	}
+
+	values.Localize(translations.ByOption(), chain)
+
+	return nil
+}
+
+// valueTranslations загружает переводы значений ids. Без локалей переводы не подставляются, поэтому и не загружаются
+func (s *Service) valueTranslations(ctx context.Context, ids []int64, chain model.LocaleChain) (map[int64]model.Translations, error) {
+	if len(chain) == 0 || len(ids) == 0 {
+		return nil, nil
+	}
+
+	translations, err := s.dbR.GetValueTranslations(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get value translations: %v", err)
+	}
+
+	return translations.ByOption(), nil
+}
+
+// attributeTranslations загружает переводы названий атрибутов ids, если заданы локали
+func (s *Service) attributeTranslations(ctx context.Context, ids []int64, chain model.LocaleChain) (map[int64]model.Translations, error) {
+	if len(chain) == 0 || len(ids) == 0 {
+		return nil, nil
+	}
+
+	translations, err := s.dbR.GetAttributeTranslations(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get attribute translations: %v", err)
+	}
+
+	return translations.ByAttribute(), nil
+}
+
+// addAuditRecord записывает изменение в журнал аудита. Вызывается в той же транзакции, что и само изменение
+func (s *Service) addAuditRecord(ctx context.Context, method string, entity model.AuditEntity, id int64, before, after any) error {
+	record, err := model.NewAuditRecord(method, entity, id, before, after)
+	if err != nil {
+		return err
+	}
+
+	record.ActorUuid, _ = ctx.Value(config.KeyUUID).(string)
+	record.RequestID, _ = ctx.Value(config.KeyRequestID).(string)
+
+	return s.dbR.AddAuditRecord(ctx, record)
+}
+
+// addCatalogEvent записывает событие в outbox. Вызывается в той же транзакции, что и само изменение
+func (s *Service) addCatalogEvent(ctx context.Context, event *optionhub.CatalogEvent) error {
+	event.ActorUuid, _ = ctx.Value(config.KeyUUID).(string)
+	event.OccurredAt = timestamppb.Now()
+
+	message, err := model.NewCatalogEventMessage(event)
+	if err != nil {
+		return err
+	}
+
+	return s.dbR.AddOutboxMessage(ctx, message)
+}
+
+// getOptionRequestForResolution загружает заявку и проверяет, что её можно перевести в статус next
+func (s *Service) getOptionRequestForResolution(ctx context.Context, logger logger_lib.LoggerInterface, id int64, next model.OptionRequestStatus) (model.OptionRequest, error) {
+	request, err := s.dbR.GetOptionRequest(ctx, id)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return model.OptionRequest{}, status.Errorf(codes.NotFound, "option request %d not found", id)
+		}
+		logger.Error(fmt.Sprintf("failed to get option request: %v", err))
+		return model.OptionRequest{}, status.Errorf(codes.Internal, "failed to get option request: %v", err)
+	}
+
+	if !request.Status.CanTransitionTo(next) {
+		return model.OptionRequest{}, status.Errorf(codes.FailedPrecondition, "option request %d is already %s", id, request.Status)
+	}
+
+	return request, nil
+}
+
+func resolutionError(logger logger_lib.LoggerInterface, err error, id int64, msg string) error {
+	if parentErr := parentStatus(err); parentErr != nil {
+		return parentErr
+	}
+	if duplicateErr := duplicateStatus(err); duplicateErr != nil {
+		return duplicateErr
+	}
+
+	switch {
+	case errors.Is(err, model.ErrNotFound):
+		return status.Errorf(codes.NotFound, "option request %d not found", id)
+	case errors.Is(err, model.ErrInvalidStatusTransition):
+		return status.Errorf(codes.FailedPrecondition, "option request %d is not pending", id)
+	default:
+		logger.Error(fmt.Sprintf("%s: %v", msg, err))
+		return status.Errorf(codes.Aborted, "%s: %v", msg, err)
+	}
+}
+
+// getLiveAttributeValue загружает неудалённое значение; удалённое считается отсутствующим
+func (s *Service) getLiveAttributeValue(ctx context.Context, logger logger_lib.LoggerInterface, id int64) (model.AttributeValue, error) {
+	value, err := s.dbR.GetAttributeValue(ctx, id)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return model.AttributeValue{}, status.Errorf(codes.NotFound, "option %d not found", id)
+		}
+		logger.Error(fmt.Sprintf("failed to get attribute value: %v", err))
+		return model.AttributeValue{}, status.Errorf(codes.Internal, "failed to get attribute value: %v", err)
+	}
+
+	if value.DeletedAt != nil {
+		return model.AttributeValue{}, status.Errorf(codes.NotFound, "option %d not found", id)
+	}
+
+	return value, nil
+}
+
+// validateParent проверяет, что родитель существует, относится к тому же атрибуту и не является потомком самого значения
+func (s *Service) validateParent(ctx context.Context, logger logger_lib.LoggerInterface, value model.AttributeValue) error {
+	if value.ParentId == nil {
+		return nil
+	}
+
+	parent, err := s.dbR.GetAttributeValue(ctx, *value.ParentId)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return status.Errorf(codes.InvalidArgument, "parent %d not found", *value.ParentId)
+		}
+		logger.Error(fmt.Sprintf("failed to get parent value: %v", err))
+		return status.Errorf(codes.Internal, "failed to get parent value: %v", err)
+	}
+
+	if parent.DeletedAt != nil {
+		return status.Errorf(codes.InvalidArgument, "parent %d is deleted", parent.Id)
+	}
+
+	if parent.AttributeId != value.AttributeId {
+		return status.Errorf(codes.InvalidArgument, "parent %d belongs to attribute %d, not %d",
+			parent.Id, parent.AttributeId, value.AttributeId)
+	}
+
+	// новое значение ещё не может быть ничьим предком
+	if value.Id == 0 {
+		return nil
+	}
+
+	if parent.Id == value.Id {
+		return status.Errorf(codes.FailedPrecondition, "option %d cannot be its own parent", value.Id)
+	}
+
+	ancestors, err := s.dbR.GetAncestors(ctx, parent.Id)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to get ancestors: %v", err))
+		return status.Errorf(codes.Internal, "failed to get ancestors: %v", err)
+	}
+
+	if lo.ContainsBy(ancestors, func(a model.AttributeValue) bool { return a.Id == value.Id }) {
+		return status.Errorf(codes.FailedPrecondition, "parent %d is a descendant of option %d", parent.Id, value.Id)
+	}
+
+	return nil
+}
+
+// duplicateStatus переводит дубль значения в codes.AlreadyExists; id существующего значения передаётся в деталях
+func duplicateStatus(err error) error {
+	var duplicate *model.DuplicateValueError
+	if errors.As(err, &duplicate) {
+		st, detailsErr := status.New(codes.AlreadyExists, err.Error()).WithDetails(&optionhub.DuplicateAttributeValue{
+			OptionId:    duplicate.Existing.Id,
+			OptionValue: duplicate.Existing.Value,
+		})
+		if detailsErr != nil {
+			return status.Error(codes.AlreadyExists, err.Error())
+		}
+		return st.Err()
+	}
+
+	if errors.Is(err, model.ErrAlreadyExists) {
+		return status.Error(codes.AlreadyExists, err.Error())
+	}
+	return nil
+}
+
+// parentStatus переводит нарушения, найденные базой, в gRPC-ошибку; для прочих ошибок возвращает nil
+func parentStatus(err error) error {
+	switch {
+	case errors.Is(err, model.ErrParentAttributeMismatch):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, model.ErrParentCycle):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return nil
+	}
+}
+
+// sendAttributeSnapshot отправляет дерево значений атрибута, переведённых по chain, и возвращает его версию
+func (s *Service) sendAttributeSnapshot(ctx context.Context, logger logger_lib.LoggerInterface, stream optionhub.OptionhubService_WatchAttributeServer, attributeId int64, chain model.LocaleChain) (int64, error) {
+	var (
+		version int64
+		values  model.AttributeValueList
+	)
+
+	err := s.dbR.WithTx(ctx, func(ctx context.Context) error {
+		var err error
+
+		version, err = s.dbR.LockAttributeVersion(ctx, attributeId)
+		if err != nil {
+			return err
+		}
+
+		values, err = s.dbR.GetValuesByAttributeId(ctx, attributeId, false)
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return 0, status.Errorf(codes.NotFound, "attribute %d not found", attributeId)
+		}
+		logger.Error(fmt.Sprintf("failed to get attribute snapshot: %v", err))
+		return 0, status.Errorf(codes.Internal, "failed to get attribute snapshot: %v", err)
+	}
+
+	err = s.localizeValues(ctx, values, chain)
+	if err != nil {
+		logger.Error(err.Error())
+		return 0, status.Error(codes.Internal, err.Error())
+	}
+
+	err = stream.Send(&optionhub.WatchAttributeOut{
+		Type:     optionhub.WatchEventType_WATCH_EVENT_TYPE_SNAPSHOT,
+		Version:  version,
+		Snapshot: values.FromDTO(false),
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return version, nil
+}
+
+// sendAttributeChanges отправляет изменения после version и возвращает версию последнего отправленного.
+// Если в истории пропуск, вместо изменений отправляется снимок. Значения переводятся по chain на момент отправки
+func (s *Service) sendAttributeChanges(ctx context.Context, logger logger_lib.LoggerInterface, stream optionhub.OptionhubService_WatchAttributeServer, attributeId int64, chain model.LocaleChain, version int64) (int64, error) {
+	for {
+		changes, err := s.dbR.GetAttributeValueChanges(ctx, attributeId, version, watchBatchSize)
+		if err != nil {
+			logger.Error(fmt.Sprintf("failed to get attribute value changes: %v", err))
+			return 0, status.Errorf(codes.Internal, "failed to get attribute value changes: %v", err)
+		}
+
+		translations, err := s.valueTranslations(ctx, lo.Uniq(lo.Map(changes, func(c model.AttributeValueChange, _ int) int64 { return c.OptionID })), chain)
+		if err != nil {
+			logger.Error(err.Error())
+			return 0, status.Error(codes.Internal, err.Error())
+		}
+
+		for _, change := range changes {
+			if change.Version != version+1 {
+				return s.sendAttributeSnapshot(ctx, logger, stream, attributeId, chain)
+			}
+
+			change.Localize(translations[change.OptionID], chain)
+
+			err = stream.Send(change.ToDTO())
+			if err != nil {
+				return 0, err
+			}
+			version = change.Version
+		}
+
+		if len(changes) < watchBatchSize {
+			return version, nil
+		}
+	}
 }
@@ -2,17 +2,20 @@ package service
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"reflect"
+	"strconv"
 	"testing"
 	"time"
 
 	"github.com/golang/mock/gomock"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
-	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	logger_lib "github.com/s21platform/logger-lib"
@@ -23,6 +26,39 @@ import (
 	"github.com/s21platform/optionhub-service/utils"
 )
 
+// runInTx выполняет fn без транзакции, подменяя DBRepo.WithTx в тестах
+func runInTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+// loadThrough выполняет загрузку без кэша, подменяя TreeCache.GetOrLoad в тестах
+func loadThrough(_ int64, _ int64, _ bool, _ string, load func() ([]*optionhub.Option, error)) ([]*optionhub.Option, error) {
+	return load()
+}
+
+// expectAuditRecord ожидает запись в журнал аудита и передаёт её в check
+func expectAuditRecord(t *testing.T, mockRepo *MockDBRepo, check func(record model.AuditRecord)) *gomock.Call {
+	return mockRepo.EXPECT().AddAuditRecord(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, record model.AuditRecord) error {
+		check(record)
+		return nil
+	})
+}
+
+// expectCatalogEvent ожидает запись CatalogEvent в outbox и передаёт декодированное событие в check
+func expectCatalogEvent(t *testing.T, mockRepo *MockDBRepo, check func(event *optionhub.CatalogEvent)) *gomock.Call {
+	return mockRepo.EXPECT().AddOutboxMessage(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, msg model.OutboxMessage) error {
+		assert.Equal(t, model.OutboxTopicCatalogEvents, msg.Topic)
+		assert.Equal(t, "application/json; proto=CatalogEvent", msg.ContentType)
+
+		var event optionhub.CatalogEvent
+		assert.NoError(t, protojson.Unmarshal(msg.Payload, &event))
+		assert.Equal(t, int32(model.CatalogEventVersion), event.Version)
+		assert.Equal(t, strconv.FormatInt(event.AttributeId, 10), msg.Key)
+		check(&event)
+		return nil
+	})
+}
+
 func TestService_GetAttributeValues(t *testing.T) {
 	t.Parallel()
 
@@ -34,7 +70,8 @@ func TestService_GetAttributeValues(t *testing.T) {
 	ctx = context.WithValue(ctx, config.KeyLogger, mockLogger)
 
 	mockRepo := NewMockDBRepo(ctrl)
-	kafkaProducer := NewMockSetAttributeProducer(ctrl)
+	mockCache := NewMockTreeCache(ctrl)
+	mockNotifier := NewMockAttributeNotifier(ctrl)
 
 	t.Run("get_attribute_values_ok", func(t *testing.T) {
 		mockLogger.EXPECT().AddFuncName("GetAttributeValues")
@@ -76,13 +113,134 @@ func TestService_GetAttributeValues(t *testing.T) {
 			Children:    []*optionhub.Option{option2},
 		}
 
-		mockRepo.EXPECT().GetValuesByAttributeId(gomock.Any(), attributeId).Return(expectedDbRes, nil)
+		mockRepo.EXPECT().GetAttribute(gomock.Any(), attributeId).Return(model.Attribute{ID: attributeId, Version: 3}, nil)
+		mockCache.EXPECT().GetOrLoad(attributeId, int64(3), false, "", gomock.Any()).DoAndReturn(loadThrough)
+		mockRepo.EXPECT().GetValuesByAttributeId(gomock.Any(), attributeId, false).Return(expectedDbRes, nil)
+		mockRepo.EXPECT().GetValueTranslations(gomock.Any(), []int64{1, 2, 3}).Return(nil, nil)
 
-		s := NewService(mockRepo, kafkaProducer)
+		s := NewService(mockRepo, mockCache, mockNotifier)
 		result, err := s.GetAttributeValues(ctx, &optionhub.GetAttributeValuesIn{AttributeId: attributeId})
 
 		assert.NoError(t, err)
 		assert.True(t, reflect.DeepEqual(option1, result.OptionList[0]))
+		assert.Equal(t, int64(3), result.Version)
+		assert.False(t, result.NotModified)
+	})
+
+	t.Run("get_attribute_values_include_deleted", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("GetAttributeValues")
+
+		var attributeId int64 = 5
+		deletedAt := time.Now()
+		expectedDbRes := model.AttributeValueList{
+			{Id: 1, Value: "Россия"},
+			{Id: 2, Value: "Moskva", ParentId: utils.TransformToPtr(int64(1)), DeletedAt: &deletedAt},
+		}
+
+		mockRepo.EXPECT().GetAttribute(gomock.Any(), attributeId).Return(model.Attribute{ID: attributeId}, nil)
+		mockCache.EXPECT().GetOrLoad(attributeId, int64(0), true, "", gomock.Any()).DoAndReturn(loadThrough)
+		mockRepo.EXPECT().GetValuesByAttributeId(gomock.Any(), attributeId, true).Return(expectedDbRes, nil)
+		mockRepo.EXPECT().GetValueTranslations(gomock.Any(), []int64{1, 2}).Return(nil, nil)
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		result, err := s.GetAttributeValues(ctx, &optionhub.GetAttributeValuesIn{AttributeId: attributeId, IncludeDeleted: true})
+
+		assert.NoError(t, err)
+		assert.Len(t, result.OptionList[0].Children, 1)
+		assert.True(t, result.OptionList[0].Children[0].IsDeleted)
+	})
+
+	t.Run("get_attribute_values_localized", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("GetAttributeValues")
+
+		var attributeId int64 = 5
+		expectedDbRes := model.AttributeValueList{
+			{Id: 1, Value: "Россия"},
+			{Id: 2, Value: "Москва", ParentId: utils.TransformToPtr(int64(1))},
+		}
+
+		mockRepo.EXPECT().GetAttribute(gomock.Any(), attributeId).Return(model.Attribute{ID: attributeId, Version: 3}, nil)
+		mockCache.EXPECT().GetOrLoad(attributeId, int64(3), false, "en-us,en", gomock.Any()).DoAndReturn(loadThrough)
+		mockRepo.EXPECT().GetValuesByAttributeId(gomock.Any(), attributeId, false).Return(expectedDbRes, nil)
+		mockRepo.EXPECT().GetValueTranslations(gomock.Any(), []int64{1, 2}).Return(model.ValueTranslationList{
+			{OptionID: 1, Locale: "en", Value: "Russia"},
+			{OptionID: 1, Locale: "kk", Value: "Ресей"},
+		}, nil)
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		result, err := s.GetAttributeValues(ctx, &optionhub.GetAttributeValuesIn{AttributeId: attributeId, Locale: "en_US"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Russia", result.OptionList[0].OptionValue)
+		assert.Equal(t, map[string]string{"en": "Russia", "kk": "Ресей"}, result.OptionList[0].Translations)
+		assert.Equal(t, "Москва", result.OptionList[0].Children[0].OptionValue)
+		assert.Empty(t, result.OptionList[0].Children[0].Translations)
+	})
+
+	t.Run("get_attribute_values_invalid_locale", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("GetAttributeValues")
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		_, err := s.GetAttributeValues(ctx, &optionhub.GetAttributeValuesIn{AttributeId: 5, Locale: "english!"})
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("get_attribute_values_cached", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("GetAttributeValues")
+
+		cached := []*optionhub.Option{{OptionId: 1, OptionValue: "Россия"}}
+		mockRepo.EXPECT().GetAttribute(gomock.Any(), int64(5)).Return(model.Attribute{ID: 5, Version: 3}, nil)
+		mockCache.EXPECT().GetOrLoad(int64(5), int64(3), false, "", gomock.Any()).Return(cached, nil)
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		result, err := s.GetAttributeValues(ctx, &optionhub.GetAttributeValuesIn{AttributeId: 5})
+
+		assert.NoError(t, err)
+		assert.Equal(t, cached, result.OptionList)
+	})
+
+	t.Run("get_attribute_values_not_modified", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("GetAttributeValues")
+
+		mockRepo.EXPECT().GetAttribute(gomock.Any(), int64(5)).Return(model.Attribute{ID: 5, Version: 3}, nil)
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		result, err := s.GetAttributeValues(ctx, &optionhub.GetAttributeValuesIn{AttributeId: 5, IfVersion: utils.TransformToPtr(int64(3))})
+
+		assert.NoError(t, err)
+		assert.True(t, result.NotModified)
+		assert.Equal(t, int64(3), result.Version)
+		assert.Empty(t, result.OptionList)
+	})
+
+	t.Run("get_attribute_values_modified", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("GetAttributeValues")
+
+		cached := []*optionhub.Option{{OptionId: 1, OptionValue: "Россия"}}
+		mockRepo.EXPECT().GetAttribute(gomock.Any(), int64(5)).Return(model.Attribute{ID: 5, Version: 4}, nil)
+		mockCache.EXPECT().GetOrLoad(int64(5), int64(4), false, "", gomock.Any()).Return(cached, nil)
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		result, err := s.GetAttributeValues(ctx, &optionhub.GetAttributeValuesIn{AttributeId: 5, IfVersion: utils.TransformToPtr(int64(3))})
+
+		assert.NoError(t, err)
+		assert.False(t, result.NotModified)
+		assert.Equal(t, int64(4), result.Version)
+		assert.Equal(t, cached, result.OptionList)
+	})
+
+	t.Run("get_attribute_values_attribute_not_found", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("GetAttributeValues")
+
+		mockRepo.EXPECT().GetAttribute(gomock.Any(), int64(5)).Return(model.Attribute{}, model.ErrNotFound)
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		_, err := s.GetAttributeValues(ctx, &optionhub.GetAttributeValuesIn{AttributeId: 5})
+
+		st, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.NotFound, st.Code())
 	})
 
 	t.Run("get_attribute_values_error", func(t *testing.T) {
@@ -93,9 +251,11 @@ func TestService_GetAttributeValues(t *testing.T) {
 
 		var attributeId int64 = 5
 
-		mockRepo.EXPECT().GetValuesByAttributeId(gomock.Any(), attributeId).Return(nil, expErr)
+		mockRepo.EXPECT().GetAttribute(gomock.Any(), attributeId).Return(model.Attribute{ID: attributeId}, nil)
+		mockCache.EXPECT().GetOrLoad(attributeId, int64(0), false, "", gomock.Any()).DoAndReturn(loadThrough)
+		mockRepo.EXPECT().GetValuesByAttributeId(gomock.Any(), attributeId, false).Return(nil, expErr)
 
-		s := NewService(mockRepo, kafkaProducer)
+		s := NewService(mockRepo, mockCache, mockNotifier)
 		_, err := s.GetAttributeValues(ctx, &optionhub.GetAttributeValuesIn{AttributeId: attributeId})
 
 		st, ok := status.FromError(err)
@@ -105,6 +265,207 @@ func TestService_GetAttributeValues(t *testing.T) {
 	})
 }
 
+// watchStream собирает отправленные сообщения и отменяет контекст стрима после stopAfter сообщений
+type watchStream struct {
+	optionhub.OptionhubService_WatchAttributeServer
+	ctx       context.Context
+	cancel    context.CancelFunc
+	stopAfter int
+	sent      []*optionhub.WatchAttributeOut
+}
+
+func newWatchStream(ctx context.Context, stopAfter int) *watchStream {
+	ctx, cancel := context.WithCancel(ctx)
+	return &watchStream{ctx: ctx, cancel: cancel, stopAfter: stopAfter}
+}
+
+func (s *watchStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *watchStream) Send(out *optionhub.WatchAttributeOut) error {
+	s.sent = append(s.sent, out)
+	if len(s.sent) >= s.stopAfter {
+		s.cancel()
+	}
+	return nil
+}
+
+func TestService_WatchAttribute(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLogger := logger_lib.NewMockLoggerInterface(ctrl)
+	ctx = context.WithValue(ctx, config.KeyLogger, mockLogger)
+
+	mockRepo := NewMockDBRepo(ctrl)
+	mockCache := NewMockTreeCache(ctrl)
+	mockNotifier := NewMockAttributeNotifier(ctrl)
+
+	var attributeId int64 = 5
+	occurredAt := time.Now()
+
+	expectSubscribe := func() (chan struct{}, *bool) {
+		updates := make(chan struct{}, 1)
+		unsubscribed := false
+		mockNotifier.EXPECT().Subscribe(attributeId).Return((<-chan struct{})(updates), func() { unsubscribed = true })
+		return updates, &unsubscribed
+	}
+
+	t.Run("snapshot_then_changes", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("WatchAttribute")
+
+		mockRepo.EXPECT().GetAttribute(gomock.Any(), attributeId).Return(model.Attribute{ID: attributeId, Version: 2}, nil)
+		updates, unsubscribed := expectSubscribe()
+		updates <- struct{}{}
+
+		mockRepo.EXPECT().WithTx(gomock.Any(), gomock.Any()).DoAndReturn(runInTx)
+		mockRepo.EXPECT().LockAttributeVersion(gomock.Any(), attributeId).Return(int64(2), nil)
+		mockRepo.EXPECT().GetValuesByAttributeId(gomock.Any(), attributeId, false).Return(model.AttributeValueList{{Id: 1, Value: "Россия"}}, nil)
+		mockRepo.EXPECT().GetValueTranslations(gomock.Any(), []int64{1}).Return(nil, nil)
+		mockRepo.EXPECT().GetAttributeValueChanges(gomock.Any(), attributeId, int64(2), uint64(watchBatchSize)).Return([]model.AttributeValueChange{
+			{AttributeID: attributeId, Version: 3, OptionID: 2, Operation: model.AttributeValueOperationAdded, Value: "Москва", ParentId: utils.TransformToPtr(int64(1)), CreatedAt: occurredAt},
+		}, nil)
+
+		stream := newWatchStream(ctx, 2)
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		err := s.WatchAttribute(&optionhub.WatchAttributeIn{AttributeId: attributeId}, stream)
+
+		assert.NoError(t, err)
+		assert.True(t, *unsubscribed)
+		assert.Len(t, stream.sent, 2)
+		assert.Equal(t, optionhub.WatchEventType_WATCH_EVENT_TYPE_SNAPSHOT, stream.sent[0].Type)
+		assert.Equal(t, int64(2), stream.sent[0].Version)
+		assert.Equal(t, "Россия", stream.sent[0].Snapshot[0].OptionValue)
+		assert.Equal(t, optionhub.WatchEventType_WATCH_EVENT_TYPE_VALUE_ADDED, stream.sent[1].Type)
+		assert.Equal(t, int64(3), stream.sent[1].Version)
+		assert.Equal(t, int64(2), stream.sent[1].OptionId)
+		assert.Equal(t, int64(1), *stream.sent[1].ParentId)
+	})
+
+	t.Run("localized", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("WatchAttribute")
+
+		mockRepo.EXPECT().GetAttribute(gomock.Any(), attributeId).Return(model.Attribute{ID: attributeId, Version: 2}, nil)
+		updates, _ := expectSubscribe()
+		updates <- struct{}{}
+
+		mockRepo.EXPECT().WithTx(gomock.Any(), gomock.Any()).DoAndReturn(runInTx)
+		mockRepo.EXPECT().LockAttributeVersion(gomock.Any(), attributeId).Return(int64(2), nil)
+		mockRepo.EXPECT().GetValuesByAttributeId(gomock.Any(), attributeId, false).Return(model.AttributeValueList{{Id: 1, Value: "Россия"}}, nil)
+		mockRepo.EXPECT().GetValueTranslations(gomock.Any(), []int64{1}).Return(model.ValueTranslationList{{OptionID: 1, Locale: "en", Value: "Russia"}}, nil)
+		mockRepo.EXPECT().GetAttributeValueChanges(gomock.Any(), attributeId, int64(2), uint64(watchBatchSize)).Return([]model.AttributeValueChange{
+			{AttributeID: attributeId, Version: 3, OptionID: 2, Operation: model.AttributeValueOperationAdded, Value: "Москва", ParentId: utils.TransformToPtr(int64(1)), CreatedAt: occurredAt},
+		}, nil)
+		mockRepo.EXPECT().GetValueTranslations(gomock.Any(), []int64{2}).Return(model.ValueTranslationList{{OptionID: 2, Locale: "en", Value: "Moscow"}}, nil)
+
+		stream := newWatchStream(ctx, 2)
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		err := s.WatchAttribute(&optionhub.WatchAttributeIn{AttributeId: attributeId, Locale: "en-US"}, stream)
+
+		assert.NoError(t, err)
+		assert.Len(t, stream.sent, 2)
+		assert.Equal(t, "Russia", stream.sent[0].Snapshot[0].OptionValue)
+		assert.Equal(t, "Moscow", stream.sent[1].Value)
+	})
+
+	t.Run("invalid_locale", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("WatchAttribute")
+
+		stream := newWatchStream(ctx, 1)
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		err := s.WatchAttribute(&optionhub.WatchAttributeIn{AttributeId: attributeId, Locale: "not a locale"}, stream)
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("resume_from_version", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("WatchAttribute")
+
+		mockRepo.EXPECT().GetAttribute(gomock.Any(), attributeId).Return(model.Attribute{ID: attributeId, Version: 3}, nil)
+		expectSubscribe()
+
+		mockRepo.EXPECT().GetAttributeValueChanges(gomock.Any(), attributeId, int64(2), uint64(watchBatchSize)).Return([]model.AttributeValueChange{
+			{AttributeID: attributeId, Version: 3, OptionID: 2, Operation: model.AttributeValueOperationDeleted, Value: "Москва", CreatedAt: occurredAt},
+		}, nil)
+
+		stream := newWatchStream(ctx, 1)
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		err := s.WatchAttribute(&optionhub.WatchAttributeIn{AttributeId: attributeId, FromVersion: utils.TransformToPtr(int64(2))}, stream)
+
+		assert.NoError(t, err)
+		assert.Len(t, stream.sent, 1)
+		assert.Equal(t, optionhub.WatchEventType_WATCH_EVENT_TYPE_VALUE_DELETED, stream.sent[0].Type)
+		assert.Equal(t, int64(3), stream.sent[0].Version)
+	})
+
+	t.Run("resume_without_history", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("WatchAttribute")
+
+		mockRepo.EXPECT().GetAttribute(gomock.Any(), attributeId).Return(model.Attribute{ID: attributeId, Version: 3}, nil)
+		expectSubscribe()
+
+		mockRepo.EXPECT().GetAttributeValueChanges(gomock.Any(), attributeId, int64(1), uint64(watchBatchSize)).Return(nil, nil)
+		mockRepo.EXPECT().WithTx(gomock.Any(), gomock.Any()).DoAndReturn(runInTx)
+		mockRepo.EXPECT().LockAttributeVersion(gomock.Any(), attributeId).Return(int64(3), nil)
+		mockRepo.EXPECT().GetValuesByAttributeId(gomock.Any(), attributeId, false).Return(model.AttributeValueList{{Id: 1, Value: "Россия"}}, nil)
+		mockRepo.EXPECT().GetValueTranslations(gomock.Any(), []int64{1}).Return(nil, nil)
+
+		stream := newWatchStream(ctx, 1)
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		err := s.WatchAttribute(&optionhub.WatchAttributeIn{AttributeId: attributeId, FromVersion: utils.TransformToPtr(int64(1))}, stream)
+
+		assert.NoError(t, err)
+		assert.Len(t, stream.sent, 1)
+		assert.Equal(t, optionhub.WatchEventType_WATCH_EVENT_TYPE_SNAPSHOT, stream.sent[0].Type)
+		assert.Equal(t, int64(3), stream.sent[0].Version)
+	})
+
+	t.Run("from_version_ahead", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("WatchAttribute")
+
+		mockRepo.EXPECT().GetAttribute(gomock.Any(), attributeId).Return(model.Attribute{ID: attributeId, Version: 3}, nil)
+
+		stream := newWatchStream(ctx, 1)
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		err := s.WatchAttribute(&optionhub.WatchAttributeIn{AttributeId: attributeId, FromVersion: utils.TransformToPtr(int64(4))}, stream)
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("attribute_not_found", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("WatchAttribute")
+
+		mockRepo.EXPECT().GetAttribute(gomock.Any(), attributeId).Return(model.Attribute{}, model.ErrNotFound)
+
+		stream := newWatchStream(ctx, 1)
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		err := s.WatchAttribute(&optionhub.WatchAttributeIn{AttributeId: attributeId}, stream)
+
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("changes_error", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("WatchAttribute")
+
+		expErr := errors.New("test error")
+		mockLogger.EXPECT().Error(fmt.Sprintf("failed to get attribute value changes: %v", expErr))
+
+		mockRepo.EXPECT().GetAttribute(gomock.Any(), attributeId).Return(model.Attribute{ID: attributeId, Version: 3}, nil)
+		expectSubscribe()
+		mockRepo.EXPECT().GetAttributeValueChanges(gomock.Any(), attributeId, int64(2), uint64(watchBatchSize)).Return(nil, expErr)
+
+		stream := newWatchStream(ctx, 1)
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		err := s.WatchAttribute(&optionhub.WatchAttributeIn{AttributeId: attributeId, FromVersion: utils.TransformToPtr(int64(2))}, stream)
+
+		assert.Equal(t, codes.Internal, status.Code(err))
+	})
+}
+
 func TestService_GetOptionRequests(t *testing.T) {
 	t.Parallel()
 
@@ -114,9 +475,12 @@ func TestService_GetOptionRequests(t *testing.T) {
 
 	mockLogger := logger_lib.NewMockLoggerInterface(ctrl)
 	ctx = context.WithValue(ctx, config.KeyLogger, mockLogger)
+	ctx = context.WithValue(ctx, config.KeyUUID, "moderator-uuid")
+	ctx = context.WithValue(ctx, config.KeyRole, model.RoleModerator)
 
 	mockRepo := NewMockDBRepo(ctrl)
-	kafkaProducer := NewMockSetAttributeProducer(ctrl)
+	mockCache := NewMockTreeCache(ctrl)
+	mockNotifier := NewMockAttributeNotifier(ctrl)
 
 	t.Run("get_ok", func(t *testing.T) {
 		mockLogger.EXPECT().AddFuncName("GetOptionRequests")
@@ -133,11 +497,11 @@ func TestService_GetOptionRequests(t *testing.T) {
 			},
 		}
 
-		mockRepo.EXPECT().GetOptionRequests(gomock.Any()).Return(expectedRequests, nil)
+		mockRepo.EXPECT().GetOptionRequests(gomock.Any(), gomock.Any()).Return(expectedRequests, int64(len(expectedRequests)), nil)
 		mockRepo.EXPECT().GetAttributeValueById(gomock.Any(), []int64{100}).Return([]model.Attribute{{ID: 100, Name: "Linux"}}, nil)
 
-		s := NewService(mockRepo, kafkaProducer)
-		result, err := s.GetOptionRequests(ctx, &emptypb.Empty{})
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		result, err := s.GetOptionRequests(ctx, &optionhub.GetOptionRequestsIn{})
 
 		assert.NoError(t, err)
 		assert.Equal(t, int64(1), result.OptionRequestItem[0].OptionRequestId)
@@ -146,16 +510,98 @@ func TestService_GetOptionRequests(t *testing.T) {
 		assert.Equal(t, "Ubuntu", result.OptionRequestItem[0].OptionRequestValue)
 		assert.Equal(t, "test-uuid", result.OptionRequestItem[0].UserUuid)
 		assert.Equal(t, timestamppb.New(now), result.OptionRequestItem[0].CreatedAt)
+		assert.Empty(t, result.NextPageToken)
+		assert.Equal(t, int64(1), result.TotalCount)
+	})
+
+	t.Run("get_next_page", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("GetOptionRequests")
+
+		now := time.Now()
+		expectedRequests := model.OptionRequestList{
+			{ID: 3, AttributeID: 100, CreatedAt: now},
+			{ID: 2, AttributeID: 100, CreatedAt: now},
+			{ID: 1, AttributeID: 100, CreatedAt: now},
+		}
+
+		mockRepo.EXPECT().GetOptionRequests(gomock.Any(), model.OptionRequestFilter{
+			AttributeID: utils.TransformToPtr(int64(100)),
+			Status:      lo.ToPtr(model.OptionRequestStatusPending),
+			SortBy:      model.OptionRequestSortByCreatedAt,
+			PageSize:    2,
+		}).Return(expectedRequests, int64(10), nil)
+		mockRepo.EXPECT().GetAttributeValueById(gomock.Any(), []int64{100, 100}).Return([]model.Attribute{{ID: 100, Name: "os"}}, nil)
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		result, err := s.GetOptionRequests(ctx, &optionhub.GetOptionRequestsIn{
+			PageSize:    2,
+			AttributeId: utils.TransformToPtr(int64(100)),
+			Status:      optionhub.OptionRequestStatus_OPTION_REQUEST_STATUS_PENDING,
+			SortBy:      optionhub.OptionRequestSortField_OPTION_REQUEST_SORT_FIELD_CREATED_AT,
+		})
+
+		assert.NoError(t, err)
+		assert.Len(t, result.OptionRequestItem, 2)
+		assert.Equal(t, int64(10), result.TotalCount)
+
+		cursor, err := model.DecodeOptionRequestCursor(result.NextPageToken)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), cursor.ID)
+		assert.Equal(t, model.OptionRequestSortByCreatedAt, cursor.SortBy)
+	})
+
+	t.Run("get_own_requests_as_user", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("GetOptionRequests")
+
+		userCtx := context.WithValue(ctx, config.KeyUUID, "user-uuid")
+		userCtx = context.WithValue(userCtx, config.KeyRole, model.RoleUser)
+
+		mockRepo.EXPECT().GetOptionRequests(gomock.Any(), model.OptionRequestFilter{
+			UserUuid: utils.TransformToPtr("user-uuid"),
+			SortBy:   model.OptionRequestSortByID,
+			PageSize: 50,
+		}).Return(model.OptionRequestList{}, int64(0), nil)
+		mockRepo.EXPECT().GetAttributeValueById(gomock.Any(), []int64{}).Return(nil, nil)
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		_, err := s.GetOptionRequests(userCtx, &optionhub.GetOptionRequestsIn{})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("get_other_user_requests_as_user", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("GetOptionRequests")
+
+		userCtx := context.WithValue(ctx, config.KeyUUID, "user-uuid")
+		userCtx = context.WithValue(userCtx, config.KeyRole, model.RoleUser)
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		_, err := s.GetOptionRequests(userCtx, &optionhub.GetOptionRequestsIn{UserUuid: utils.TransformToPtr("other-uuid")})
+
+		st, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.PermissionDenied, st.Code())
+	})
+
+	t.Run("get_invalid_page_token", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("GetOptionRequests")
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		_, err := s.GetOptionRequests(ctx, &optionhub.GetOptionRequestsIn{PageToken: "not a token"})
+
+		st, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
 	})
 
 	t.Run("get_error", func(t *testing.T) {
 		mockLogger.EXPECT().AddFuncName("GetOptionRequests")
 		mockLogger.EXPECT().Error("failed to get option requests: test error")
 
-		mockRepo.EXPECT().GetOptionRequests(gomock.Any()).Return(nil, errors.New("test error"))
+		mockRepo.EXPECT().GetOptionRequests(gomock.Any(), gomock.Any()).Return(nil, int64(0), errors.New("test error"))
 
-		s := NewService(mockRepo, kafkaProducer)
-		_, err := s.GetOptionRequests(ctx, &emptypb.Empty{})
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		_, err := s.GetOptionRequests(ctx, &optionhub.GetOptionRequestsIn{})
 
 		st, ok := status.FromError(err)
 		assert.True(t, ok)
@@ -174,11 +620,11 @@ func TestService_GetOptionRequests(t *testing.T) {
 			},
 		}
 
-		mockRepo.EXPECT().GetOptionRequests(gomock.Any()).Return(expectedRequests, nil)
+		mockRepo.EXPECT().GetOptionRequests(gomock.Any(), gomock.Any()).Return(expectedRequests, int64(len(expectedRequests)), nil)
 		mockRepo.EXPECT().GetAttributeValueById(gomock.Any(), []int64{100}).Return(nil, errors.New("test error"))
 
-		s := NewService(mockRepo, kafkaProducer)
-		_, err := s.GetOptionRequests(ctx, &emptypb.Empty{})
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		_, err := s.GetOptionRequests(ctx, &optionhub.GetOptionRequestsIn{})
 
 		st, ok := status.FromError(err)
 		assert.True(t, ok)
@@ -198,14 +644,39 @@ func TestService_SetAttribute(t *testing.T) {
 	ctx = context.WithValue(ctx, config.KeyLogger, mockLogger)
 
 	mockRepo := NewMockDBRepo(ctrl)
-	mockProducer := NewMockSetAttributeProducer(ctrl)
+	mockCache := NewMockTreeCache(ctrl)
+	mockNotifier := NewMockAttributeNotifier(ctrl)
+
+	enumAttribute := model.Attribute{ID: 1, Name: "os", Type: model.AttributeTypeEnum}
 
 	t.Run("set_ok", func(t *testing.T) {
 		mockLogger.EXPECT().AddFuncName("SetAttributeTopic")
-		mockRepo.EXPECT().AddAttributeValue(ctx, gomock.Any()).Return(nil)
-		mockProducer.EXPECT().ProduceMessage(ctx, gomock.Any(), gomock.Any()).Return(nil)
+		mockRepo.EXPECT().GetAttribute(ctx, int64(1)).Return(enumAttribute, nil)
+		mockRepo.EXPECT().WithTx(ctx, gomock.Any()).DoAndReturn(runInTx)
+		mockRepo.EXPECT().AddAttributeValue(ctx, gomock.Any()).Return(int64(7), nil)
+		mockRepo.EXPECT().AddOutboxMessage(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, msg model.OutboxMessage) error {
+			assert.Equal(t, model.OutboxTopicSetAttribute, msg.Topic)
+			assert.Equal(t, "set_new_attribute", msg.Key)
+			assert.JSONEq(t, `{"attribute_id":1}`, string(msg.Payload))
+			return nil
+		})
+		expectAuditRecord(t, mockRepo, func(record model.AuditRecord) {
+			assert.Equal(t, "AddAttributeValue", record.Method)
+			assert.Equal(t, model.AuditEntityAttributeValue, record.EntityType)
+			assert.Equal(t, int64(7), record.EntityID)
+			assert.Nil(t, record.Before)
+			assert.JSONEq(t, `{"id":7,"attribute_id":1,"value":"Linux","parent_id":null,"deleted_at":null}`, string(record.After))
+		})
+		expectCatalogEvent(t, mockRepo, func(event *optionhub.CatalogEvent) {
+			assert.Equal(t, optionhub.CatalogEventType_CATALOG_EVENT_TYPE_ATTRIBUTE_VALUE_ADDED, event.Type)
+			assert.Equal(t, int64(1), event.AttributeId)
+			assert.Equal(t, int64(7), event.AttributeValueAdded.OptionId)
+			assert.Equal(t, "Linux", event.AttributeValueAdded.Value)
+		})
 
-		s := NewService(mockRepo, mockProducer)
+		mockCache.EXPECT().Invalidate(int64(1))
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
 		_, err := s.AddAttributeValue(ctx, &optionhub.AddAttributeValueIn{AttributeId: 1, Value: "Linux"})
 
 		assert.NoError(t, err)
@@ -215,9 +686,11 @@ func TestService_SetAttribute(t *testing.T) {
 		mockLogger.EXPECT().AddFuncName("SetAttributeTopic")
 		mockLogger.EXPECT().Error("failed to add new attribute: test error")
 
-		mockRepo.EXPECT().AddAttributeValue(ctx, gomock.Any()).Return(errors.New("test error"))
+		mockRepo.EXPECT().GetAttribute(ctx, int64(1)).Return(enumAttribute, nil)
+		mockRepo.EXPECT().WithTx(ctx, gomock.Any()).DoAndReturn(runInTx)
+		mockRepo.EXPECT().AddAttributeValue(ctx, gomock.Any()).Return(int64(0), errors.New("test error"))
 
-		s := NewService(mockRepo, mockProducer)
+		s := NewService(mockRepo, mockCache, mockNotifier)
 		_, err := s.AddAttributeValue(ctx, &optionhub.AddAttributeValueIn{AttributeId: 1, Value: "Linux"})
 
 		st, ok := status.FromError(err)
@@ -225,4 +698,2286 @@ func TestService_SetAttribute(t *testing.T) {
 		assert.Equal(t, codes.Aborted, st.Code())
 		assert.Contains(t, st.Message(), "failed to add new attribute")
 	})
+
+	t.Run("set_outbox_error", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("SetAttributeTopic")
+		mockLogger.EXPECT().Error("failed to add new attribute: outbox error")
+
+		mockRepo.EXPECT().GetAttribute(ctx, int64(1)).Return(enumAttribute, nil)
+		mockRepo.EXPECT().WithTx(ctx, gomock.Any()).DoAndReturn(runInTx)
+		mockRepo.EXPECT().AddAttributeValue(ctx, gomock.Any()).Return(int64(7), nil)
+		mockRepo.EXPECT().AddOutboxMessage(ctx, gomock.Any()).Return(errors.New("outbox error"))
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		_, err := s.AddAttributeValue(ctx, &optionhub.AddAttributeValueIn{AttributeId: 1, Value: "Linux"})
+
+		st, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.Aborted, st.Code())
+	})
+
+	t.Run("set_duplicate", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("SetAttributeTopic")
+
+		mockRepo.EXPECT().GetAttribute(ctx, int64(1)).Return(enumAttribute, nil)
+		mockRepo.EXPECT().WithTx(ctx, gomock.Any()).DoAndReturn(runInTx)
+		mockRepo.EXPECT().AddAttributeValue(ctx, model.AttributeValue{AttributeId: 1, Value: "Linux Mint"}).
+			Return(int64(0), &model.DuplicateValueError{Existing: model.AttributeValue{Id: 3, AttributeId: 1, Value: "linux mint"}})
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		_, err := s.AddAttributeValue(ctx, &optionhub.AddAttributeValueIn{AttributeId: 1, Value: "  Linux \t Mint "})
+
+		st, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.AlreadyExists, st.Code())
+		assert.Len(t, st.Details(), 1)
+		duplicate := st.Details()[0].(*optionhub.DuplicateAttributeValue)
+		assert.Equal(t, int64(3), duplicate.OptionId)
+		assert.Equal(t, "linux mint", duplicate.OptionValue)
+	})
+
+	t.Run("set_attribute_not_found", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("SetAttributeTopic")
+		mockRepo.EXPECT().GetAttribute(ctx, int64(1)).Return(model.Attribute{}, model.ErrNotFound)
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		_, err := s.AddAttributeValue(ctx, &optionhub.AddAttributeValueIn{AttributeId: 1, Value: "Linux"})
+
+		st, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.NotFound, st.Code())
+	})
+
+	t.Run("set_parent_on_enum", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("SetAttributeTopic")
+		mockRepo.EXPECT().GetAttribute(ctx, int64(1)).Return(enumAttribute, nil)
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		_, err := s.AddAttributeValue(ctx, &optionhub.AddAttributeValueIn{AttributeId: 1, Value: "Ubuntu", ParentId: utils.TransformToPtr(int64(2))})
+
+		st, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+	})
+
+	t.Run("set_parent_of_other_attribute", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("SetAttributeTopic")
+		mockRepo.EXPECT().GetAttribute(ctx, int64(1)).Return(model.Attribute{ID: 1, Type: model.AttributeTypeTree}, nil)
+		mockRepo.EXPECT().GetAttributeValue(ctx, int64(2)).Return(model.AttributeValue{Id: 2, AttributeId: 9}, nil)
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		_, err := s.AddAttributeValue(ctx, &optionhub.AddAttributeValueIn{AttributeId: 1, Value: "Ubuntu", ParentId: utils.TransformToPtr(int64(2))})
+
+		st, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+		assert.Contains(t, st.Message(), "belongs to attribute 9")
+	})
+
+	t.Run("set_parent_rejected_by_db", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("SetAttributeTopic")
+		mockRepo.EXPECT().GetAttribute(ctx, int64(1)).Return(model.Attribute{ID: 1, Type: model.AttributeTypeTree}, nil)
+		mockRepo.EXPECT().GetAttributeValue(ctx, int64(2)).Return(model.AttributeValue{Id: 2, AttributeId: 1}, nil)
+		mockRepo.EXPECT().WithTx(ctx, gomock.Any()).DoAndReturn(runInTx)
+		mockRepo.EXPECT().AddAttributeValue(ctx, gomock.Any()).
+			Return(int64(0), fmt.Errorf("parent 2 belongs to another attribute: %w", model.ErrParentAttributeMismatch))
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		_, err := s.AddAttributeValue(ctx, &optionhub.AddAttributeValueIn{AttributeId: 1, Value: "Ubuntu", ParentId: utils.TransformToPtr(int64(2))})
+
+		st, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+	})
+
+	t.Run("set_non_numeric_value", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("SetAttributeTopic")
+		mockRepo.EXPECT().GetAttribute(ctx, int64(1)).Return(model.Attribute{ID: 1, Type: model.AttributeTypeNumberRange}, nil)
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		_, err := s.AddAttributeValue(ctx, &optionhub.AddAttributeValueIn{AttributeId: 1, Value: "много"})
+
+		st, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+	})
+}
+
+func TestService_CreateAttribute(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLogger := logger_lib.NewMockLoggerInterface(ctrl)
+	ctx = context.WithValue(ctx, config.KeyLogger, mockLogger)
+
+	mockRepo := NewMockDBRepo(ctrl)
+	mockCache := NewMockTreeCache(ctrl)
+	mockNotifier := NewMockAttributeNotifier(ctrl)
+
+	t.Run("create_ok", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("CreateAttribute")
+		mockRepo.EXPECT().WithTx(gomock.Any(), gomock.Any()).DoAndReturn(runInTx)
+		mockRepo.EXPECT().CreateAttribute(gomock.Any(), model.Attribute{Name: "os", Type: model.AttributeTypeEnum}).Return(int64(7), nil)
+		expectAuditRecord(t, mockRepo, func(record model.AuditRecord) {
+			assert.Equal(t, "CreateAttribute", record.Method)
+			assert.Equal(t, model.AuditEntityAttribute, record.EntityType)
+			assert.Equal(t, int64(7), record.EntityID)
+		})
+		expectCatalogEvent(t, mockRepo, func(event *optionhub.CatalogEvent) {
+			assert.Equal(t, optionhub.CatalogEventType_CATALOG_EVENT_TYPE_ATTRIBUTE_CREATED, event.Type)
+			assert.Equal(t, int64(7), event.AttributeId)
+			assert.Equal(t, "os", event.AttributeCreated.Name)
+			assert.Equal(t, optionhub.AttributeType_ATTRIBUTE_TYPE_ENUM, event.AttributeCreated.Type)
+		})
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		result, err := s.CreateAttribute(ctx, &optionhub.CreateAttributeIn{Name: " os ", Type: optionhub.AttributeType_ATTRIBUTE_TYPE_ENUM})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(7), result.AttributeId)
+	})
+
+	t.Run("create_empty_name", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("CreateAttribute")
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		_, err := s.CreateAttribute(ctx, &optionhub.CreateAttributeIn{Name: "  ", Type: optionhub.AttributeType_ATTRIBUTE_TYPE_ENUM})
+
+		st, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+	})
+
+	t.Run("create_unspecified_type", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("CreateAttribute")
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		_, err := s.CreateAttribute(ctx, &optionhub.CreateAttributeIn{Name: "os"})
+
+		st, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+	})
+
+	t.Run("create_error", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("CreateAttribute")
+		mockLogger.EXPECT().Error("failed to create attribute: test error")
+		mockRepo.EXPECT().WithTx(gomock.Any(), gomock.Any()).DoAndReturn(runInTx)
+		mockRepo.EXPECT().CreateAttribute(gomock.Any(), gomock.Any()).Return(int64(0), errors.New("test error"))
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		_, err := s.CreateAttribute(ctx, &optionhub.CreateAttributeIn{Name: "os", Type: optionhub.AttributeType_ATTRIBUTE_TYPE_ENUM})
+
+		st, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.Internal, st.Code())
+		assert.Contains(t, st.Message(), "failed to create attribute")
+	})
+}
+
+func TestService_GetAttribute(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLogger := logger_lib.NewMockLoggerInterface(ctrl)
+	ctx = context.WithValue(ctx, config.KeyLogger, mockLogger)
+
+	mockRepo := NewMockDBRepo(ctrl)
+	mockCache := NewMockTreeCache(ctrl)
+	mockNotifier := NewMockAttributeNotifier(ctrl)
+
+	t.Run("get_ok", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("GetAttribute")
+
+		now := time.Now()
+		mockRepo.EXPECT().GetAttribute(gomock.Any(), int64(3)).Return(model.Attribute{ID: 3, Name: "city", Type: model.AttributeTypeTree, CreatedAt: now}, nil)
+		mockRepo.EXPECT().GetAttributeTranslations(gomock.Any(), []int64{3}).Return(model.AttributeTranslationList{
+			{AttributeID: 3, Locale: "ru", Name: "город"},
+		}, nil)
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		result, err := s.GetAttribute(ctx, &optionhub.GetAttributeIn{AttributeId: 3, Locale: "ru-RU"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(3), result.AttributeId)
+		assert.Equal(t, "город", result.Name)
+		assert.Equal(t, map[string]string{"ru": "город"}, result.Translations)
+		assert.Equal(t, optionhub.AttributeType_ATTRIBUTE_TYPE_TREE, result.Type)
+		assert.Equal(t, timestamppb.New(now), result.CreatedAt)
+	})
+
+	t.Run("get_not_found", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("GetAttribute")
+		mockRepo.EXPECT().GetAttribute(gomock.Any(), int64(3)).Return(model.Attribute{}, model.ErrNotFound)
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		_, err := s.GetAttribute(ctx, &optionhub.GetAttributeIn{AttributeId: 3})
+
+		st, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.NotFound, st.Code())
+	})
+}
+
+func TestService_ListAttributes(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLogger := logger_lib.NewMockLoggerInterface(ctrl)
+	ctx = context.WithValue(ctx, config.KeyLogger, mockLogger)
+
+	mockRepo := NewMockDBRepo(ctrl)
+	mockCache := NewMockTreeCache(ctrl)
+	mockNotifier := NewMockAttributeNotifier(ctrl)
+
+	t.Run("list_ok", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("ListAttributes")
+		mockRepo.EXPECT().ListAttributes(gomock.Any()).Return(model.AttributeList{{ID: 1, Name: "os"}, {ID: 2, Name: "city"}}, nil)
+		mockRepo.EXPECT().GetAttributeTranslations(gomock.Any(), []int64{1, 2}).Return(model.AttributeTranslationList{
+			{AttributeID: 2, Locale: "ru", Name: "город"},
+		}, nil)
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		result, err := s.ListAttributes(ctx, &optionhub.ListAttributesIn{})
+
+		assert.NoError(t, err)
+		assert.Len(t, result.Attributes, 2)
+		assert.Equal(t, "city", result.Attributes[1].Name)
+		assert.Equal(t, map[string]string{"ru": "город"}, result.Attributes[1].Translations)
+	})
+
+	t.Run("list_localized", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("ListAttributes")
+		mockRepo.EXPECT().ListAttributes(gomock.Any()).Return(model.AttributeList{{ID: 1, Name: "os"}, {ID: 2, Name: "city"}}, nil)
+		mockRepo.EXPECT().GetAttributeTranslations(gomock.Any(), []int64{1, 2}).Return(model.AttributeTranslationList{
+			{AttributeID: 2, Locale: "ru", Name: "город"},
+		}, nil)
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		result, err := s.ListAttributes(ctx, &optionhub.ListAttributesIn{Locale: "ru-RU"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "os", result.Attributes[0].Name)
+		assert.Equal(t, "город", result.Attributes[1].Name)
+	})
+
+	t.Run("list_invalid_locale", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("ListAttributes")
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		_, err := s.ListAttributes(ctx, &optionhub.ListAttributesIn{Locale: "русский"})
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("list_error", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("ListAttributes")
+		mockLogger.EXPECT().Error("failed to list attributes: test error")
+		mockRepo.EXPECT().ListAttributes(gomock.Any()).Return(nil, errors.New("test error"))
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		_, err := s.ListAttributes(ctx, &optionhub.ListAttributesIn{})
+
+		st, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.Internal, st.Code())
+	})
+}
+
+func TestService_UpdateAttribute(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLogger := logger_lib.NewMockLoggerInterface(ctrl)
+	ctx = context.WithValue(ctx, config.KeyLogger, mockLogger)
+
+	mockRepo := NewMockDBRepo(ctrl)
+	mockCache := NewMockTreeCache(ctrl)
+	mockNotifier := NewMockAttributeNotifier(ctrl)
+
+	t.Run("update_ok", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("UpdateAttribute")
+		mockRepo.EXPECT().GetAttribute(gomock.Any(), int64(1)).Return(model.Attribute{ID: 1, Name: "hobbies"}, nil)
+		mockRepo.EXPECT().WithTx(gomock.Any(), gomock.Any()).DoAndReturn(runInTx)
+		mockRepo.EXPECT().UpdateAttribute(gomock.Any(), int64(1), "hobby").Return(nil)
+		expectAuditRecord(t, mockRepo, func(record model.AuditRecord) {
+			assert.Equal(t, "UpdateAttribute", record.Method)
+			assert.Contains(t, string(record.Before), `"name":"hobbies"`)
+			assert.Contains(t, string(record.After), `"name":"hobby"`)
+		})
+		expectCatalogEvent(t, mockRepo, func(event *optionhub.CatalogEvent) {
+			assert.Equal(t, optionhub.CatalogEventType_CATALOG_EVENT_TYPE_ATTRIBUTE_UPDATED, event.Type)
+			assert.Equal(t, int64(1), event.AttributeId)
+			assert.Equal(t, "hobbies", event.AttributeUpdated.OldName)
+			assert.Equal(t, "hobby", event.AttributeUpdated.NewName)
+		})
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		_, err := s.UpdateAttribute(ctx, &optionhub.UpdateAttributeIn{AttributeId: 1, Name: "hobby"})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("update_not_found", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("UpdateAttribute")
+		mockRepo.EXPECT().GetAttribute(gomock.Any(), int64(1)).Return(model.Attribute{}, model.ErrNotFound)
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		_, err := s.UpdateAttribute(ctx, &optionhub.UpdateAttributeIn{AttributeId: 1, Name: "hobby"})
+
+		st, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.NotFound, st.Code())
+	})
+}
+
+func TestService_DeleteAttribute(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLogger := logger_lib.NewMockLoggerInterface(ctrl)
+	ctx = context.WithValue(ctx, config.KeyLogger, mockLogger)
+
+	mockRepo := NewMockDBRepo(ctrl)
+	mockCache := NewMockTreeCache(ctrl)
+	mockNotifier := NewMockAttributeNotifier(ctrl)
+
+	t.Run("delete_ok", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("DeleteAttribute")
+		mockRepo.EXPECT().GetAttribute(gomock.Any(), int64(1)).Return(model.Attribute{ID: 1, Name: "os", Type: model.AttributeTypeTree}, nil)
+		mockRepo.EXPECT().WithTx(gomock.Any(), gomock.Any()).DoAndReturn(runInTx)
+		mockRepo.EXPECT().DeleteAttribute(gomock.Any(), int64(1)).Return(nil)
+		expectAuditRecord(t, mockRepo, func(record model.AuditRecord) {
+			assert.Equal(t, "DeleteAttribute", record.Method)
+			assert.NotNil(t, record.Before)
+			assert.Nil(t, record.After)
+		})
+		expectCatalogEvent(t, mockRepo, func(event *optionhub.CatalogEvent) {
+			assert.Equal(t, optionhub.CatalogEventType_CATALOG_EVENT_TYPE_ATTRIBUTE_DELETED, event.Type)
+			assert.Equal(t, int64(1), event.AttributeId)
+			assert.Equal(t, "os", event.AttributeDeleted.Name)
+			assert.Equal(t, optionhub.AttributeType_ATTRIBUTE_TYPE_TREE, event.AttributeDeleted.Type)
+			assert.Empty(t, event.AttributeDeleted.OptionIds)
+		})
+
+		mockCache.EXPECT().Invalidate(int64(1))
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		_, err := s.DeleteAttribute(ctx, &optionhub.DeleteAttributeIn{AttributeId: 1})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("delete_error", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("DeleteAttribute")
+		mockLogger.EXPECT().Error("failed to delete attribute: test error")
+		mockRepo.EXPECT().GetAttribute(gomock.Any(), int64(1)).Return(model.Attribute{ID: 1, Name: "os"}, nil)
+		mockRepo.EXPECT().WithTx(gomock.Any(), gomock.Any()).DoAndReturn(runInTx)
+		mockRepo.EXPECT().DeleteAttribute(gomock.Any(), int64(1)).Return(errors.New("test error"))
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		_, err := s.DeleteAttribute(ctx, &optionhub.DeleteAttributeIn{AttributeId: 1})
+
+		st, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.Internal, st.Code())
+	})
+
+	t.Run("delete_has_values", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("DeleteAttribute")
+		mockRepo.EXPECT().GetAttribute(gomock.Any(), int64(1)).Return(model.Attribute{ID: 1, Name: "os"}, nil)
+		mockRepo.EXPECT().WithTx(gomock.Any(), gomock.Any()).DoAndReturn(runInTx)
+		mockRepo.EXPECT().DeleteAttribute(gomock.Any(), int64(1)).Return(model.ErrHasValues)
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		_, err := s.DeleteAttribute(ctx, &optionhub.DeleteAttributeIn{AttributeId: 1})
+
+		st, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.FailedPrecondition, st.Code())
+	})
+}
+
+func TestService_ApproveOptionRequest(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLogger := logger_lib.NewMockLoggerInterface(ctrl)
+	ctx = context.WithValue(ctx, config.KeyLogger, mockLogger)
+	ctx = context.WithValue(ctx, config.KeyUUID, "moderator-uuid")
+
+	mockRepo := NewMockDBRepo(ctrl)
+	mockCache := NewMockTreeCache(ctrl)
+	mockNotifier := NewMockAttributeNotifier(ctrl)
+
+	pendingRequest := model.OptionRequest{ID: 1, AttributeID: 100, Value: "Ubuntu", Status: model.OptionRequestStatusPending}
+
+	t.Run("approve_ok", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("ApproveOptionRequest")
+		mockRepo.EXPECT().GetOptionRequest(gomock.Any(), int64(1)).Return(pendingRequest, nil)
+		mockRepo.EXPECT().GetAttribute(gomock.Any(), int64(100)).Return(model.Attribute{ID: 100, Type: model.AttributeTypeEnum}, nil)
+		mockRepo.EXPECT().WithTx(gomock.Any(), gomock.Any()).DoAndReturn(runInTx)
+		mockRepo.EXPECT().ApproveOptionRequest(gomock.Any(), model.OptionRequestResolution{
+			RequestID:     1,
+			Status:        model.OptionRequestStatusApproved,
+			ModeratorUuid: "moderator-uuid",
+		}, model.AttributeValue{AttributeId: 100, Value: "Ubuntu"}).Return(int64(42), nil)
+		mockRepo.EXPECT().AddOutboxMessage(gomock.Any(), gomock.Any()).Return(nil)
+		expectAuditRecord(t, mockRepo, func(record model.AuditRecord) {
+			assert.Equal(t, model.AuditEntityOptionRequest, record.EntityType)
+			assert.Contains(t, string(record.Before), `"status":"pending"`)
+			assert.Contains(t, string(record.After), `"status":"approved"`)
+		})
+		expectAuditRecord(t, mockRepo, func(record model.AuditRecord) {
+			assert.Equal(t, model.AuditEntityAttributeValue, record.EntityType)
+			assert.Equal(t, int64(42), record.EntityID)
+		})
+		expectCatalogEvent(t, mockRepo, func(event *optionhub.CatalogEvent) {
+			assert.Equal(t, optionhub.CatalogEventType_CATALOG_EVENT_TYPE_ATTRIBUTE_VALUE_ADDED, event.Type)
+			assert.Equal(t, int64(42), event.AttributeValueAdded.OptionId)
+		})
+		expectCatalogEvent(t, mockRepo, func(event *optionhub.CatalogEvent) {
+			assert.Equal(t, optionhub.CatalogEventType_CATALOG_EVENT_TYPE_OPTION_REQUEST_APPROVED, event.Type)
+			assert.Equal(t, int64(100), event.AttributeId)
+			assert.Equal(t, "moderator-uuid", event.ActorUuid)
+			assert.Equal(t, int64(1), event.OptionRequestApproved.OptionRequestId)
+			assert.Equal(t, int64(42), event.OptionRequestApproved.OptionId)
+		})
+
+		mockCache.EXPECT().Invalidate(int64(100))
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		result, err := s.ApproveOptionRequest(ctx, &optionhub.ApproveOptionRequestIn{OptionRequestId: 1})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(42), result.OptionId)
+	})
+
+	t.Run("approve_already_resolved", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("ApproveOptionRequest")
+		mockRepo.EXPECT().GetOptionRequest(gomock.Any(), int64(1)).
+			Return(model.OptionRequest{ID: 1, Status: model.OptionRequestStatusRejected}, nil)
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		_, err := s.ApproveOptionRequest(ctx, &optionhub.ApproveOptionRequestIn{OptionRequestId: 1})
+
+		st, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.FailedPrecondition, st.Code())
+	})
+
+	t.Run("approve_concurrently_resolved", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("ApproveOptionRequest")
+		mockRepo.EXPECT().GetOptionRequest(gomock.Any(), int64(1)).Return(pendingRequest, nil)
+		mockRepo.EXPECT().GetAttribute(gomock.Any(), int64(100)).Return(model.Attribute{ID: 100, Type: model.AttributeTypeEnum}, nil)
+		mockRepo.EXPECT().WithTx(gomock.Any(), gomock.Any()).DoAndReturn(runInTx)
+		mockRepo.EXPECT().ApproveOptionRequest(gomock.Any(), gomock.Any(), gomock.Any()).Return(int64(0), model.ErrInvalidStatusTransition)
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		_, err := s.ApproveOptionRequest(ctx, &optionhub.ApproveOptionRequestIn{OptionRequestId: 1})
+
+		st, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.FailedPrecondition, st.Code())
+	})
+}
+
+func TestService_RejectOptionRequest(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLogger := logger_lib.NewMockLoggerInterface(ctrl)
+	ctx = context.WithValue(ctx, config.KeyLogger, mockLogger)
+	ctx = context.WithValue(ctx, config.KeyUUID, "moderator-uuid")
+
+	mockRepo := NewMockDBRepo(ctrl)
+	mockCache := NewMockTreeCache(ctrl)
+	mockNotifier := NewMockAttributeNotifier(ctrl)
+
+	pendingRequest := model.OptionRequest{ID: 1, AttributeID: 100, Value: "спам", UserUuid: "user-uuid", Status: model.OptionRequestStatusPending}
+
+	t.Run("reject_ok", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("RejectOptionRequest")
+		mockRepo.EXPECT().GetOptionRequest(gomock.Any(), int64(1)).Return(pendingRequest, nil)
+		mockRepo.EXPECT().WithTx(gomock.Any(), gomock.Any()).DoAndReturn(runInTx)
+		mockRepo.EXPECT().ResolveOptionRequest(gomock.Any(), model.OptionRequestResolution{
+			RequestID:     1,
+			Status:        model.OptionRequestStatusRejected,
+			ModeratorUuid: "moderator-uuid",
+			Reason:        utils.TransformToPtr("spam"),
+		}).Return(nil)
+		expectAuditRecord(t, mockRepo, func(record model.AuditRecord) {
+			assert.Equal(t, "RejectOptionRequest", record.Method)
+			assert.Equal(t, "moderator-uuid", record.ActorUuid)
+			assert.Contains(t, string(record.After), `"status":"rejected"`)
+		})
+		expectCatalogEvent(t, mockRepo, func(event *optionhub.CatalogEvent) {
+			assert.Equal(t, optionhub.CatalogEventType_CATALOG_EVENT_TYPE_OPTION_REQUEST_REJECTED, event.Type)
+			assert.Equal(t, int64(100), event.AttributeId)
+			assert.Equal(t, "spam", event.OptionRequestRejected.Reason)
+			assert.Equal(t, "user-uuid", event.OptionRequestRejected.UserUuid)
+		})
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		_, err := s.RejectOptionRequest(ctx, &optionhub.RejectOptionRequestIn{OptionRequestId: 1, Reason: "spam"})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("reject_empty_reason", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("RejectOptionRequest")
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		_, err := s.RejectOptionRequest(ctx, &optionhub.RejectOptionRequestIn{OptionRequestId: 1})
+
+		st, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+	})
+
+	t.Run("reject_concurrently_resolved", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("RejectOptionRequest")
+		mockRepo.EXPECT().GetOptionRequest(gomock.Any(), int64(1)).Return(pendingRequest, nil)
+		mockRepo.EXPECT().WithTx(gomock.Any(), gomock.Any()).DoAndReturn(runInTx)
+		mockRepo.EXPECT().ResolveOptionRequest(gomock.Any(), gomock.Any()).Return(model.ErrInvalidStatusTransition)
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		_, err := s.RejectOptionRequest(ctx, &optionhub.RejectOptionRequestIn{OptionRequestId: 1, Reason: "spam"})
+
+		st, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.FailedPrecondition, st.Code())
+	})
+
+	t.Run("reject_not_found", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("RejectOptionRequest")
+		mockRepo.EXPECT().GetOptionRequest(gomock.Any(), int64(1)).Return(model.OptionRequest{}, model.ErrNotFound)
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		_, err := s.RejectOptionRequest(ctx, &optionhub.RejectOptionRequestIn{OptionRequestId: 1, Reason: "spam"})
+
+		st, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.NotFound, st.Code())
+	})
+}
+
+func TestService_MergeOptionRequest(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLogger := logger_lib.NewMockLoggerInterface(ctrl)
+	ctx = context.WithValue(ctx, config.KeyLogger, mockLogger)
+	ctx = context.WithValue(ctx, config.KeyUUID, "moderator-uuid")
+
+	mockRepo := NewMockDBRepo(ctrl)
+	mockCache := NewMockTreeCache(ctrl)
+	mockNotifier := NewMockAttributeNotifier(ctrl)
+
+	pendingRequest := model.OptionRequest{ID: 1, AttributeID: 100, Value: "убунту", Status: model.OptionRequestStatusPending}
+
+	t.Run("merge_ok", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("MergeOptionRequest")
+		mockRepo.EXPECT().GetOptionRequest(gomock.Any(), int64(1)).Return(pendingRequest, nil)
+		mockRepo.EXPECT().GetAttributeValue(gomock.Any(), int64(42)).Return(model.AttributeValue{Id: 42, AttributeId: 100, Value: "Ubuntu"}, nil)
+		mockRepo.EXPECT().WithTx(gomock.Any(), gomock.Any()).DoAndReturn(runInTx)
+		mockRepo.EXPECT().ResolveOptionRequest(gomock.Any(), model.OptionRequestResolution{
+			RequestID:     1,
+			Status:        model.OptionRequestStatusMerged,
+			ModeratorUuid: "moderator-uuid",
+			OptionID:      utils.TransformToPtr(int64(42)),
+		}).Return(nil)
+		expectAuditRecord(t, mockRepo, func(record model.AuditRecord) {
+			assert.Equal(t, "MergeOptionRequest", record.Method)
+		})
+		expectCatalogEvent(t, mockRepo, func(event *optionhub.CatalogEvent) {
+			assert.Equal(t, optionhub.CatalogEventType_CATALOG_EVENT_TYPE_OPTION_REQUEST_MERGED, event.Type)
+			assert.Equal(t, int64(42), event.OptionRequestMerged.OptionId)
+		})
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		_, err := s.MergeOptionRequest(ctx, &optionhub.MergeOptionRequestIn{OptionRequestId: 1, OptionId: 42})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("merge_other_attribute", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("MergeOptionRequest")
+		mockRepo.EXPECT().GetOptionRequest(gomock.Any(), int64(1)).Return(pendingRequest, nil)
+		mockRepo.EXPECT().GetAttributeValue(gomock.Any(), int64(42)).Return(model.AttributeValue{Id: 42, AttributeId: 200}, nil)
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		_, err := s.MergeOptionRequest(ctx, &optionhub.MergeOptionRequestIn{OptionRequestId: 1, OptionId: 42})
+
+		st, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+	})
+}
+
+func TestService_CreateOptionRequest(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLogger := logger_lib.NewMockLoggerInterface(ctrl)
+	ctx = context.WithValue(ctx, config.KeyLogger, mockLogger)
+	userCtx := context.WithValue(ctx, config.KeyUUID, "user-uuid")
+
+	mockRepo := NewMockDBRepo(ctrl)
+	mockCache := NewMockTreeCache(ctrl)
+	mockNotifier := NewMockAttributeNotifier(ctrl)
+
+	treeAttribute := model.Attribute{ID: 5, Name: "city", Type: model.AttributeTypeTree}
+
+	t.Run("create_ok", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("CreateOptionRequest")
+		mockRepo.EXPECT().GetAttribute(gomock.Any(), int64(5)).Return(treeAttribute, nil)
+		mockRepo.EXPECT().GetAttributeValue(gomock.Any(), int64(2)).Return(model.AttributeValue{Id: 2, AttributeId: 5, Value: "Москва"}, nil)
+		mockRepo.EXPECT().WithTx(gomock.Any(), gomock.Any()).DoAndReturn(runInTx)
+		mockRepo.EXPECT().CreateOptionRequest(gomock.Any(), model.OptionRequest{
+			AttributeID: 5,
+			Value:       "Курьяново",
+			ParentId:    utils.TransformToPtr(int64(2)),
+			UserUuid:    "user-uuid",
+		}).Return(int64(11), nil)
+		expectAuditRecord(t, mockRepo, func(record model.AuditRecord) {
+			assert.Equal(t, "CreateOptionRequest", record.Method)
+			assert.Equal(t, "user-uuid", record.ActorUuid)
+			assert.Equal(t, int64(11), record.EntityID)
+		})
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		result, err := s.CreateOptionRequest(userCtx, &optionhub.CreateOptionRequestIn{
+			AttributeId: 5,
+			Value:       " Курьяново ",
+			ParentId:    utils.TransformToPtr(int64(2)),
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(11), result.OptionRequestId)
+	})
+
+	t.Run("create_no_uuid", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("CreateOptionRequest")
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		_, err := s.CreateOptionRequest(ctx, &optionhub.CreateOptionRequestIn{AttributeId: 5, Value: "Москва"})
+
+		st, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.Unauthenticated, st.Code())
+	})
+
+	t.Run("create_duplicate", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("CreateOptionRequest")
+		mockRepo.EXPECT().GetAttribute(gomock.Any(), int64(5)).Return(treeAttribute, nil)
+		mockRepo.EXPECT().WithTx(gomock.Any(), gomock.Any()).DoAndReturn(runInTx)
+		mockRepo.EXPECT().CreateOptionRequest(gomock.Any(), gomock.Any()).
+			Return(int64(0), fmt.Errorf("value %q is already requested: %w", "Москва", model.ErrAlreadyExists))
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		_, err := s.CreateOptionRequest(userCtx, &optionhub.CreateOptionRequestIn{AttributeId: 5, Value: "Москва"})
+
+		st, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.AlreadyExists, st.Code())
+		assert.Contains(t, st.Message(), "already requested")
+	})
+}
+
+func TestService_UpdateAttributeValue(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLogger := logger_lib.NewMockLoggerInterface(ctrl)
+	ctx = context.WithValue(ctx, config.KeyLogger, mockLogger)
+
+	mockRepo := NewMockDBRepo(ctrl)
+	mockCache := NewMockTreeCache(ctrl)
+	mockNotifier := NewMockAttributeNotifier(ctrl)
+
+	treeAttribute := model.Attribute{ID: 5, Type: model.AttributeTypeTree}
+	current := model.AttributeValue{Id: 2, AttributeId: 5, Value: "Moskva", ParentId: utils.TransformToPtr(int64(1))}
+
+	t.Run("update_ok", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("UpdateAttributeValue")
+		mockRepo.EXPECT().GetAttributeValue(gomock.Any(), int64(2)).Return(current, nil)
+		mockRepo.EXPECT().GetAttribute(gomock.Any(), int64(5)).Return(treeAttribute, nil)
+		mockRepo.EXPECT().WithTx(gomock.Any(), gomock.Any()).DoAndReturn(runInTx)
+		mockRepo.EXPECT().GetDuplicateValue(gomock.Any(), model.AttributeValue{Id: 2, AttributeId: 5, Value: "Москва", ParentId: utils.TransformToPtr(int64(1))}).Return(model.AttributeValue{}, model.ErrNotFound)
+		mockRepo.EXPECT().UpdateAttributeValue(gomock.Any(), model.AttributeValueUpdate{ID: 2, Value: utils.TransformToPtr("Москва")}).Return(nil)
+		expectAuditRecord(t, mockRepo, func(record model.AuditRecord) {
+			assert.Equal(t, "UpdateAttributeValue", record.Method)
+			assert.Contains(t, string(record.Before), `"value":"Moskva"`)
+			assert.Contains(t, string(record.After), `"value":"Москва"`)
+		})
+		expectCatalogEvent(t, mockRepo, func(event *optionhub.CatalogEvent) {
+			assert.Equal(t, optionhub.CatalogEventType_CATALOG_EVENT_TYPE_ATTRIBUTE_VALUE_UPDATED, event.Type)
+			assert.Equal(t, int64(5), event.AttributeId)
+			assert.Equal(t, "Moskva", event.AttributeValueUpdated.OldValue)
+			assert.Equal(t, "Москва", event.AttributeValueUpdated.NewValue)
+			assert.Equal(t, int64(1), event.AttributeValueUpdated.GetNewParentId())
+		})
+
+		mockCache.EXPECT().Invalidate(int64(5))
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		_, err := s.UpdateAttributeValue(ctx, &optionhub.UpdateAttributeValueIn{OptionId: 2, Value: utils.TransformToPtr(" Москва ")})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("update_to_own_alias", func(t *testing.T) {
+		spb := model.AttributeValue{Id: 7, AttributeId: 5, Value: "Санкт-Петербург", ParentId: utils.TransformToPtr(int64(1))}
+
+		mockLogger.EXPECT().AddFuncName("UpdateAttributeValue")
+		mockRepo.EXPECT().GetAttributeValue(gomock.Any(), int64(7)).Return(spb, nil)
+		mockRepo.EXPECT().GetAttribute(gomock.Any(), int64(5)).Return(treeAttribute, nil)
+		mockRepo.EXPECT().WithTx(gomock.Any(), gomock.Any()).DoAndReturn(runInTx)
+		mockRepo.EXPECT().GetDuplicateValue(gomock.Any(), gomock.Any()).Return(model.AttributeValue{}, model.ErrNotFound)
+		// синоним "СПб" принадлежит самому значению, репозиторий его не считает конфликтом
+		mockRepo.EXPECT().UpdateAttributeValue(gomock.Any(), model.AttributeValueUpdate{ID: 7, Value: utils.TransformToPtr("СПб")}).Return(nil)
+		expectAuditRecord(t, mockRepo, func(record model.AuditRecord) {
+			assert.Contains(t, string(record.After), `"value":"СПб"`)
+		})
+		expectCatalogEvent(t, mockRepo, func(event *optionhub.CatalogEvent) {
+			assert.Equal(t, "Санкт-Петербург", event.AttributeValueUpdated.OldValue)
+			assert.Equal(t, "СПб", event.AttributeValueUpdated.NewValue)
+		})
+		mockCache.EXPECT().Invalidate(int64(5))
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		_, err := s.UpdateAttributeValue(ctx, &optionhub.UpdateAttributeValueIn{OptionId: 7, Value: utils.TransformToPtr("СПб")})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("update_duplicate", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("UpdateAttributeValue")
+		mockRepo.EXPECT().GetAttributeValue(gomock.Any(), int64(2)).Return(current, nil)
+		mockRepo.EXPECT().GetAttribute(gomock.Any(), int64(5)).Return(treeAttribute, nil)
+		mockRepo.EXPECT().WithTx(gomock.Any(), gomock.Any()).DoAndReturn(runInTx)
+		mockRepo.EXPECT().GetDuplicateValue(gomock.Any(), gomock.Any()).Return(model.AttributeValue{Id: 4, AttributeId: 5, Value: "Москва"}, nil)
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		_, err := s.UpdateAttributeValue(ctx, &optionhub.UpdateAttributeValueIn{OptionId: 2, Value: utils.TransformToPtr("МОСКВА")})
+
+		st, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.AlreadyExists, st.Code())
+		assert.Len(t, st.Details(), 1)
+		assert.Equal(t, int64(4), st.Details()[0].(*optionhub.DuplicateAttributeValue).OptionId)
+	})
+
+	t.Run("update_parent_cycle", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("UpdateAttributeValue")
+		mockRepo.EXPECT().GetAttributeValue(gomock.Any(), int64(2)).Return(current, nil)
+		mockRepo.EXPECT().GetAttribute(gomock.Any(), int64(5)).Return(treeAttribute, nil)
+		mockRepo.EXPECT().GetAttributeValue(gomock.Any(), int64(3)).Return(model.AttributeValue{Id: 3, AttributeId: 5, ParentId: utils.TransformToPtr(int64(2))}, nil)
+		mockRepo.EXPECT().GetAncestors(gomock.Any(), int64(3)).Return(model.AttributeValueList{
+			{Id: 1, AttributeId: 5},
+			{Id: 2, AttributeId: 5, ParentId: utils.TransformToPtr(int64(1))},
+		}, nil)
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		_, err := s.UpdateAttributeValue(ctx, &optionhub.UpdateAttributeValueIn{OptionId: 2, ParentId: utils.TransformToPtr(int64(3))})
+
+		st, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.FailedPrecondition, st.Code())
+	})
+
+	t.Run("update_own_parent", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("UpdateAttributeValue")
+		mockRepo.EXPECT().GetAttributeValue(gomock.Any(), int64(2)).Return(current, nil).Times(2)
+		mockRepo.EXPECT().GetAttribute(gomock.Any(), int64(5)).Return(treeAttribute, nil)
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		_, err := s.UpdateAttributeValue(ctx, &optionhub.UpdateAttributeValueIn{OptionId: 2, ParentId: utils.TransformToPtr(int64(2))})
+
+		st, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.FailedPrecondition, st.Code())
+	})
+
+	t.Run("update_nothing", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("UpdateAttributeValue")
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		_, err := s.UpdateAttributeValue(ctx, &optionhub.UpdateAttributeValueIn{OptionId: 2})
+
+		st, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+	})
+
+	t.Run("update_deleted", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("UpdateAttributeValue")
+
+		deleted := current
+		deleted.DeletedAt = utils.TransformToPtr(time.Now())
+		mockRepo.EXPECT().GetAttributeValue(gomock.Any(), int64(2)).Return(deleted, nil)
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		_, err := s.UpdateAttributeValue(ctx, &optionhub.UpdateAttributeValueIn{OptionId: 2, Value: utils.TransformToPtr("Москва")})
+
+		st, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.NotFound, st.Code())
+	})
+}
+
+func TestService_DeleteAttributeValue(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLogger := logger_lib.NewMockLoggerInterface(ctrl)
+	ctx = context.WithValue(ctx, config.KeyLogger, mockLogger)
+
+	mockRepo := NewMockDBRepo(ctrl)
+	mockCache := NewMockTreeCache(ctrl)
+	mockNotifier := NewMockAttributeNotifier(ctrl)
+
+	current := model.AttributeValue{Id: 2, AttributeId: 5, Value: "Москва"}
+
+	t.Run("delete_ok", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("DeleteAttributeValue")
+		mockRepo.EXPECT().GetAttributeValue(gomock.Any(), int64(2)).Return(current, nil)
+		mockRepo.EXPECT().WithTx(gomock.Any(), gomock.Any()).DoAndReturn(runInTx)
+		mockRepo.EXPECT().DeleteAttributeValue(gomock.Any(), int64(2)).Return(nil)
+		expectAuditRecord(t, mockRepo, func(record model.AuditRecord) {
+			assert.Equal(t, "DeleteAttributeValue", record.Method)
+			assert.Nil(t, record.After)
+		})
+		expectCatalogEvent(t, mockRepo, func(event *optionhub.CatalogEvent) {
+			assert.Equal(t, optionhub.CatalogEventType_CATALOG_EVENT_TYPE_ATTRIBUTE_VALUE_DELETED, event.Type)
+			assert.Equal(t, int64(5), event.AttributeId)
+			assert.Equal(t, "Москва", event.AttributeValueDeleted.Value)
+		})
+
+		mockCache.EXPECT().Invalidate(int64(5))
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		_, err := s.DeleteAttributeValue(ctx, &optionhub.DeleteAttributeValueIn{OptionId: 2})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("delete_has_children", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("DeleteAttributeValue")
+		mockRepo.EXPECT().GetAttributeValue(gomock.Any(), int64(2)).Return(current, nil)
+		mockRepo.EXPECT().WithTx(gomock.Any(), gomock.Any()).DoAndReturn(runInTx)
+		mockRepo.EXPECT().DeleteAttributeValue(gomock.Any(), int64(2)).Return(model.ErrHasChildren)
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		_, err := s.DeleteAttributeValue(ctx, &optionhub.DeleteAttributeValueIn{OptionId: 2})
+
+		st, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.FailedPrecondition, st.Code())
+	})
+
+	t.Run("delete_already_deleted", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("DeleteAttributeValue")
+
+		deleted := current
+		deleted.DeletedAt = utils.TransformToPtr(time.Now())
+		mockRepo.EXPECT().GetAttributeValue(gomock.Any(), int64(2)).Return(deleted, nil)
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		_, err := s.DeleteAttributeValue(ctx, &optionhub.DeleteAttributeValueIn{OptionId: 2})
+
+		st, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.NotFound, st.Code())
+	})
+}
+
+func TestService_MergeAttributeValues(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.WithValue(context.Background(), config.KeyUUID, "test-uuid")
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLogger := logger_lib.NewMockLoggerInterface(ctrl)
+	ctx = context.WithValue(ctx, config.KeyLogger, mockLogger)
+
+	mockRepo := NewMockDBRepo(ctrl)
+	mockCache := NewMockTreeCache(ctrl)
+	mockNotifier := NewMockAttributeNotifier(ctrl)
+
+	source := model.AttributeValue{Id: 3, AttributeId: 5, Value: "Питер"}
+	target := model.AttributeValue{Id: 2, AttributeId: 5, Value: "Санкт-Петербург"}
+
+	t.Run("merge_ok", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("MergeAttributeValues")
+		mockRepo.EXPECT().GetAttributeValue(gomock.Any(), int64(3)).Return(source, nil)
+		mockRepo.EXPECT().GetAttributeValue(gomock.Any(), int64(2)).Return(target, nil)
+		mockRepo.EXPECT().WithTx(gomock.Any(), gomock.Any()).DoAndReturn(runInTx)
+		mockRepo.EXPECT().MergeAttributeValues(gomock.Any(), int64(3), int64(2)).Return([]int64{8, 9}, nil)
+		mockRepo.EXPECT().GetPendingRequestsByParent(gomock.Any(), int64(3)).Return(nil, nil)
+		expectAuditRecord(t, mockRepo, func(record model.AuditRecord) {
+			assert.Equal(t, "MergeAttributeValues", record.Method)
+			assert.Equal(t, model.AuditEntityAttributeValue, record.EntityType)
+			assert.Equal(t, int64(3), record.EntityID)
+		})
+		expectCatalogEvent(t, mockRepo, func(event *optionhub.CatalogEvent) {
+			assert.Equal(t, optionhub.CatalogEventType_CATALOG_EVENT_TYPE_ATTRIBUTE_VALUE_MERGED, event.Type)
+			assert.Equal(t, int64(5), event.AttributeId)
+			assert.Equal(t, int64(3), event.AttributeValueMerged.SourceOptionId)
+			assert.Equal(t, int64(2), event.AttributeValueMerged.TargetOptionId)
+			assert.Equal(t, []int64{8, 9}, event.AttributeValueMerged.MovedChildIds)
+		})
+		mockCache.EXPECT().Invalidate(int64(5))
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		result, err := s.MergeAttributeValues(ctx, &optionhub.MergeAttributeValuesIn{SourceOptionId: 3, TargetOptionId: 2})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{8, 9}, result.MovedChildIds)
+	})
+
+	t.Run("merge_moves_option_requests", func(t *testing.T) {
+		moved := model.OptionRequest{ID: 20, AttributeID: 5, Value: "Невский район", ParentId: lo.ToPtr(int64(3)), Status: model.OptionRequestStatusPending}
+		duplicate := model.OptionRequest{ID: 21, AttributeID: 5, Value: "Центр", ParentId: lo.ToPtr(int64(3)), Status: model.OptionRequestStatusPending}
+
+		mockLogger.EXPECT().AddFuncName("MergeAttributeValues")
+		mockRepo.EXPECT().GetAttributeValue(gomock.Any(), int64(3)).Return(source, nil)
+		mockRepo.EXPECT().GetAttributeValue(gomock.Any(), int64(2)).Return(target, nil)
+		mockRepo.EXPECT().WithTx(gomock.Any(), gomock.Any()).DoAndReturn(runInTx)
+		mockRepo.EXPECT().MergeAttributeValues(gomock.Any(), int64(3), int64(2)).Return(nil, nil)
+		mockRepo.EXPECT().GetPendingRequestsByParent(gomock.Any(), int64(3)).Return(model.OptionRequestList{moved, duplicate}, nil)
+		movedTo := moved
+		movedTo.ParentId = lo.ToPtr(int64(2))
+		mockRepo.EXPECT().GetPendingDuplicateRequest(gomock.Any(), movedTo).Return(model.OptionRequest{}, model.ErrNotFound)
+		mockRepo.EXPECT().SetOptionRequestParent(gomock.Any(), int64(20), int64(2)).Return(nil)
+		duplicateTo := duplicate
+		duplicateTo.ParentId = lo.ToPtr(int64(2))
+		mockRepo.EXPECT().GetPendingDuplicateRequest(gomock.Any(), duplicateTo).Return(model.OptionRequest{ID: 15}, nil)
+		mockRepo.EXPECT().ResolveOptionRequest(gomock.Any(), model.OptionRequestResolution{
+			RequestID:     21,
+			Status:        model.OptionRequestStatusRejected,
+			ModeratorUuid: "test-uuid",
+			Reason:        lo.ToPtr("duplicate of option request 15"),
+		}).Return(nil)
+		gomock.InOrder(
+			expectAuditRecord(t, mockRepo, func(record model.AuditRecord) {
+				assert.Equal(t, model.AuditEntityOptionRequest, record.EntityType)
+				assert.Equal(t, int64(20), record.EntityID)
+				assert.Contains(t, string(record.After), `"parent_id":2`)
+			}),
+			expectAuditRecord(t, mockRepo, func(record model.AuditRecord) {
+				assert.Equal(t, model.AuditEntityOptionRequest, record.EntityType)
+				assert.Equal(t, int64(21), record.EntityID)
+			}),
+			expectAuditRecord(t, mockRepo, func(record model.AuditRecord) {
+				assert.Equal(t, model.AuditEntityAttributeValue, record.EntityType)
+			}),
+		)
+		gomock.InOrder(
+			expectCatalogEvent(t, mockRepo, func(event *optionhub.CatalogEvent) {
+				assert.Equal(t, optionhub.CatalogEventType_CATALOG_EVENT_TYPE_OPTION_REQUEST_REJECTED, event.Type)
+				assert.Equal(t, int64(21), event.OptionRequestRejected.OptionRequestId)
+			}),
+			expectCatalogEvent(t, mockRepo, func(event *optionhub.CatalogEvent) {
+				assert.Equal(t, optionhub.CatalogEventType_CATALOG_EVENT_TYPE_ATTRIBUTE_VALUE_MERGED, event.Type)
+			}),
+		)
+		mockCache.EXPECT().Invalidate(int64(5))
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		_, err := s.MergeAttributeValues(ctx, &optionhub.MergeAttributeValuesIn{SourceOptionId: 3, TargetOptionId: 2})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("merge_into_itself", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("MergeAttributeValues")
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		_, err := s.MergeAttributeValues(ctx, &optionhub.MergeAttributeValuesIn{SourceOptionId: 3, TargetOptionId: 3})
+
+		st, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+	})
+
+	t.Run("merge_deleted_target", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("MergeAttributeValues")
+
+		deleted := target
+		deleted.DeletedAt = lo.ToPtr(time.Now())
+		mockRepo.EXPECT().GetAttributeValue(gomock.Any(), int64(3)).Return(source, nil)
+		mockRepo.EXPECT().GetAttributeValue(gomock.Any(), int64(2)).Return(deleted, nil)
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		_, err := s.MergeAttributeValues(ctx, &optionhub.MergeAttributeValuesIn{SourceOptionId: 3, TargetOptionId: 2})
+
+		st, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.NotFound, st.Code())
+	})
+
+	t.Run("merge_other_attribute", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("MergeAttributeValues")
+
+		other := target
+		other.AttributeId = 6
+		mockRepo.EXPECT().GetAttributeValue(gomock.Any(), int64(3)).Return(source, nil)
+		mockRepo.EXPECT().GetAttributeValue(gomock.Any(), int64(2)).Return(other, nil)
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		_, err := s.MergeAttributeValues(ctx, &optionhub.MergeAttributeValuesIn{SourceOptionId: 3, TargetOptionId: 2})
+
+		st, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+	})
+
+	t.Run("merge_into_descendant", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("MergeAttributeValues")
+		mockRepo.EXPECT().GetAttributeValue(gomock.Any(), int64(3)).Return(source, nil)
+		mockRepo.EXPECT().GetAttributeValue(gomock.Any(), int64(2)).Return(target, nil)
+		mockRepo.EXPECT().WithTx(gomock.Any(), gomock.Any()).DoAndReturn(runInTx)
+		mockRepo.EXPECT().MergeAttributeValues(gomock.Any(), int64(3), int64(2)).Return(nil, fmt.Errorf("option 2 is a descendant of option 3: %w", model.ErrParentCycle))
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		_, err := s.MergeAttributeValues(ctx, &optionhub.MergeAttributeValuesIn{SourceOptionId: 3, TargetOptionId: 2})
+
+		st, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.FailedPrecondition, st.Code())
+	})
+
+	t.Run("merge_duplicate_child", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("MergeAttributeValues")
+		mockRepo.EXPECT().GetAttributeValue(gomock.Any(), int64(3)).Return(source, nil)
+		mockRepo.EXPECT().GetAttributeValue(gomock.Any(), int64(2)).Return(target, nil)
+		mockRepo.EXPECT().WithTx(gomock.Any(), gomock.Any()).DoAndReturn(runInTx)
+		mockRepo.EXPECT().MergeAttributeValues(gomock.Any(), int64(3), int64(2)).Return(nil, &model.DuplicateValueError{
+			Existing: model.AttributeValue{Id: 10, AttributeId: 5, Value: "Невский район", ParentId: lo.ToPtr(int64(2))},
+		})
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		_, err := s.MergeAttributeValues(ctx, &optionhub.MergeAttributeValuesIn{SourceOptionId: 3, TargetOptionId: 2})
+
+		st, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.AlreadyExists, st.Code())
+		assert.Len(t, st.Details(), 1)
+		detail, ok := st.Details()[0].(*optionhub.DuplicateAttributeValue)
+		assert.True(t, ok)
+		assert.Equal(t, int64(10), detail.OptionId)
+	})
+
+	t.Run("merge_error", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("MergeAttributeValues")
+		mockLogger.EXPECT().Error("failed to merge attribute values: test error")
+		mockRepo.EXPECT().GetAttributeValue(gomock.Any(), int64(3)).Return(source, nil)
+		mockRepo.EXPECT().GetAttributeValue(gomock.Any(), int64(2)).Return(target, nil)
+		mockRepo.EXPECT().WithTx(gomock.Any(), gomock.Any()).DoAndReturn(runInTx)
+		mockRepo.EXPECT().MergeAttributeValues(gomock.Any(), int64(3), int64(2)).Return(nil, errors.New("test error"))
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		_, err := s.MergeAttributeValues(ctx, &optionhub.MergeAttributeValuesIn{SourceOptionId: 3, TargetOptionId: 2})
+
+		st, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.Aborted, st.Code())
+	})
+}
+
+func TestService_NormalizeLegacyValues(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := NewMockDBRepo(ctrl)
+	mockCache := NewMockTreeCache(ctrl)
+	mockNotifier := NewMockAttributeNotifier(ctrl)
+
+	t.Run("normalize_ok", func(t *testing.T) {
+		unique := model.AttributeValue{Id: 1, AttributeId: 5, Value: "Москва"}
+		deleted := model.AttributeValue{Id: 2, AttributeId: 5, Value: "Тверь", DeletedAt: lo.ToPtr(time.Now())}
+
+		mockRepo.EXPECT().WithTx(ctx, gomock.Any()).DoAndReturn(runInTx)
+		mockRepo.EXPECT().GetUnnormalizedValues(ctx, uint64(normalizeBatchSize)).Return(model.AttributeValueList{unique, deleted}, nil)
+		mockRepo.EXPECT().GetAttributeValue(ctx, int64(1)).Return(unique, nil)
+		mockRepo.EXPECT().GetDuplicateValue(ctx, unique).Return(model.AttributeValue{}, model.ErrNotFound)
+		mockRepo.EXPECT().SetNormalizedValue(ctx, unique).Return(nil)
+		mockRepo.EXPECT().GetAttributeValue(ctx, int64(2)).Return(deleted, nil)
+		mockRepo.EXPECT().SetNormalizedValue(ctx, deleted).Return(nil)
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		total, err := s.NormalizeLegacyValues(ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, total)
+	})
+
+	t.Run("normalize_merge_duplicates", func(t *testing.T) {
+		// 3 совпал с 1, а у обоих есть потомок "Центр": сначала сливаются потомки, потом сами значения
+		target := model.AttributeValue{Id: 1, AttributeId: 5, Value: "Москва"}
+		source := model.AttributeValue{Id: 3, AttributeId: 5, Value: "москва "}
+		targetChild := model.AttributeValue{Id: 2, AttributeId: 5, Value: "Центр", ParentId: lo.ToPtr(int64(1))}
+		sourceChild := model.AttributeValue{Id: 4, AttributeId: 5, Value: "центр", ParentId: lo.ToPtr(int64(3))}
+
+		mockRepo.EXPECT().WithTx(ctx, gomock.Any()).DoAndReturn(runInTx)
+		mockRepo.EXPECT().GetUnnormalizedValues(ctx, uint64(normalizeBatchSize)).Return(model.AttributeValueList{source}, nil)
+		mockRepo.EXPECT().GetAttributeValue(ctx, int64(3)).Return(source, nil)
+		mockRepo.EXPECT().GetDuplicateValue(ctx, source).Return(target, nil)
+		gomock.InOrder(
+			mockRepo.EXPECT().MergeAttributeValues(ctx, int64(3), int64(1)).Return(nil, &model.DuplicateValueError{Existing: targetChild}),
+			mockRepo.EXPECT().MergeAttributeValues(ctx, int64(4), int64(2)).Return(nil, nil),
+			mockRepo.EXPECT().MergeAttributeValues(ctx, int64(3), int64(1)).Return([]int64{5}, nil),
+		)
+		mockRepo.EXPECT().GetPendingRequestsByParent(ctx, int64(4)).Return(nil, nil)
+		mockRepo.EXPECT().GetPendingRequestsByParent(ctx, int64(3)).Return(nil, nil)
+		mockRepo.EXPECT().GetDuplicateValue(ctx, model.AttributeValue{AttributeId: 5, Value: "Центр", ParentId: lo.ToPtr(int64(3))}).Return(sourceChild, nil)
+		expectAuditRecord(t, mockRepo, func(record model.AuditRecord) {
+			assert.Equal(t, "NormalizeLegacyValues", record.Method)
+		}).Times(2)
+		expectCatalogEvent(t, mockRepo, func(event *optionhub.CatalogEvent) {
+			assert.Equal(t, optionhub.CatalogEventType_CATALOG_EVENT_TYPE_ATTRIBUTE_VALUE_MERGED, event.Type)
+		}).Times(2)
+		mockRepo.EXPECT().SetNormalizedValue(ctx, source).Return(nil)
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		total, err := s.NormalizeLegacyValues(ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, total)
+	})
+
+	t.Run("normalize_error", func(t *testing.T) {
+		mockRepo.EXPECT().WithTx(ctx, gomock.Any()).DoAndReturn(runInTx)
+		mockRepo.EXPECT().GetUnnormalizedValues(ctx, uint64(normalizeBatchSize)).Return(nil, errors.New("test error"))
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		_, err := s.NormalizeLegacyValues(ctx)
+
+		assert.ErrorContains(t, err, "test error")
+	})
+}
+
+func TestService_NormalizeLegacyOptionRequests(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := NewMockDBRepo(ctrl)
+	mockCache := NewMockTreeCache(ctrl)
+	mockNotifier := NewMockAttributeNotifier(ctrl)
+
+	t.Run("normalize_ok", func(t *testing.T) {
+		first := model.OptionRequest{ID: 1, AttributeID: 5, Value: "Straße", UserUuid: "user-1", Status: model.OptionRequestStatusPending}
+		duplicate := model.OptionRequest{ID: 2, AttributeID: 5, Value: "STRASSE", UserUuid: "user-2", Status: model.OptionRequestStatusPending}
+		approved := model.OptionRequest{ID: 3, AttributeID: 5, Value: "Москва", Status: model.OptionRequestStatusApproved}
+
+		mockRepo.EXPECT().WithTx(ctx, gomock.Any()).DoAndReturn(runInTx)
+		mockRepo.EXPECT().GetUnnormalizedOptionRequests(ctx, uint64(normalizeBatchSize)).
+			Return(model.OptionRequestList{first, duplicate, approved}, nil)
+		mockRepo.EXPECT().GetPendingDuplicateRequest(ctx, first).Return(model.OptionRequest{}, model.ErrNotFound)
+		mockRepo.EXPECT().SetOptionRequestNormalizedValue(ctx, first).Return(nil)
+		mockRepo.EXPECT().GetPendingDuplicateRequest(ctx, duplicate).Return(first, nil)
+		mockRepo.EXPECT().ResolveOptionRequest(ctx, model.OptionRequestResolution{
+			RequestID: 2,
+			Status:    model.OptionRequestStatusRejected,
+			Reason:    lo.ToPtr("duplicate of option request 1"),
+		}).Return(nil)
+		expectAuditRecord(t, mockRepo, func(record model.AuditRecord) {
+			assert.Equal(t, "NormalizeLegacyOptionRequests", record.Method)
+			assert.Equal(t, int64(2), record.EntityID)
+		})
+		expectCatalogEvent(t, mockRepo, func(event *optionhub.CatalogEvent) {
+			assert.Equal(t, optionhub.CatalogEventType_CATALOG_EVENT_TYPE_OPTION_REQUEST_REJECTED, event.Type)
+			assert.Equal(t, "user-2", event.OptionRequestRejected.UserUuid)
+		})
+		mockRepo.EXPECT().SetOptionRequestNormalizedValue(ctx, duplicate).Return(nil)
+		mockRepo.EXPECT().SetOptionRequestNormalizedValue(ctx, approved).Return(nil)
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		total, err := s.NormalizeLegacyOptionRequests(ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 3, total)
+	})
+}
+
+func TestService_ImportAttributeValues(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLogger := logger_lib.NewMockLoggerInterface(ctrl)
+	ctx = context.WithValue(ctx, config.KeyLogger, mockLogger)
+	ctx = context.WithValue(ctx, config.KeyUUID, "test-uuid")
+
+	mockRepo := NewMockDBRepo(ctrl)
+	mockCache := NewMockTreeCache(ctrl)
+	mockNotifier := NewMockAttributeNotifier(ctrl)
+
+	treeAttribute := model.Attribute{ID: 1, Name: "os", Type: model.AttributeTypeTree}
+	existing := model.AttributeValueList{
+		{Id: 10, AttributeId: 1, Value: "Linux"},
+		{Id: 11, AttributeId: 1, Value: "Ubuntu", ParentId: lo.ToPtr(int64(10))},
+		{Id: 12, AttributeId: 1, Value: "BeOS", DeletedAt: lo.ToPtr(time.Now())},
+	}
+
+	t.Run("import_csv_ok", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("ImportAttributeValues")
+		mockRepo.EXPECT().GetAttribute(ctx, int64(1)).Return(treeAttribute, nil)
+		mockRepo.EXPECT().WithTx(ctx, gomock.Any()).DoAndReturn(runInTx)
+		mockRepo.EXPECT().GetValuesByAttributeId(ctx, int64(1), true).Return(existing, nil)
+		mockRepo.EXPECT().GetAttributeAliases(ctx, int64(1)).Return(nil, nil)
+		mockRepo.EXPECT().AddAttributeValue(ctx, model.AttributeValue{AttributeId: 1, Value: "Debian", ParentId: lo.ToPtr(int64(10))}).Return(int64(20), nil)
+		mockRepo.EXPECT().AddAttributeValue(ctx, model.AttributeValue{AttributeId: 1, Value: "Windows"}).Return(int64(21), nil)
+		mockRepo.EXPECT().AddAttributeValue(ctx, model.AttributeValue{AttributeId: 1, Value: "11", ParentId: lo.ToPtr(int64(21))}).Return(int64(22), nil)
+		expectAuditRecord(t, mockRepo, func(record model.AuditRecord) {
+			assert.Equal(t, "ImportAttributeValues", record.Method)
+			assert.Equal(t, model.AuditEntityAttributeValue, record.EntityType)
+		}).Times(3)
+		expectCatalogEvent(t, mockRepo, func(event *optionhub.CatalogEvent) {
+			assert.Equal(t, optionhub.CatalogEventType_CATALOG_EVENT_TYPE_ATTRIBUTE_VALUE_ADDED, event.Type)
+		}).Times(3)
+		mockRepo.EXPECT().AddOutboxMessage(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, msg model.OutboxMessage) error {
+			assert.Equal(t, model.OutboxTopicSetAttribute, msg.Topic)
+			assert.JSONEq(t, `{"attribute_id":1}`, string(msg.Payload))
+			return nil
+		})
+		mockCache.EXPECT().Invalidate(int64(1))
+
+		data := "path\nlinux/ Ubuntu\nLinux/Debian\nWindows/11\n"
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		out, err := s.ImportAttributeValues(ctx, &optionhub.ImportAttributeValuesIn{
+			AttributeId: 1,
+			Format:      optionhub.ImportFormat_IMPORT_FORMAT_CSV,
+			Data:        []byte(data),
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int32(3), out.Created)
+		assert.Equal(t, int32(2), out.Skipped)
+		assert.Equal(t, int32(0), out.Conflicts)
+		assert.Equal(t, []string{"linux", "Ubuntu"}, out.Rows[1].Path)
+		assert.Equal(t, optionhub.ImportRowStatus_IMPORT_ROW_STATUS_SKIPPED, out.Rows[1].Status)
+		assert.Equal(t, int64(11), out.Rows[1].OptionId)
+		assert.Equal(t, []string{"Windows", "11"}, out.Rows[4].Path)
+		assert.Equal(t, int64(22), out.Rows[4].OptionId)
+	})
+
+	t.Run("import_json_deleted_conflict", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("ImportAttributeValues")
+		mockRepo.EXPECT().GetAttribute(ctx, int64(1)).Return(treeAttribute, nil)
+		mockRepo.EXPECT().WithTx(ctx, gomock.Any()).DoAndReturn(runInTx)
+		mockRepo.EXPECT().GetValuesByAttributeId(ctx, int64(1), true).Return(existing, nil)
+		mockRepo.EXPECT().GetAttributeAliases(ctx, int64(1)).Return(nil, nil)
+
+		data := `[{"value": "BeOS", "children": [{"value": "R5"}]}, {"value": "Linux"}]`
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		out, err := s.ImportAttributeValues(ctx, &optionhub.ImportAttributeValuesIn{
+			AttributeId: 1,
+			Format:      optionhub.ImportFormat_IMPORT_FORMAT_JSON,
+			Data:        []byte(data),
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int32(0), out.Created)
+		assert.Equal(t, int32(1), out.Skipped)
+		assert.Equal(t, int32(2), out.Conflicts)
+		assert.Equal(t, optionhub.ImportRowStatus_IMPORT_ROW_STATUS_CONFLICT, out.Rows[0].Status)
+		assert.Contains(t, out.Rows[0].Message, "deleted")
+		assert.Equal(t, optionhub.ImportRowStatus_IMPORT_ROW_STATUS_CONFLICT, out.Rows[1].Status)
+	})
+
+	t.Run("import_recreate_deleted_dry_run", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("ImportAttributeValues")
+		mockRepo.EXPECT().GetAttribute(ctx, int64(1)).Return(treeAttribute, nil)
+		mockRepo.EXPECT().WithTx(ctx, gomock.Any()).DoAndReturn(runInTx)
+		mockRepo.EXPECT().GetValuesByAttributeId(ctx, int64(1), true).Return(existing, nil)
+		mockRepo.EXPECT().GetAttributeAliases(ctx, int64(1)).Return(nil, nil)
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		out, err := s.ImportAttributeValues(ctx, &optionhub.ImportAttributeValuesIn{
+			AttributeId:     1,
+			Format:          optionhub.ImportFormat_IMPORT_FORMAT_CSV,
+			Data:            []byte("path\nBeOS\n"),
+			DryRun:          true,
+			RecreateDeleted: true,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int32(1), out.Created)
+		assert.Equal(t, int32(0), out.Conflicts)
+	})
+
+	t.Run("import_dry_run", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("ImportAttributeValues")
+		mockRepo.EXPECT().GetAttribute(ctx, int64(1)).Return(treeAttribute, nil)
+		mockRepo.EXPECT().WithTx(ctx, gomock.Any()).DoAndReturn(runInTx)
+		mockRepo.EXPECT().GetValuesByAttributeId(ctx, int64(1), true).Return(existing, nil)
+		mockRepo.EXPECT().GetAttributeAliases(ctx, int64(1)).Return(nil, nil)
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		out, err := s.ImportAttributeValues(ctx, &optionhub.ImportAttributeValuesIn{
+			AttributeId: 1,
+			Format:      optionhub.ImportFormat_IMPORT_FORMAT_CSV,
+			Data:        []byte("path\nWindows/11\n"),
+			DryRun:      true,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int32(2), out.Created)
+		assert.Equal(t, int64(0), out.Rows[1].OptionId)
+	})
+
+	t.Run("import_alias_conflict", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("ImportAttributeValues")
+		mockRepo.EXPECT().GetAttribute(ctx, int64(1)).Return(treeAttribute, nil)
+		mockRepo.EXPECT().WithTx(ctx, gomock.Any()).DoAndReturn(runInTx)
+		mockRepo.EXPECT().GetValuesByAttributeId(ctx, int64(1), true).Return(existing, nil)
+		mockRepo.EXPECT().GetAttributeAliases(ctx, int64(1)).Return(model.AttributeValueAliasList{
+			{ID: 5, OptionID: 11, AttributeID: 1, Alias: "Убунту"},
+		}, nil)
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		out, err := s.ImportAttributeValues(ctx, &optionhub.ImportAttributeValuesIn{
+			AttributeId: 1,
+			Format:      optionhub.ImportFormat_IMPORT_FORMAT_CSV,
+			Data:        []byte("path\nLinux/УБУНТУ\n"),
+			DryRun:      true,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int32(0), out.Created)
+		assert.Equal(t, int32(1), out.Skipped)
+		assert.Equal(t, int32(1), out.Conflicts)
+		assert.Equal(t, optionhub.ImportRowStatus_IMPORT_ROW_STATUS_CONFLICT, out.Rows[1].Status)
+		assert.Contains(t, out.Rows[1].Message, "option 11")
+	})
+
+	t.Run("import_enum_nested_path", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("ImportAttributeValues")
+		mockRepo.EXPECT().GetAttribute(ctx, int64(2)).Return(model.Attribute{ID: 2, Type: model.AttributeTypeEnum}, nil)
+		mockRepo.EXPECT().WithTx(ctx, gomock.Any()).DoAndReturn(runInTx)
+		mockRepo.EXPECT().GetValuesByAttributeId(ctx, int64(2), true).Return(model.AttributeValueList{{Id: 30, AttributeId: 2, Value: "Go"}}, nil)
+		mockRepo.EXPECT().GetAttributeAliases(ctx, int64(2)).Return(nil, nil)
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		out, err := s.ImportAttributeValues(ctx, &optionhub.ImportAttributeValuesIn{
+			AttributeId: 2,
+			Format:      optionhub.ImportFormat_IMPORT_FORMAT_CSV,
+			Data:        []byte("path\nGo/1.22\n"),
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int32(1), out.Conflicts)
+		assert.Contains(t, out.Rows[1].Message, "does not support parent_id")
+	})
+
+	t.Run("import_invalid_data", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("ImportAttributeValues")
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		_, err := s.ImportAttributeValues(ctx, &optionhub.ImportAttributeValuesIn{
+			AttributeId: 1,
+			Format:      optionhub.ImportFormat_IMPORT_FORMAT_CSV,
+			Data:        []byte("value\nLinux\n"),
+		})
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("import_unknown_format", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("ImportAttributeValues")
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		_, err := s.ImportAttributeValues(ctx, &optionhub.ImportAttributeValuesIn{AttributeId: 1, Data: []byte("[]")})
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("import_error", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("ImportAttributeValues")
+		mockLogger.EXPECT().Error("failed to import attribute values: test error")
+		mockRepo.EXPECT().GetAttribute(ctx, int64(1)).Return(treeAttribute, nil)
+		mockRepo.EXPECT().WithTx(ctx, gomock.Any()).DoAndReturn(runInTx)
+		mockRepo.EXPECT().GetValuesByAttributeId(ctx, int64(1), true).Return(existing, nil)
+		mockRepo.EXPECT().GetAttributeAliases(ctx, int64(1)).Return(nil, nil)
+		mockRepo.EXPECT().AddAttributeValue(ctx, gomock.Any()).Return(int64(0), errors.New("test error"))
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		_, err := s.ImportAttributeValues(ctx, &optionhub.ImportAttributeValuesIn{
+			AttributeId: 1,
+			Format:      optionhub.ImportFormat_IMPORT_FORMAT_CSV,
+			Data:        []byte("path\nWindows\n"),
+		})
+
+		assert.Equal(t, codes.Aborted, status.Code(err))
+	})
+}
+
+func TestService_ExportCatalog(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLogger := logger_lib.NewMockLoggerInterface(ctrl)
+	ctx = context.WithValue(ctx, config.KeyLogger, mockLogger)
+
+	mockRepo := NewMockDBRepo(ctrl)
+	mockCache := NewMockTreeCache(ctrl)
+	mockNotifier := NewMockAttributeNotifier(ctrl)
+
+	attributes := model.AttributeList{
+		{ID: 2, Name: "os", Type: model.AttributeTypeTree},
+		{ID: 1, Name: "city", Type: model.AttributeTypeEnum},
+	}
+	values := model.AttributeValueList{
+		{Id: 3, AttributeId: 1, Value: "Москва"},
+		{Id: 4, AttributeId: 1, Value: "Казань"},
+		{Id: 10, AttributeId: 2, Value: "Linux"},
+		{Id: 12, AttributeId: 2, Value: "Ubuntu", ParentId: lo.ToPtr(int64(10))},
+		{Id: 11, AttributeId: 2, Value: "Debian", ParentId: lo.ToPtr(int64(10))},
+	}
+
+	t.Run("export_json", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("ExportCatalog")
+		mockRepo.EXPECT().ListAttributes(ctx).Return(attributes, nil)
+		mockRepo.EXPECT().GetAllAttributeValues(ctx, false).Return(values, nil)
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		out, err := s.ExportCatalog(ctx, &optionhub.ExportCatalogIn{Format: optionhub.ExportFormat_EXPORT_FORMAT_JSON})
+
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"attributes": [
+			{"id": 1, "name": "city", "type": "enum", "values": [{"id": 4, "value": "Казань"}, {"id": 3, "value": "Москва"}]},
+			{"id": 2, "name": "os", "type": "tree", "values": [
+				{"id": 10, "value": "Linux", "children": [{"id": 11, "value": "Debian"}, {"id": 12, "value": "Ubuntu"}]}
+			]}
+		]}`, string(out.Data))
+
+		var catalog struct {
+			Attributes []struct {
+				Values json.RawMessage `json:"values"`
+			} `json:"attributes"`
+		}
+		assert.NoError(t, json.Unmarshal(out.Data, &catalog))
+
+		roots, err := model.ParseImportJSON(catalog.Attributes[1].Values)
+		assert.NoError(t, err)
+		assert.Equal(t, 3, roots.Count())
+	})
+
+	t.Run("export_csv", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("ExportCatalog")
+		mockRepo.EXPECT().ListAttributes(ctx).Return(attributes, nil)
+		mockRepo.EXPECT().GetAllAttributeValues(ctx, false).Return(values, nil)
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		out, err := s.ExportCatalog(ctx, &optionhub.ExportCatalogIn{Format: optionhub.ExportFormat_EXPORT_FORMAT_CSV})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "attribute_id,attribute,type,option_id,path,deleted\n"+
+			"1,city,enum,4,Казань,false\n"+
+			"1,city,enum,3,Москва,false\n"+
+			"2,os,tree,10,Linux,false\n"+
+			"2,os,tree,11,Linux/Debian,false\n"+
+			"2,os,tree,12,Linux/Ubuntu,false\n", string(out.Data))
+	})
+
+	t.Run("export_yaml_include_deleted", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("ExportCatalog")
+		mockRepo.EXPECT().ListAttributes(ctx).Return(attributes[:1], nil)
+		mockRepo.EXPECT().GetAllAttributeValues(ctx, true).Return(model.AttributeValueList{
+			{Id: 10, AttributeId: 2, Value: "BeOS", DeletedAt: lo.ToPtr(time.Now())},
+		}, nil)
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		out, err := s.ExportCatalog(ctx, &optionhub.ExportCatalogIn{Format: optionhub.ExportFormat_EXPORT_FORMAT_YAML, IncludeDeleted: true})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "attributes:\n"+
+			"  - id: 2\n"+
+			"    name: os\n"+
+			"    type: tree\n"+
+			"    values:\n"+
+			"      - id: 10\n"+
+			"        value: BeOS\n"+
+			"        deleted: true\n", string(out.Data))
+	})
+
+	t.Run("export_unknown_format", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("ExportCatalog")
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		_, err := s.ExportCatalog(ctx, &optionhub.ExportCatalogIn{})
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("export_error", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("ExportCatalog")
+		mockLogger.EXPECT().Error("failed to get attribute values: test error")
+		mockRepo.EXPECT().ListAttributes(ctx).Return(attributes, nil)
+		mockRepo.EXPECT().GetAllAttributeValues(ctx, false).Return(nil, errors.New("test error"))
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		_, err := s.ExportCatalog(ctx, &optionhub.ExportCatalogIn{Format: optionhub.ExportFormat_EXPORT_FORMAT_JSON})
+
+		assert.Equal(t, codes.Internal, status.Code(err))
+	})
+}
+
+func TestService_SearchAttributeValues(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLogger := logger_lib.NewMockLoggerInterface(ctrl)
+	ctx = context.WithValue(ctx, config.KeyLogger, mockLogger)
+
+	mockRepo := NewMockDBRepo(ctrl)
+	mockCache := NewMockTreeCache(ctrl)
+	mockNotifier := NewMockAttributeNotifier(ctrl)
+
+	t.Run("search_ok", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("SearchAttributeValues")
+		mockRepo.EXPECT().SearchAttributeValues(gomock.Any(), int64(5), "курь", uint64(10)).Return(model.SearchHitList{
+			{Id: 3, Value: "Курьяново", ParentId: utils.TransformToPtr(int64(2)), Path: []string{"Россия", "Москва", "Курьяново"}},
+			{Id: 4, Value: "Курск", Alias: utils.TransformToPtr("Курьск"), Path: []string{"Курск"}},
+		}, nil)
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		result, err := s.SearchAttributeValues(ctx, &optionhub.SearchAttributeValuesIn{AttributeId: 5, Query: " курь "})
+
+		assert.NoError(t, err)
+		assert.Len(t, result.Hits, 2)
+		assert.Equal(t, int64(3), result.Hits[0].OptionId)
+		assert.Equal(t, []string{"Россия", "Москва", "Курьяново"}, result.Hits[0].Path)
+		assert.Nil(t, result.Hits[0].MatchedAlias)
+		assert.Equal(t, "Курск", result.Hits[1].OptionValue)
+		assert.Equal(t, "Курьск", result.Hits[1].GetMatchedAlias())
+	})
+
+	t.Run("search_localized", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("SearchAttributeValues")
+		mockRepo.EXPECT().SearchAttributeValues(gomock.Any(), int64(5), "курь", uint64(10)).Return(model.SearchHitList{
+			{Id: 3, Value: "Курьяново", ParentId: utils.TransformToPtr(int64(2)), Path: []string{"Россия", "Москва", "Курьяново"}, PathIDs: []int64{1, 2, 3}},
+		}, nil)
+		mockRepo.EXPECT().GetValueTranslations(gomock.Any(), []int64{1, 2, 3}).Return(model.ValueTranslationList{
+			{OptionID: 1, Locale: "en", Value: "Russia"},
+			{OptionID: 3, Locale: "en", Value: "Kuryanovo"},
+		}, nil)
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		result, err := s.SearchAttributeValues(ctx, &optionhub.SearchAttributeValuesIn{AttributeId: 5, Query: "курь", Locale: "en"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Kuryanovo", result.Hits[0].OptionValue)
+		assert.Equal(t, []string{"Russia", "Москва", "Kuryanovo"}, result.Hits[0].Path)
+	})
+
+	t.Run("search_limit_capped", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("SearchAttributeValues")
+		mockRepo.EXPECT().SearchAttributeValues(gomock.Any(), int64(5), "мо", uint64(maxSearchLimit)).Return(nil, nil)
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		result, err := s.SearchAttributeValues(ctx, &optionhub.SearchAttributeValuesIn{AttributeId: 5, Query: "мо", Limit: 1000})
+
+		assert.NoError(t, err)
+		assert.Empty(t, result.Hits)
+	})
+
+	t.Run("search_empty_query", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("SearchAttributeValues")
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		_, err := s.SearchAttributeValues(ctx, &optionhub.SearchAttributeValuesIn{AttributeId: 5, Query: "  "})
+
+		st, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+	})
+}
+
+func TestService_GetSubtree(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLogger := logger_lib.NewMockLoggerInterface(ctrl)
+	ctx = context.WithValue(ctx, config.KeyLogger, mockLogger)
+
+	mockRepo := NewMockDBRepo(ctrl)
+	mockCache := NewMockTreeCache(ctrl)
+	mockNotifier := NewMockAttributeNotifier(ctrl)
+
+	t.Run("get_subtree_ok", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("GetSubtree")
+		mockRepo.EXPECT().GetSubtree(gomock.Any(), int64(2), int32(1)).Return(model.AttributeValueList{
+			{Id: 2, Value: "Москва", ParentId: utils.TransformToPtr(int64(1)), HasChildren: true},
+			{Id: 3, Value: "Курьяново", ParentId: utils.TransformToPtr(int64(2)), HasChildren: true},
+		}, nil)
+		mockRepo.EXPECT().GetValueTranslations(gomock.Any(), []int64{2, 3}).Return(nil, nil)
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		result, err := s.GetSubtree(ctx, &optionhub.GetSubtreeIn{OptionId: 2, Depth: 1})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), result.Option.OptionId)
+		assert.Len(t, result.Option.Children, 1)
+		assert.Equal(t, "Курьяново", result.Option.Children[0].OptionValue)
+		assert.True(t, result.Option.Children[0].HasChildren)
+	})
+
+	t.Run("get_subtree_not_found", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("GetSubtree")
+		mockRepo.EXPECT().GetSubtree(gomock.Any(), int64(2), int32(0)).Return(nil, nil)
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		_, err := s.GetSubtree(ctx, &optionhub.GetSubtreeIn{OptionId: 2})
+
+		st, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.NotFound, st.Code())
+	})
+}
+
+func TestService_GetAncestors(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLogger := logger_lib.NewMockLoggerInterface(ctrl)
+	ctx = context.WithValue(ctx, config.KeyLogger, mockLogger)
+
+	mockRepo := NewMockDBRepo(ctrl)
+	mockCache := NewMockTreeCache(ctrl)
+	mockNotifier := NewMockAttributeNotifier(ctrl)
+
+	t.Run("get_ancestors_ok", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("GetAncestors")
+		mockRepo.EXPECT().GetAttributeValue(gomock.Any(), int64(3)).Return(model.AttributeValue{Id: 3, ParentId: utils.TransformToPtr(int64(2))}, nil)
+		mockRepo.EXPECT().GetAncestors(gomock.Any(), int64(3)).Return(model.AttributeValueList{
+			{Id: 1, Value: "Россия"},
+			{Id: 2, Value: "Москва", ParentId: utils.TransformToPtr(int64(1))},
+		}, nil)
+		mockRepo.EXPECT().GetValueTranslations(gomock.Any(), []int64{1, 2}).Return(nil, nil)
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		result, err := s.GetAncestors(ctx, &optionhub.GetAncestorsIn{OptionId: 3})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"Россия", "Москва"}, lo.Map(result.Ancestors, func(o *optionhub.Option, _ int) string { return o.OptionValue }))
+	})
+
+	t.Run("get_ancestors_not_found", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("GetAncestors")
+		mockRepo.EXPECT().GetAttributeValue(gomock.Any(), int64(3)).Return(model.AttributeValue{}, model.ErrNotFound)
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		_, err := s.GetAncestors(ctx, &optionhub.GetAncestorsIn{OptionId: 3})
+
+		st, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.NotFound, st.Code())
+	})
+}
+
+func TestService_GetChildren(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLogger := logger_lib.NewMockLoggerInterface(ctrl)
+	ctx = context.WithValue(ctx, config.KeyLogger, mockLogger)
+
+	mockRepo := NewMockDBRepo(ctrl)
+	mockCache := NewMockTreeCache(ctrl)
+	mockNotifier := NewMockAttributeNotifier(ctrl)
+
+	t.Run("get_children_ok", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("GetChildren")
+		mockRepo.EXPECT().GetAttributeValue(gomock.Any(), int64(1)).Return(model.AttributeValue{Id: 1}, nil)
+		mockRepo.EXPECT().GetChildren(gomock.Any(), int64(1)).Return(model.AttributeValueList{
+			{Id: 2, Value: "Москва", ParentId: utils.TransformToPtr(int64(1)), HasChildren: true},
+		}, nil)
+		mockRepo.EXPECT().GetValueTranslations(gomock.Any(), []int64{2}).Return(model.ValueTranslationList{{OptionID: 2, Locale: "en", Value: "Moscow"}}, nil)
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		result, err := s.GetChildren(ctx, &optionhub.GetChildrenIn{OptionId: 1, Locale: "kk,en"})
+
+		assert.NoError(t, err)
+		assert.Len(t, result.Children, 1)
+		assert.True(t, result.Children[0].HasChildren)
+		assert.Empty(t, result.Children[0].Children)
+		assert.Equal(t, "Moscow", result.Children[0].OptionValue)
+	})
+
+	t.Run("get_children_not_found", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("GetChildren")
+		mockRepo.EXPECT().GetAttributeValue(gomock.Any(), int64(1)).Return(model.AttributeValue{}, model.ErrNotFound)
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		_, err := s.GetChildren(ctx, &optionhub.GetChildrenIn{OptionId: 1})
+
+		st, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.NotFound, st.Code())
+	})
+
+	t.Run("get_children_deleted", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("GetChildren")
+		mockRepo.EXPECT().GetAttributeValue(gomock.Any(), int64(1)).Return(model.AttributeValue{Id: 1, DeletedAt: utils.TransformToPtr(time.Now())}, nil)
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		_, err := s.GetChildren(ctx, &optionhub.GetChildrenIn{OptionId: 1})
+
+		st, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.NotFound, st.Code())
+	})
+}
+
+func TestService_ResolveOptions(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLogger := logger_lib.NewMockLoggerInterface(ctrl)
+	ctx = context.WithValue(ctx, config.KeyLogger, mockLogger)
+
+	mockRepo := NewMockDBRepo(ctrl)
+	mockCache := NewMockTreeCache(ctrl)
+	mockNotifier := NewMockAttributeNotifier(ctrl)
+
+	t.Run("resolve_ok", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("ResolveOptions")
+		mockRepo.EXPECT().GetValueRedirects(gomock.Any(), []int64{3, 7, 99}).Return(nil, nil)
+		mockRepo.EXPECT().GetAttributeValuesByIds(gomock.Any(), []int64{3, 7, 99}).Return(model.AttributeValueList{
+			{Id: 7, AttributeId: 1, Value: "Linux"},
+			{Id: 3, AttributeId: 5, Value: "Курьяново", ParentId: utils.TransformToPtr(int64(2))},
+		}, nil)
+		mockRepo.EXPECT().GetAttributeValueById(gomock.Any(), []int64{1, 5}).Return([]model.Attribute{
+			{ID: 1, Name: "os"},
+			{ID: 5, Name: "city"},
+		}, nil)
+		mockRepo.EXPECT().GetPaths(gomock.Any(), []int64{3, 7, 99}).Return([]model.OptionPath{
+			{OptionID: 3, Path: []string{"Россия", "Москва", "Курьяново"}},
+			{OptionID: 7, Path: []string{"Linux"}},
+		}, nil)
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		result, err := s.ResolveOptions(ctx, &optionhub.ResolveOptionsIn{OptionIds: []int64{3, 7, 3, 99}})
+
+		assert.NoError(t, err)
+		assert.Len(t, result.Options, 2)
+		assert.Equal(t, int64(3), result.Options[0].OptionId)
+		assert.Equal(t, "city", result.Options[0].AttributeName)
+		assert.Equal(t, []string{"Россия", "Москва", "Курьяново"}, result.Options[0].Path)
+		assert.Equal(t, "os", result.Options[1].AttributeName)
+		assert.Equal(t, []int64{99}, result.NotFoundIds)
+	})
+
+	t.Run("resolve_localized", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("ResolveOptions")
+		mockRepo.EXPECT().GetValueRedirects(gomock.Any(), []int64{3}).Return(nil, nil)
+		mockRepo.EXPECT().GetAttributeValuesByIds(gomock.Any(), []int64{3}).Return(model.AttributeValueList{
+			{Id: 3, AttributeId: 5, Value: "Москва", ParentId: utils.TransformToPtr(int64(1))},
+		}, nil)
+		mockRepo.EXPECT().GetAttributeValueById(gomock.Any(), []int64{5}).Return([]model.Attribute{{ID: 5, Name: "город"}}, nil)
+		mockRepo.EXPECT().GetPaths(gomock.Any(), []int64{3}).Return([]model.OptionPath{
+			{OptionID: 3, Path: []string{"Россия", "Москва"}, PathIDs: []int64{1, 3}},
+		}, nil)
+		mockRepo.EXPECT().GetValueTranslations(gomock.Any(), []int64{1, 3}).Return(model.ValueTranslationList{
+			{OptionID: 1, Locale: "en", Value: "Russia"},
+			{OptionID: 3, Locale: "en", Value: "Moscow"},
+		}, nil)
+		mockRepo.EXPECT().GetAttributeTranslations(gomock.Any(), []int64{5}).Return(model.AttributeTranslationList{
+			{AttributeID: 5, Locale: "en", Name: "city"},
+		}, nil)
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		result, err := s.ResolveOptions(ctx, &optionhub.ResolveOptionsIn{OptionIds: []int64{3}, Locale: "en-GB"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Moscow", result.Options[0].OptionValue)
+		assert.Equal(t, "city", result.Options[0].AttributeName)
+		assert.Equal(t, []string{"Russia", "Moscow"}, result.Options[0].Path)
+	})
+
+	t.Run("resolve_redirected", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("ResolveOptions")
+		mockRepo.EXPECT().GetValueRedirects(gomock.Any(), []int64{4, 7}).Return([]model.ValueRedirect{
+			{SourceID: 4, TargetID: 7},
+		}, nil)
+		mockRepo.EXPECT().GetAttributeValuesByIds(gomock.Any(), []int64{7}).Return(model.AttributeValueList{
+			{Id: 7, AttributeId: 1, Value: "Linux"},
+		}, nil)
+		mockRepo.EXPECT().GetAttributeValueById(gomock.Any(), []int64{1}).Return([]model.Attribute{{ID: 1, Name: "os"}}, nil)
+		mockRepo.EXPECT().GetPaths(gomock.Any(), []int64{7}).Return([]model.OptionPath{
+			{OptionID: 7, Path: []string{"Linux"}},
+		}, nil)
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		result, err := s.ResolveOptions(ctx, &optionhub.ResolveOptionsIn{OptionIds: []int64{4, 7}})
+
+		assert.NoError(t, err)
+		assert.Len(t, result.Options, 2)
+		assert.Equal(t, int64(7), result.Options[0].OptionId)
+		assert.Equal(t, int64(4), result.Options[0].GetRedirectedFrom())
+		assert.Equal(t, []string{"Linux"}, result.Options[0].Path)
+		assert.Equal(t, int64(7), result.Options[1].OptionId)
+		assert.Nil(t, result.Options[1].RedirectedFrom)
+		assert.Empty(t, result.NotFoundIds)
+	})
+
+	t.Run("resolve_empty", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("ResolveOptions")
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		result, err := s.ResolveOptions(ctx, &optionhub.ResolveOptionsIn{})
+
+		assert.NoError(t, err)
+		assert.Empty(t, result.Options)
+	})
+
+	t.Run("resolve_error", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("ResolveOptions")
+		mockLogger.EXPECT().Error("failed to get attribute values by ids: test error")
+		mockRepo.EXPECT().GetValueRedirects(gomock.Any(), []int64{3}).Return(nil, nil)
+		mockRepo.EXPECT().GetAttributeValuesByIds(gomock.Any(), []int64{3}).Return(nil, errors.New("test error"))
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		_, err := s.ResolveOptions(ctx, &optionhub.ResolveOptionsIn{OptionIds: []int64{3}})
+
+		st, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.Internal, st.Code())
+	})
+}
+
+func TestService_SetAttributeValueTranslation(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.WithValue(context.Background(), config.KeyUUID, "test-uuid")
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLogger := logger_lib.NewMockLoggerInterface(ctrl)
+	ctx = context.WithValue(ctx, config.KeyLogger, mockLogger)
+
+	mockRepo := NewMockDBRepo(ctrl)
+	mockCache := NewMockTreeCache(ctrl)
+	mockNotifier := NewMockAttributeNotifier(ctrl)
+
+	t.Run("set_ok", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("SetAttributeValueTranslation")
+
+		mockRepo.EXPECT().GetAttributeValue(gomock.Any(), int64(2)).Return(model.AttributeValue{Id: 2, AttributeId: 5, Value: "Москва"}, nil)
+		mockRepo.EXPECT().WithTx(gomock.Any(), gomock.Any()).DoAndReturn(runInTx)
+		mockRepo.EXPECT().GetValueTranslations(gomock.Any(), []int64{2}).Return(model.ValueTranslationList{{OptionID: 2, Locale: "en", Value: "Moskva"}}, nil)
+		mockRepo.EXPECT().SetValueTranslation(gomock.Any(), model.ValueTranslation{OptionID: 2, Locale: "en", Value: "Moscow"}).Return(nil)
+		expectAuditRecord(t, mockRepo, func(record model.AuditRecord) {
+			assert.Equal(t, "SetAttributeValueTranslation", record.Method)
+			assert.Equal(t, model.AuditEntityAttributeValue, record.EntityType)
+			assert.Equal(t, int64(2), record.EntityID)
+			assert.JSONEq(t, `{"option_id":2,"locale":"en","value":"Moskva"}`, string(record.Before))
+			assert.JSONEq(t, `{"option_id":2,"locale":"en","value":"Moscow"}`, string(record.After))
+		})
+		mockCache.EXPECT().Invalidate(int64(5))
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		_, err := s.SetAttributeValueTranslation(ctx, &optionhub.SetAttributeValueTranslationIn{OptionId: 2, Locale: "EN", Value: " Moscow "})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("set_empty", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("SetAttributeValueTranslation")
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		_, err := s.SetAttributeValueTranslation(ctx, &optionhub.SetAttributeValueTranslationIn{OptionId: 2, Locale: "en", Value: " "})
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("set_invalid_locale", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("SetAttributeValueTranslation")
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		_, err := s.SetAttributeValueTranslation(ctx, &optionhub.SetAttributeValueTranslationIn{OptionId: 2, Locale: "en,ru", Value: "Moscow"})
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("set_option_deleted", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("SetAttributeValueTranslation")
+
+		deletedAt := time.Now()
+		mockRepo.EXPECT().GetAttributeValue(gomock.Any(), int64(2)).Return(model.AttributeValue{Id: 2, AttributeId: 5, DeletedAt: &deletedAt}, nil)
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		_, err := s.SetAttributeValueTranslation(ctx, &optionhub.SetAttributeValueTranslationIn{OptionId: 2, Locale: "en", Value: "Moscow"})
+
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
+
+func TestService_DeleteAttributeValueTranslation(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.WithValue(context.Background(), config.KeyUUID, "test-uuid")
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLogger := logger_lib.NewMockLoggerInterface(ctrl)
+	ctx = context.WithValue(ctx, config.KeyLogger, mockLogger)
+
+	mockRepo := NewMockDBRepo(ctrl)
+	mockCache := NewMockTreeCache(ctrl)
+	mockNotifier := NewMockAttributeNotifier(ctrl)
+
+	t.Run("delete_ok", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("DeleteAttributeValueTranslation")
+
+		mockRepo.EXPECT().GetAttributeValue(gomock.Any(), int64(2)).Return(model.AttributeValue{Id: 2, AttributeId: 5, Value: "Москва"}, nil)
+		mockRepo.EXPECT().WithTx(gomock.Any(), gomock.Any()).DoAndReturn(runInTx)
+		mockRepo.EXPECT().GetValueTranslations(gomock.Any(), []int64{2}).Return(model.ValueTranslationList{{OptionID: 2, Locale: "en", Value: "Moscow"}}, nil)
+		mockRepo.EXPECT().DeleteValueTranslation(gomock.Any(), int64(2), "en").Return(nil)
+		expectAuditRecord(t, mockRepo, func(record model.AuditRecord) {
+			assert.Equal(t, "DeleteAttributeValueTranslation", record.Method)
+			assert.JSONEq(t, `{"option_id":2,"locale":"en","value":"Moscow"}`, string(record.Before))
+			assert.Nil(t, record.After)
+		})
+		mockCache.EXPECT().Invalidate(int64(5))
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		_, err := s.DeleteAttributeValueTranslation(ctx, &optionhub.DeleteAttributeValueTranslationIn{OptionId: 2, Locale: "en"})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("delete_not_found", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("DeleteAttributeValueTranslation")
+
+		mockRepo.EXPECT().GetAttributeValue(gomock.Any(), int64(2)).Return(model.AttributeValue{Id: 2, AttributeId: 5, Value: "Москва"}, nil)
+		mockRepo.EXPECT().WithTx(gomock.Any(), gomock.Any()).DoAndReturn(runInTx)
+		mockRepo.EXPECT().GetValueTranslations(gomock.Any(), []int64{2}).Return(nil, nil)
+		mockRepo.EXPECT().DeleteValueTranslation(gomock.Any(), int64(2), "de").Return(model.ErrNotFound)
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		_, err := s.DeleteAttributeValueTranslation(ctx, &optionhub.DeleteAttributeValueTranslationIn{OptionId: 2, Locale: "de"})
+
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
+
+func TestService_SetAttributeTranslation(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.WithValue(context.Background(), config.KeyUUID, "test-uuid")
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLogger := logger_lib.NewMockLoggerInterface(ctrl)
+	ctx = context.WithValue(ctx, config.KeyLogger, mockLogger)
+
+	mockRepo := NewMockDBRepo(ctrl)
+	mockCache := NewMockTreeCache(ctrl)
+	mockNotifier := NewMockAttributeNotifier(ctrl)
+
+	t.Run("set_ok", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("SetAttributeTranslation")
+
+		mockRepo.EXPECT().GetAttribute(gomock.Any(), int64(3)).Return(model.Attribute{ID: 3, Name: "city"}, nil)
+		mockRepo.EXPECT().WithTx(gomock.Any(), gomock.Any()).DoAndReturn(runInTx)
+		mockRepo.EXPECT().GetAttributeTranslations(gomock.Any(), []int64{3}).Return(nil, nil)
+		mockRepo.EXPECT().SetAttributeTranslation(gomock.Any(), model.AttributeTranslation{AttributeID: 3, Locale: "ru", Name: "город"}).Return(nil)
+		expectAuditRecord(t, mockRepo, func(record model.AuditRecord) {
+			assert.Equal(t, "SetAttributeTranslation", record.Method)
+			assert.Equal(t, model.AuditEntityAttribute, record.EntityType)
+			assert.Nil(t, record.Before)
+			assert.JSONEq(t, `{"attribute_id":3,"locale":"ru","name":"город"}`, string(record.After))
+		})
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		_, err := s.SetAttributeTranslation(ctx, &optionhub.SetAttributeTranslationIn{AttributeId: 3, Locale: "ru", Name: "город"})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("set_attribute_not_found", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("SetAttributeTranslation")
+
+		mockRepo.EXPECT().GetAttribute(gomock.Any(), int64(3)).Return(model.Attribute{}, model.ErrNotFound)
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		_, err := s.SetAttributeTranslation(ctx, &optionhub.SetAttributeTranslationIn{AttributeId: 3, Locale: "ru", Name: "город"})
+
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
+
+func TestService_AddAttributeValueAlias(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLogger := logger_lib.NewMockLoggerInterface(ctrl)
+	ctx = context.WithValue(ctx, config.KeyLogger, mockLogger)
+	ctx = context.WithValue(ctx, config.KeyUUID, "test-uuid")
+
+	mockRepo := NewMockDBRepo(ctrl)
+	mockCache := NewMockTreeCache(ctrl)
+	mockNotifier := NewMockAttributeNotifier(ctrl)
+
+	option := model.AttributeValue{Id: 7, AttributeId: 2, Value: "Санкт-Петербург"}
+
+	t.Run("add_ok", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("AddAttributeValueAlias")
+		mockRepo.EXPECT().GetAttributeValue(ctx, int64(7)).Return(option, nil)
+		mockRepo.EXPECT().WithTx(ctx, gomock.Any()).DoAndReturn(runInTx)
+		mockRepo.EXPECT().AddValueAlias(ctx, model.AttributeValueAlias{OptionID: 7, AttributeID: 2, Alias: "Питер"}).Return(int64(3), nil)
+		expectAuditRecord(t, mockRepo, func(record model.AuditRecord) {
+			assert.Equal(t, "AddAttributeValueAlias", record.Method)
+			assert.Equal(t, int64(7), record.EntityID)
+			assert.JSONEq(t, `{"id":3,"option_id":7,"attribute_id":2,"alias":"Питер"}`, string(record.After))
+		})
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		out, err := s.AddAttributeValueAlias(ctx, &optionhub.AddAttributeValueAliasIn{OptionId: 7, Alias: "  Питер "})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(3), out.AliasId)
+	})
+
+	t.Run("add_same_as_value", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("AddAttributeValueAlias")
+		mockRepo.EXPECT().GetAttributeValue(ctx, int64(7)).Return(option, nil)
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		_, err := s.AddAttributeValueAlias(ctx, &optionhub.AddAttributeValueAliasIn{OptionId: 7, Alias: "САНКТ-ПЕТЕРБУРГ"})
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("add_matches_other_value", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("AddAttributeValueAlias")
+		mockRepo.EXPECT().GetAttributeValue(ctx, int64(7)).Return(option, nil)
+		mockRepo.EXPECT().WithTx(ctx, gomock.Any()).DoAndReturn(runInTx)
+		mockRepo.EXPECT().AddValueAlias(ctx, gomock.Any()).Return(int64(0), &model.DuplicateValueError{Existing: model.AttributeValue{Id: 8, AttributeId: 2, Value: "Москва"}})
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		_, err := s.AddAttributeValueAlias(ctx, &optionhub.AddAttributeValueAliasIn{OptionId: 7, Alias: "Москва"})
+
+		st, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.AlreadyExists, st.Code())
+		assert.Equal(t, int64(8), st.Details()[0].(*optionhub.DuplicateAttributeValue).OptionId)
+	})
+
+	t.Run("add_alias_taken", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("AddAttributeValueAlias")
+		mockRepo.EXPECT().GetAttributeValue(ctx, int64(7)).Return(option, nil)
+		mockRepo.EXPECT().WithTx(ctx, gomock.Any()).DoAndReturn(runInTx)
+		mockRepo.EXPECT().AddValueAlias(ctx, gomock.Any()).Return(int64(0), &model.DuplicateValueError{Existing: option})
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		_, err := s.AddAttributeValueAlias(ctx, &optionhub.AddAttributeValueAliasIn{OptionId: 7, Alias: "СПб"})
+
+		assert.Equal(t, codes.AlreadyExists, status.Code(err))
+	})
+
+	t.Run("add_option_deleted", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("AddAttributeValueAlias")
+
+		deleted := option
+		deleted.DeletedAt = lo.ToPtr(time.Now())
+		mockRepo.EXPECT().GetAttributeValue(ctx, int64(7)).Return(deleted, nil)
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		_, err := s.AddAttributeValueAlias(ctx, &optionhub.AddAttributeValueAliasIn{OptionId: 7, Alias: "Питер"})
+
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("add_empty", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("AddAttributeValueAlias")
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		_, err := s.AddAttributeValueAlias(ctx, &optionhub.AddAttributeValueAliasIn{OptionId: 7, Alias: " \t"})
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestService_DeleteAttributeValueAlias(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLogger := logger_lib.NewMockLoggerInterface(ctrl)
+	ctx = context.WithValue(ctx, config.KeyLogger, mockLogger)
+	ctx = context.WithValue(ctx, config.KeyUUID, "test-uuid")
+
+	mockRepo := NewMockDBRepo(ctrl)
+	mockCache := NewMockTreeCache(ctrl)
+	mockNotifier := NewMockAttributeNotifier(ctrl)
+
+	t.Run("delete_ok", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("DeleteAttributeValueAlias")
+		mockRepo.EXPECT().GetValueAlias(ctx, int64(3)).Return(model.AttributeValueAlias{ID: 3, OptionID: 7, AttributeID: 2, Alias: "Питер"}, nil)
+		mockRepo.EXPECT().WithTx(ctx, gomock.Any()).DoAndReturn(runInTx)
+		mockRepo.EXPECT().DeleteValueAlias(ctx, int64(3)).Return(nil)
+		expectAuditRecord(t, mockRepo, func(record model.AuditRecord) {
+			assert.Equal(t, "DeleteAttributeValueAlias", record.Method)
+			assert.Equal(t, int64(7), record.EntityID)
+			assert.Nil(t, record.After)
+		})
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		_, err := s.DeleteAttributeValueAlias(ctx, &optionhub.DeleteAttributeValueAliasIn{AliasId: 3})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("delete_not_found", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("DeleteAttributeValueAlias")
+		mockRepo.EXPECT().GetValueAlias(ctx, int64(4)).Return(model.AttributeValueAlias{}, model.ErrNotFound)
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		_, err := s.DeleteAttributeValueAlias(ctx, &optionhub.DeleteAttributeValueAliasIn{AliasId: 4})
+
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
+
+func TestService_GetAuditLog(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLogger := logger_lib.NewMockLoggerInterface(ctrl)
+	ctx = context.WithValue(ctx, config.KeyLogger, mockLogger)
+
+	mockRepo := NewMockDBRepo(ctrl)
+	mockCache := NewMockTreeCache(ctrl)
+	mockNotifier := NewMockAttributeNotifier(ctrl)
+
+	t.Run("get_ok", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("GetAuditLog")
+
+		entity := model.AuditEntityAttributeValue
+		mockRepo.EXPECT().GetAuditLog(gomock.Any(), model.AuditLogFilter{
+			EntityType: &entity,
+			EntityID:   utils.TransformToPtr(int64(2)),
+			PageSize:   2,
+		}).Return(model.AuditRecordList{
+			{ID: 30, Method: "UpdateAttributeValue", EntityType: entity, EntityID: 2, Before: []byte(`{"value":"Moskva"}`), After: []byte(`{"value":"Москва"}`)},
+			{ID: 20, Method: "UpdateAttributeValue", EntityType: entity, EntityID: 2},
+			{ID: 10, Method: "AddAttributeValue", EntityType: entity, EntityID: 2},
+		}, nil)
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		result, err := s.GetAuditLog(ctx, &optionhub.GetAuditLogIn{
+			PageSize:   2,
+			EntityType: optionhub.AuditEntityType_AUDIT_ENTITY_TYPE_ATTRIBUTE_VALUE,
+			EntityId:   utils.TransformToPtr(int64(2)),
+		})
+
+		assert.NoError(t, err)
+		assert.Len(t, result.Records, 2)
+		assert.Equal(t, `{"value":"Москва"}`, result.Records[0].After)
+		assert.Equal(t, optionhub.AuditEntityType_AUDIT_ENTITY_TYPE_ATTRIBUTE_VALUE, result.Records[0].EntityType)
+
+		filter, err := model.NewAuditLogFilter(&optionhub.GetAuditLogIn{PageToken: result.NextPageToken})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(20), *filter.BeforeID)
+	})
+
+	t.Run("get_entity_id_without_type", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("GetAuditLog")
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		_, err := s.GetAuditLog(ctx, &optionhub.GetAuditLogIn{EntityId: utils.TransformToPtr(int64(2))})
+
+		st, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+	})
+
+	t.Run("get_error", func(t *testing.T) {
+		mockLogger.EXPECT().AddFuncName("GetAuditLog")
+		mockLogger.EXPECT().Error("failed to get audit log: test error")
+		mockRepo.EXPECT().GetAuditLog(gomock.Any(), gomock.Any()).Return(nil, errors.New("test error"))
+
+		s := NewService(mockRepo, mockCache, mockNotifier)
+		_, err := s.GetAuditLog(ctx, &optionhub.GetAuditLogIn{})
+
+		st, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.Internal, st.Code())
+	})
 }
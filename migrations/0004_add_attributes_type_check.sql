@@ -0,0 +1,43 @@
+-- +goose Up
+-- до этой миграции тип не проверялся: приводим известные написания к кодам типов
+UPDATE attributes
+SET type = CASE lower(regexp_replace(trim(type), '[\s-]+', '_', 'g'))
+               WHEN 'text' THEN 'free_text'
+               WHEN 'string' THEN 'free_text'
+               WHEN 'freetext' THEN 'free_text'
+               WHEN 'number' THEN 'number_range'
+               WHEN 'numberrange' THEN 'number_range'
+               WHEN 'bool' THEN 'boolean'
+               ELSE lower(regexp_replace(trim(type), '[\s-]+', '_', 'g'))
+    END
+WHERE type NOT IN ('enum', 'tree', 'free_text', 'number_range', 'date', 'boolean');
+
+-- NOT VALID проверяет только новые и изменяемые строки; существующие проверяются ниже, если их удалось привести
+ALTER TABLE attributes
+    ADD CONSTRAINT attributes_type_check
+        CHECK (type IN ('enum', 'tree', 'free_text', 'number_range', 'date', 'boolean')) NOT VALID;
+
+-- +goose StatementBegin
+DO
+$$
+    DECLARE
+        unknown TEXT;
+    BEGIN
+        SELECT string_agg(DISTINCT quote_literal(type), ', ')
+        INTO unknown
+        FROM attributes
+        WHERE type NOT IN ('enum', 'tree', 'free_text', 'number_range', 'date', 'boolean');
+
+        IF unknown IS NULL THEN
+            ALTER TABLE attributes VALIDATE CONSTRAINT attributes_type_check;
+        ELSE
+            -- такие атрибуты нужно исправить вручную и выполнить VALIDATE CONSTRAINT attributes_type_check
+            RAISE WARNING 'attributes have unknown types: %', unknown;
+        END IF;
+    END
+$$;
+-- +goose StatementEnd
+
+-- +goose Down
+ALTER TABLE attributes
+    DROP CONSTRAINT IF EXISTS attributes_type_check;
@@ -0,0 +1,20 @@
+-- +goose Up
+ALTER TABLE option_requests
+    ADD COLUMN status         TEXT NOT NULL DEFAULT 'pending'
+        CONSTRAINT option_requests_status_check CHECK (status IN ('pending', 'approved', 'rejected', 'merged')),
+    ADD COLUMN reject_reason  TEXT,
+    ADD COLUMN option_id      INT REFERENCES attribute_values (id),
+    ADD COLUMN moderator_uuid UUID,
+    ADD COLUMN resolved_at    TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS option_requests_status_idx ON option_requests (status);
+
+-- +goose Down
+DROP INDEX IF EXISTS option_requests_status_idx;
+
+ALTER TABLE option_requests
+    DROP COLUMN IF EXISTS resolved_at,
+    DROP COLUMN IF EXISTS moderator_uuid,
+    DROP COLUMN IF EXISTS option_id,
+    DROP COLUMN IF EXISTS reject_reason,
+    DROP COLUMN IF EXISTS status;
@@ -0,0 +1,26 @@
+-- +goose Up
+ALTER TABLE option_requests
+    ADD COLUMN parent_id INT REFERENCES attribute_values (id);
+
+-- у существующих заявок parent_id пуст, поэтому дубли - ожидающие заявки с тем же значением атрибута.
+-- Остаётся самая ранняя, остальные отклоняются со ссылкой на неё
+UPDATE option_requests r
+SET status        = 'rejected',
+    reject_reason = 'duplicate of option request ' || d.first_id,
+    resolved_at   = CURRENT_TIMESTAMP
+FROM (SELECT id,
+             min(id) OVER (PARTITION BY attribute_id, lower(value)) AS first_id
+      FROM option_requests
+      WHERE status = 'pending') d
+WHERE r.id = d.id
+  AND d.id <> d.first_id;
+
+CREATE UNIQUE INDEX IF NOT EXISTS option_requests_pending_uniq
+    ON option_requests (attribute_id, lower(value), COALESCE(parent_id, 0))
+    WHERE status = 'pending';
+
+-- +goose Down
+DROP INDEX IF EXISTS option_requests_pending_uniq;
+
+ALTER TABLE option_requests
+    DROP COLUMN IF EXISTS parent_id;
@@ -0,0 +1,9 @@
+-- +goose Up
+CREATE INDEX IF NOT EXISTS option_requests_status_created_at_idx ON option_requests (status, created_at, id);
+CREATE INDEX IF NOT EXISTS option_requests_attribute_id_idx ON option_requests (attribute_id);
+CREATE INDEX IF NOT EXISTS option_requests_user_uuid_idx ON option_requests (user_uuid);
+
+-- +goose Down
+DROP INDEX IF EXISTS option_requests_user_uuid_idx;
+DROP INDEX IF EXISTS option_requests_attribute_id_idx;
+DROP INDEX IF EXISTS option_requests_status_created_at_idx;
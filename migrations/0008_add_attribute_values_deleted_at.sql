@@ -0,0 +1,7 @@
+-- +goose Up
+ALTER TABLE attribute_values
+    ADD COLUMN deleted_at TIMESTAMP;
+
+-- +goose Down
+ALTER TABLE attribute_values
+    DROP COLUMN IF EXISTS deleted_at;
@@ -0,0 +1,50 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION attribute_values_check_parent() RETURNS TRIGGER AS
+$$
+DECLARE
+    parent_attribute_id INT;
+BEGIN
+    IF NEW.parent_id IS NULL THEN
+        RETURN NEW;
+    END IF;
+
+    SELECT attribute_id INTO parent_attribute_id FROM attribute_values WHERE id = NEW.parent_id;
+    IF parent_attribute_id IS DISTINCT FROM NEW.attribute_id THEN
+        RAISE EXCEPTION 'parent % belongs to another attribute', NEW.parent_id
+            USING ERRCODE = 'check_violation', CONSTRAINT = 'attribute_values_parent_attribute_check';
+    END IF;
+
+    -- проверка цикла читает предков, поэтому перепривязки внутри атрибута выполняются по очереди: иначе две
+    -- встречные перепривязки не увидят друг друга и вместе создадут цикл. Ключ совпадает с блокировкой
+    -- атрибута в репозитории (attributeLockKey), чтобы они не взаимоблокировались
+    IF TG_OP = 'UPDATE' THEN
+        PERFORM pg_advisory_xact_lock(3, NEW.attribute_id);
+    END IF;
+
+    IF TG_OP = 'UPDATE' AND EXISTS (
+        WITH RECURSIVE ancestors AS (
+            SELECT id, parent_id FROM attribute_values WHERE id = NEW.parent_id
+            UNION
+            SELECT av.id, av.parent_id FROM attribute_values av JOIN ancestors a ON av.id = a.parent_id
+        )
+        SELECT 1 FROM ancestors WHERE id = NEW.id
+    ) THEN
+        RAISE EXCEPTION 'parent % would create a cycle', NEW.parent_id
+            USING ERRCODE = 'check_violation', CONSTRAINT = 'attribute_values_parent_cycle_check';
+    END IF;
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE TRIGGER attribute_values_check_parent
+    BEFORE INSERT OR UPDATE OF parent_id, attribute_id
+    ON attribute_values
+    FOR EACH ROW
+EXECUTE FUNCTION attribute_values_check_parent();
+
+-- +goose Down
+DROP TRIGGER IF EXISTS attribute_values_check_parent ON attribute_values;
+DROP FUNCTION IF EXISTS attribute_values_check_parent();
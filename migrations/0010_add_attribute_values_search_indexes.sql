@@ -0,0 +1,14 @@
+-- +goose Up
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE INDEX IF NOT EXISTS attribute_values_value_prefix_idx
+    ON attribute_values (attribute_id, lower(value) text_pattern_ops)
+    WHERE deleted_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS attribute_values_value_trgm_idx
+    ON attribute_values USING gin (lower(value) gin_trgm_ops)
+    WHERE deleted_at IS NULL;
+
+-- +goose Down
+DROP INDEX IF EXISTS attribute_values_value_trgm_idx;
+DROP INDEX IF EXISTS attribute_values_value_prefix_idx;
@@ -0,0 +1,17 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS outbox
+(
+    id          BIGSERIAL PRIMARY KEY,
+    topic       TEXT      NOT NULL,
+    message_key TEXT      NOT NULL,
+    payload     JSONB     NOT NULL,
+    attempts    INT       NOT NULL DEFAULT 0,
+    last_error  TEXT,
+    created_at  TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    sent_at     TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS outbox_pending_idx ON outbox (id) WHERE sent_at IS NULL;
+
+-- +goose Down
+DROP TABLE IF EXISTS outbox;
@@ -0,0 +1,20 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS audit_log
+(
+    id          BIGSERIAL PRIMARY KEY,
+    actor_uuid  TEXT      NOT NULL,
+    method      TEXT      NOT NULL,
+    entity_type TEXT      NOT NULL,
+    entity_id   BIGINT    NOT NULL,
+    before      JSONB,
+    after       JSONB,
+    request_id  TEXT      NOT NULL DEFAULT '',
+    created_at  TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS audit_log_entity_idx ON audit_log (entity_type, entity_id, id);
+CREATE INDEX IF NOT EXISTS audit_log_actor_idx ON audit_log (actor_uuid, id);
+CREATE INDEX IF NOT EXISTS audit_log_created_at_idx ON audit_log (created_at);
+
+-- +goose Down
+DROP TABLE IF EXISTS audit_log;
@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION attribute_values_notify() RETURNS TRIGGER AS
+$$
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        PERFORM pg_notify('attribute_values_changed', OLD.attribute_id::TEXT);
+        RETURN OLD;
+    END IF;
+
+    IF TG_OP = 'UPDATE' AND OLD.attribute_id IS DISTINCT FROM NEW.attribute_id THEN
+        PERFORM pg_notify('attribute_values_changed', OLD.attribute_id::TEXT);
+    END IF;
+
+    PERFORM pg_notify('attribute_values_changed', NEW.attribute_id::TEXT);
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE TRIGGER attribute_values_notify
+    AFTER INSERT OR UPDATE OR DELETE
+    ON attribute_values
+    FOR EACH ROW
+EXECUTE FUNCTION attribute_values_notify();
+
+-- +goose Down
+DROP TRIGGER IF EXISTS attribute_values_notify ON attribute_values;
+DROP FUNCTION IF EXISTS attribute_values_notify();
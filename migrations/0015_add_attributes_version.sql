@@ -0,0 +1,34 @@
+-- +goose Up
+ALTER TABLE attributes
+    ADD COLUMN version BIGINT NOT NULL DEFAULT 0;
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION attribute_values_bump_version() RETURNS TRIGGER AS
+$$
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        UPDATE attributes SET version = version + 1 WHERE id = OLD.attribute_id;
+        RETURN OLD;
+    END IF;
+
+    IF TG_OP = 'UPDATE' AND OLD.attribute_id IS DISTINCT FROM NEW.attribute_id THEN
+        UPDATE attributes SET version = version + 1 WHERE id = OLD.attribute_id;
+    END IF;
+
+    UPDATE attributes SET version = version + 1 WHERE id = NEW.attribute_id;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE TRIGGER attribute_values_bump_version
+    AFTER INSERT OR UPDATE OR DELETE
+    ON attribute_values
+    FOR EACH ROW
+EXECUTE FUNCTION attribute_values_bump_version();
+
+-- +goose Down
+DROP TRIGGER IF EXISTS attribute_values_bump_version ON attribute_values;
+DROP FUNCTION IF EXISTS attribute_values_bump_version();
+ALTER TABLE attributes
+    DROP COLUMN IF EXISTS version;
@@ -0,0 +1,82 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS attribute_value_changes
+(
+    attribute_id BIGINT      NOT NULL REFERENCES attributes (id) ON DELETE CASCADE,
+    version      BIGINT      NOT NULL,
+    option_id    BIGINT      NOT NULL,
+    operation    TEXT        NOT NULL CHECK (operation IN ('added', 'updated', 'deleted')),
+    value        TEXT        NOT NULL,
+    parent_id    BIGINT,
+    created_at   TIMESTAMP   NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (attribute_id, version)
+);
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION attribute_values_record_change(val attribute_values, op TEXT) RETURNS VOID AS
+$$
+DECLARE
+    new_version BIGINT;
+BEGIN
+    UPDATE attributes SET version = version + 1 WHERE id = val.attribute_id RETURNING version INTO new_version;
+    IF new_version IS NULL THEN
+        RETURN;
+    END IF;
+
+    INSERT INTO attribute_value_changes (attribute_id, version, option_id, operation, value, parent_id)
+    VALUES (val.attribute_id, new_version, val.id, op, val.value, val.parent_id);
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION attribute_values_bump_version() RETURNS TRIGGER AS
+$$
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        PERFORM attribute_values_record_change(OLD, 'deleted');
+        RETURN OLD;
+    END IF;
+
+    IF TG_OP = 'INSERT' THEN
+        PERFORM attribute_values_record_change(NEW, CASE WHEN NEW.deleted_at IS NULL THEN 'added' ELSE 'deleted' END);
+        RETURN NEW;
+    END IF;
+
+    IF OLD.attribute_id IS DISTINCT FROM NEW.attribute_id THEN
+        PERFORM attribute_values_record_change(OLD, 'deleted');
+        PERFORM attribute_values_record_change(NEW, CASE WHEN NEW.deleted_at IS NULL THEN 'added' ELSE 'deleted' END);
+    ELSIF OLD.deleted_at IS NULL AND NEW.deleted_at IS NOT NULL THEN
+        PERFORM attribute_values_record_change(NEW, 'deleted');
+    ELSIF OLD.deleted_at IS NOT NULL AND NEW.deleted_at IS NULL THEN
+        PERFORM attribute_values_record_change(NEW, 'added');
+    ELSE
+        PERFORM attribute_values_record_change(NEW, 'updated');
+    END IF;
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION attribute_values_bump_version() RETURNS TRIGGER AS
+$$
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        UPDATE attributes SET version = version + 1 WHERE id = OLD.attribute_id;
+        RETURN OLD;
+    END IF;
+
+    IF TG_OP = 'UPDATE' AND OLD.attribute_id IS DISTINCT FROM NEW.attribute_id THEN
+        UPDATE attributes SET version = version + 1 WHERE id = OLD.attribute_id;
+    END IF;
+
+    UPDATE attributes SET version = version + 1 WHERE id = NEW.attribute_id;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+DROP FUNCTION IF EXISTS attribute_values_record_change(attribute_values, TEXT);
+DROP TABLE IF EXISTS attribute_value_changes;
@@ -0,0 +1,53 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS attribute_translations
+(
+    attribute_id INT       NOT NULL REFERENCES attributes (id) ON DELETE CASCADE,
+    locale       TEXT      NOT NULL,
+    name         TEXT      NOT NULL,
+    updated_at   TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (attribute_id, locale)
+);
+
+CREATE TABLE IF NOT EXISTS attribute_value_translations
+(
+    option_id  INT       NOT NULL REFERENCES attribute_values (id) ON DELETE CASCADE,
+    locale     TEXT      NOT NULL,
+    value      TEXT      NOT NULL,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (option_id, locale)
+);
+
+-- перевод - тоже изменение значения: поднимаем версию атрибута и будим кэши и WatchAttribute
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION attribute_value_translations_changed() RETURNS TRIGGER AS
+$$
+DECLARE
+    changed attribute_values;
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        SELECT * INTO changed FROM attribute_values WHERE id = OLD.option_id;
+    ELSE
+        SELECT * INTO changed FROM attribute_values WHERE id = NEW.option_id;
+    END IF;
+
+    IF FOUND THEN
+        PERFORM attribute_values_record_change(changed, 'updated');
+        PERFORM pg_notify('attribute_values_changed', changed.attribute_id::TEXT);
+    END IF;
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE TRIGGER attribute_value_translations_changed
+    AFTER INSERT OR UPDATE OR DELETE
+    ON attribute_value_translations
+    FOR EACH ROW
+EXECUTE FUNCTION attribute_value_translations_changed();
+
+-- +goose Down
+DROP TRIGGER IF EXISTS attribute_value_translations_changed ON attribute_value_translations;
+DROP FUNCTION IF EXISTS attribute_value_translations_changed();
+DROP TABLE IF EXISTS attribute_value_translations;
+DROP TABLE IF EXISTS attribute_translations;
@@ -0,0 +1,45 @@
+-- +goose Up
+ALTER TABLE attribute_values
+    ADD COLUMN normalized_value TEXT;
+
+-- ключ существующих значений здесь не заполняется: SQL не повторяет model.NormalizeValue (case folding, NFC).
+-- Его проставляет сервис при старте (Service.NormalizeLegacyValues), сливая найденные дубли в самое старое значение.
+-- Строки без ключа в индексе не конфликтуют, поэтому индекс можно создать сразу
+CREATE UNIQUE INDEX IF NOT EXISTS attribute_values_normalized_uniq
+    ON attribute_values (attribute_id, COALESCE(parent_id, 0), normalized_value)
+    WHERE deleted_at IS NULL;
+
+-- заполнение normalized_value не меняет значение для клиентов: без списка колонок каждая строка при старте
+-- поднимала бы версию атрибута, писала "updated" в историю и будила подписчиков
+DROP TRIGGER IF EXISTS attribute_values_notify ON attribute_values;
+CREATE TRIGGER attribute_values_notify
+    AFTER INSERT OR DELETE OR UPDATE OF value, parent_id, deleted_at, attribute_id
+    ON attribute_values
+    FOR EACH ROW
+EXECUTE FUNCTION attribute_values_notify();
+
+DROP TRIGGER IF EXISTS attribute_values_bump_version ON attribute_values;
+CREATE TRIGGER attribute_values_bump_version
+    AFTER INSERT OR DELETE OR UPDATE OF value, parent_id, deleted_at, attribute_id
+    ON attribute_values
+    FOR EACH ROW
+EXECUTE FUNCTION attribute_values_bump_version();
+
+-- +goose Down
+DROP TRIGGER IF EXISTS attribute_values_bump_version ON attribute_values;
+CREATE TRIGGER attribute_values_bump_version
+    AFTER INSERT OR UPDATE OR DELETE
+    ON attribute_values
+    FOR EACH ROW
+EXECUTE FUNCTION attribute_values_bump_version();
+
+DROP TRIGGER IF EXISTS attribute_values_notify ON attribute_values;
+CREATE TRIGGER attribute_values_notify
+    AFTER INSERT OR UPDATE OR DELETE
+    ON attribute_values
+    FOR EACH ROW
+EXECUTE FUNCTION attribute_values_notify();
+
+DROP INDEX IF EXISTS attribute_values_normalized_uniq;
+ALTER TABLE attribute_values
+    DROP COLUMN IF EXISTS normalized_value;
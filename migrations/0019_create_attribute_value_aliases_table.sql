@@ -0,0 +1,27 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS attribute_value_aliases
+(
+    id               SERIAL PRIMARY KEY,
+    option_id        INT       NOT NULL REFERENCES attribute_values (id) ON DELETE CASCADE,
+    attribute_id     INT       NOT NULL REFERENCES attributes (id) ON DELETE CASCADE,
+    alias            TEXT      NOT NULL,
+    normalized_alias TEXT      NOT NULL,
+    created_at       TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- синоним однозначно указывает на значение в пределах атрибута, независимо от родителя.
+-- Синонимы есть только у неудалённых значений: сервис удаляет их вместе со значением, а при слиянии переносит на target
+CREATE UNIQUE INDEX IF NOT EXISTS attribute_value_aliases_uniq
+    ON attribute_value_aliases (attribute_id, normalized_alias);
+
+CREATE INDEX IF NOT EXISTS attribute_value_aliases_option_idx
+    ON attribute_value_aliases (option_id);
+
+CREATE INDEX IF NOT EXISTS attribute_value_aliases_prefix_idx
+    ON attribute_value_aliases (attribute_id, lower(alias) text_pattern_ops);
+
+CREATE INDEX IF NOT EXISTS attribute_value_aliases_trgm_idx
+    ON attribute_value_aliases USING gin (lower(alias) gin_trgm_ops);
+
+-- +goose Down
+DROP TABLE IF EXISTS attribute_value_aliases;
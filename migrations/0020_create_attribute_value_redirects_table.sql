@@ -0,0 +1,15 @@
+-- +goose Up
+-- после слияния значений старый id продолжает резолвиться в оставшееся значение.
+-- Цепочки не хранятся: при слиянии target в другое значение редиректы на него переписываются
+CREATE TABLE IF NOT EXISTS attribute_value_redirects
+(
+    source_id  INT PRIMARY KEY REFERENCES attribute_values (id) ON DELETE CASCADE,
+    target_id  INT       NOT NULL REFERENCES attribute_values (id) ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS attribute_value_redirects_target_idx
+    ON attribute_value_redirects (target_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS attribute_value_redirects;
@@ -0,0 +1,8 @@
+-- +goose Up
+-- relay передаёт content_type в заголовке сообщения, чтобы потребитель знал, как его декодировать
+ALTER TABLE outbox
+    ADD COLUMN content_type TEXT NOT NULL DEFAULT 'application/json';
+
+-- +goose Down
+ALTER TABLE outbox
+    DROP COLUMN IF EXISTS content_type;
@@ -0,0 +1,21 @@
+-- +goose Up
+-- заявки проверяются на дубли тем же ключом model.NormalizeValue, что и значения.
+-- Ключ существующих заявок проставляет сервис при старте (Service.NormalizeLegacyOptionRequests)
+ALTER TABLE option_requests
+    ADD COLUMN normalized_value TEXT;
+
+DROP INDEX IF EXISTS option_requests_pending_uniq;
+
+CREATE UNIQUE INDEX IF NOT EXISTS option_requests_pending_uniq
+    ON option_requests (attribute_id, COALESCE(parent_id, 0), normalized_value)
+    WHERE status = 'pending';
+
+-- +goose Down
+DROP INDEX IF EXISTS option_requests_pending_uniq;
+
+CREATE UNIQUE INDEX IF NOT EXISTS option_requests_pending_uniq
+    ON option_requests (attribute_id, lower(value), COALESCE(parent_id, 0))
+    WHERE status = 'pending';
+
+ALTER TABLE option_requests
+    DROP COLUMN IF EXISTS normalized_value;
@@ -0,0 +1,12 @@
+-- +goose Up
+-- relay забирает пачку в короткой транзакции и публикует её уже после коммита. Пока claimed_until не истёк,
+-- сообщения этого ключа не отдаются другим репликам; если реплика упала, пачку заберут после истечения срока
+ALTER TABLE outbox
+    ADD COLUMN claimed_until TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS outbox_pending_key_idx ON outbox (topic, message_key) WHERE sent_at IS NULL;
+
+-- +goose Down
+DROP INDEX IF EXISTS outbox_pending_key_idx;
+ALTER TABLE outbox
+    DROP COLUMN IF EXISTS claimed_until;
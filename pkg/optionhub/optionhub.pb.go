@@ -22,34 +22,4683 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// kind of values stored in the attribute
+type AttributeType int32
+
+const (
+	AttributeType_ATTRIBUTE_TYPE_UNSPECIFIED AttributeType = 0
+	// flat list of values without parents
+	AttributeType_ATTRIBUTE_TYPE_ENUM AttributeType = 1
+	// hierarchical values linked by parent_id
+	AttributeType_ATTRIBUTE_TYPE_TREE AttributeType = 2
+	// arbitrary text suggestions
+	AttributeType_ATTRIBUTE_TYPE_FREE_TEXT AttributeType = 3
+	// number or range of numbers, e.g. "18-25"
+	AttributeType_ATTRIBUTE_TYPE_NUMBER_RANGE AttributeType = 4
+	// date in YYYY-MM-DD format
+	AttributeType_ATTRIBUTE_TYPE_DATE AttributeType = 5
+	// "true" or "false"
+	AttributeType_ATTRIBUTE_TYPE_BOOLEAN AttributeType = 6
+)
+
+// Enum value maps for AttributeType.
+var (
+	AttributeType_name = map[int32]string{
+		0: "ATTRIBUTE_TYPE_UNSPECIFIED",
+		1: "ATTRIBUTE_TYPE_ENUM",
+		2: "ATTRIBUTE_TYPE_TREE",
+		3: "ATTRIBUTE_TYPE_FREE_TEXT",
+		4: "ATTRIBUTE_TYPE_NUMBER_RANGE",
+		5: "ATTRIBUTE_TYPE_DATE",
+		6: "ATTRIBUTE_TYPE_BOOLEAN",
+	}
+	AttributeType_value = map[string]int32{
+		"ATTRIBUTE_TYPE_UNSPECIFIED":  0,
+		"ATTRIBUTE_TYPE_ENUM":         1,
+		"ATTRIBUTE_TYPE_TREE":         2,
+		"ATTRIBUTE_TYPE_FREE_TEXT":    3,
+		"ATTRIBUTE_TYPE_NUMBER_RANGE": 4,
+		"ATTRIBUTE_TYPE_DATE":         5,
+		"ATTRIBUTE_TYPE_BOOLEAN":      6,
+	}
+)
+
+func (x AttributeType) Enum() *AttributeType {
+	p := new(AttributeType)
+	*p = x
+	return p
+}
+
+func (x AttributeType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AttributeType) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_optionhub_proto_enumTypes[0].Descriptor()
+}
+
+func (AttributeType) Type() protoreflect.EnumType {
+	return &file_api_optionhub_proto_enumTypes[0]
+}
+
+func (x AttributeType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AttributeType.Descriptor instead.
+func (AttributeType) EnumDescriptor() ([]byte, []int) {
+	return file_api_optionhub_proto_rawDescGZIP(), []int{0}
+}
+
+// kind of the message sent by WatchAttribute
+type WatchEventType int32
+
+const (
+	WatchEventType_WATCH_EVENT_TYPE_UNSPECIFIED WatchEventType = 0
+	// full tree of the attribute values, replaces everything known to the client
+	WatchEventType_WATCH_EVENT_TYPE_SNAPSHOT      WatchEventType = 1
+	WatchEventType_WATCH_EVENT_TYPE_VALUE_ADDED   WatchEventType = 2
+	WatchEventType_WATCH_EVENT_TYPE_VALUE_UPDATED WatchEventType = 3
+	WatchEventType_WATCH_EVENT_TYPE_VALUE_DELETED WatchEventType = 4
+)
+
+// Enum value maps for WatchEventType.
+var (
+	WatchEventType_name = map[int32]string{
+		0: "WATCH_EVENT_TYPE_UNSPECIFIED",
+		1: "WATCH_EVENT_TYPE_SNAPSHOT",
+		2: "WATCH_EVENT_TYPE_VALUE_ADDED",
+		3: "WATCH_EVENT_TYPE_VALUE_UPDATED",
+		4: "WATCH_EVENT_TYPE_VALUE_DELETED",
+	}
+	WatchEventType_value = map[string]int32{
+		"WATCH_EVENT_TYPE_UNSPECIFIED":   0,
+		"WATCH_EVENT_TYPE_SNAPSHOT":      1,
+		"WATCH_EVENT_TYPE_VALUE_ADDED":   2,
+		"WATCH_EVENT_TYPE_VALUE_UPDATED": 3,
+		"WATCH_EVENT_TYPE_VALUE_DELETED": 4,
+	}
+)
+
+func (x WatchEventType) Enum() *WatchEventType {
+	p := new(WatchEventType)
+	*p = x
+	return p
+}
+
+func (x WatchEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WatchEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_optionhub_proto_enumTypes[1].Descriptor()
+}
+
+func (WatchEventType) Type() protoreflect.EnumType {
+	return &file_api_optionhub_proto_enumTypes[1]
+}
+
+func (x WatchEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WatchEventType.Descriptor instead.
+func (WatchEventType) EnumDescriptor() ([]byte, []int) {
+	return file_api_optionhub_proto_rawDescGZIP(), []int{1}
+}
+
+type ImportFormat int32
+
+const (
+	ImportFormat_IMPORT_FORMAT_UNSPECIFIED ImportFormat = 0
+	// csv with a header and a "path" column, e.g. "Россия/Москва/Курьяново"; "/" and "\\" inside values are escaped with "\\"
+	ImportFormat_IMPORT_FORMAT_CSV ImportFormat = 1
+	// json array of {"value": "...", "children": [...]}
+	ImportFormat_IMPORT_FORMAT_JSON ImportFormat = 2
+)
+
+// Enum value maps for ImportFormat.
+var (
+	ImportFormat_name = map[int32]string{
+		0: "IMPORT_FORMAT_UNSPECIFIED",
+		1: "IMPORT_FORMAT_CSV",
+		2: "IMPORT_FORMAT_JSON",
+	}
+	ImportFormat_value = map[string]int32{
+		"IMPORT_FORMAT_UNSPECIFIED": 0,
+		"IMPORT_FORMAT_CSV":         1,
+		"IMPORT_FORMAT_JSON":        2,
+	}
+)
+
+func (x ImportFormat) Enum() *ImportFormat {
+	p := new(ImportFormat)
+	*p = x
+	return p
+}
+
+func (x ImportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_optionhub_proto_enumTypes[2].Descriptor()
+}
+
+func (ImportFormat) Type() protoreflect.EnumType {
+	return &file_api_optionhub_proto_enumTypes[2]
+}
+
+func (x ImportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImportFormat.Descriptor instead.
+func (ImportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_api_optionhub_proto_rawDescGZIP(), []int{2}
+}
+
+type ImportRowStatus int32
+
+const (
+	ImportRowStatus_IMPORT_ROW_STATUS_UNSPECIFIED ImportRowStatus = 0
+	// value will be or was created
+	ImportRowStatus_IMPORT_ROW_STATUS_CREATED ImportRowStatus = 1
+	// value already exists
+	ImportRowStatus_IMPORT_ROW_STATUS_SKIPPED ImportRowStatus = 2
+	// value cannot be imported, see message
+	ImportRowStatus_IMPORT_ROW_STATUS_CONFLICT ImportRowStatus = 3
+)
+
+// Enum value maps for ImportRowStatus.
+var (
+	ImportRowStatus_name = map[int32]string{
+		0: "IMPORT_ROW_STATUS_UNSPECIFIED",
+		1: "IMPORT_ROW_STATUS_CREATED",
+		2: "IMPORT_ROW_STATUS_SKIPPED",
+		3: "IMPORT_ROW_STATUS_CONFLICT",
+	}
+	ImportRowStatus_value = map[string]int32{
+		"IMPORT_ROW_STATUS_UNSPECIFIED": 0,
+		"IMPORT_ROW_STATUS_CREATED":     1,
+		"IMPORT_ROW_STATUS_SKIPPED":     2,
+		"IMPORT_ROW_STATUS_CONFLICT":    3,
+	}
+)
+
+func (x ImportRowStatus) Enum() *ImportRowStatus {
+	p := new(ImportRowStatus)
+	*p = x
+	return p
+}
+
+func (x ImportRowStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImportRowStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_optionhub_proto_enumTypes[3].Descriptor()
+}
+
+func (ImportRowStatus) Type() protoreflect.EnumType {
+	return &file_api_optionhub_proto_enumTypes[3]
+}
+
+func (x ImportRowStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImportRowStatus.Descriptor instead.
+func (ImportRowStatus) EnumDescriptor() ([]byte, []int) {
+	return file_api_optionhub_proto_rawDescGZIP(), []int{3}
+}
+
+type ExportFormat int32
+
+const (
+	ExportFormat_EXPORT_FORMAT_UNSPECIFIED ExportFormat = 0
+	// {"attributes": [{"id", "name", "type", "values": [{"id", "value", "children": [...]}]}]}
+	ExportFormat_EXPORT_FORMAT_JSON ExportFormat = 1
+	// csv with columns attribute_id, attribute, type, option_id, path, deleted; path is compatible with import
+	ExportFormat_EXPORT_FORMAT_CSV ExportFormat = 2
+	// same structure as json
+	ExportFormat_EXPORT_FORMAT_YAML ExportFormat = 3
+)
+
+// Enum value maps for ExportFormat.
+var (
+	ExportFormat_name = map[int32]string{
+		0: "EXPORT_FORMAT_UNSPECIFIED",
+		1: "EXPORT_FORMAT_JSON",
+		2: "EXPORT_FORMAT_CSV",
+		3: "EXPORT_FORMAT_YAML",
+	}
+	ExportFormat_value = map[string]int32{
+		"EXPORT_FORMAT_UNSPECIFIED": 0,
+		"EXPORT_FORMAT_JSON":        1,
+		"EXPORT_FORMAT_CSV":         2,
+		"EXPORT_FORMAT_YAML":        3,
+	}
+)
+
+func (x ExportFormat) Enum() *ExportFormat {
+	p := new(ExportFormat)
+	*p = x
+	return p
+}
+
+func (x ExportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_optionhub_proto_enumTypes[4].Descriptor()
+}
+
+func (ExportFormat) Type() protoreflect.EnumType {
+	return &file_api_optionhub_proto_enumTypes[4]
+}
+
+func (x ExportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExportFormat.Descriptor instead.
+func (ExportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_api_optionhub_proto_rawDescGZIP(), []int{4}
+}
+
+// moderation status of the option request
+type OptionRequestStatus int32
+
+const (
+	OptionRequestStatus_OPTION_REQUEST_STATUS_UNSPECIFIED OptionRequestStatus = 0
+	// waiting for moderator
+	OptionRequestStatus_OPTION_REQUEST_STATUS_PENDING OptionRequestStatus = 1
+	// new value was created from the request
+	OptionRequestStatus_OPTION_REQUEST_STATUS_APPROVED OptionRequestStatus = 2
+	// request was declined
+	OptionRequestStatus_OPTION_REQUEST_STATUS_REJECTED OptionRequestStatus = 3
+	// request was mapped to an existing value
+	OptionRequestStatus_OPTION_REQUEST_STATUS_MERGED OptionRequestStatus = 4
+)
+
+// Enum value maps for OptionRequestStatus.
+var (
+	OptionRequestStatus_name = map[int32]string{
+		0: "OPTION_REQUEST_STATUS_UNSPECIFIED",
+		1: "OPTION_REQUEST_STATUS_PENDING",
+		2: "OPTION_REQUEST_STATUS_APPROVED",
+		3: "OPTION_REQUEST_STATUS_REJECTED",
+		4: "OPTION_REQUEST_STATUS_MERGED",
+	}
+	OptionRequestStatus_value = map[string]int32{
+		"OPTION_REQUEST_STATUS_UNSPECIFIED": 0,
+		"OPTION_REQUEST_STATUS_PENDING":     1,
+		"OPTION_REQUEST_STATUS_APPROVED":    2,
+		"OPTION_REQUEST_STATUS_REJECTED":    3,
+		"OPTION_REQUEST_STATUS_MERGED":      4,
+	}
+)
+
+func (x OptionRequestStatus) Enum() *OptionRequestStatus {
+	p := new(OptionRequestStatus)
+	*p = x
+	return p
+}
+
+func (x OptionRequestStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OptionRequestStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_optionhub_proto_enumTypes[5].Descriptor()
+}
+
+func (OptionRequestStatus) Type() protoreflect.EnumType {
+	return &file_api_optionhub_proto_enumTypes[5]
+}
+
+func (x OptionRequestStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OptionRequestStatus.Descriptor instead.
+func (OptionRequestStatus) EnumDescriptor() ([]byte, []int) {
+	return file_api_optionhub_proto_rawDescGZIP(), []int{5}
+}
+
+// field to sort option requests by
+type OptionRequestSortField int32
+
+const (
+	OptionRequestSortField_OPTION_REQUEST_SORT_FIELD_ID         OptionRequestSortField = 0
+	OptionRequestSortField_OPTION_REQUEST_SORT_FIELD_CREATED_AT OptionRequestSortField = 1
+)
+
+// Enum value maps for OptionRequestSortField.
+var (
+	OptionRequestSortField_name = map[int32]string{
+		0: "OPTION_REQUEST_SORT_FIELD_ID",
+		1: "OPTION_REQUEST_SORT_FIELD_CREATED_AT",
+	}
+	OptionRequestSortField_value = map[string]int32{
+		"OPTION_REQUEST_SORT_FIELD_ID":         0,
+		"OPTION_REQUEST_SORT_FIELD_CREATED_AT": 1,
+	}
+)
+
+func (x OptionRequestSortField) Enum() *OptionRequestSortField {
+	p := new(OptionRequestSortField)
+	*p = x
+	return p
+}
+
+func (x OptionRequestSortField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OptionRequestSortField) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_optionhub_proto_enumTypes[6].Descriptor()
+}
+
+func (OptionRequestSortField) Type() protoreflect.EnumType {
+	return &file_api_optionhub_proto_enumTypes[6]
+}
+
+func (x OptionRequestSortField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OptionRequestSortField.Descriptor instead.
+func (OptionRequestSortField) EnumDescriptor() ([]byte, []int) {
+	return file_api_optionhub_proto_rawDescGZIP(), []int{6}
+}
+
+// kind of the entity changed by an audited call
+type AuditEntityType int32
+
+const (
+	AuditEntityType_AUDIT_ENTITY_TYPE_UNSPECIFIED     AuditEntityType = 0
+	AuditEntityType_AUDIT_ENTITY_TYPE_ATTRIBUTE       AuditEntityType = 1
+	AuditEntityType_AUDIT_ENTITY_TYPE_ATTRIBUTE_VALUE AuditEntityType = 2
+	AuditEntityType_AUDIT_ENTITY_TYPE_OPTION_REQUEST  AuditEntityType = 3
+)
+
+// Enum value maps for AuditEntityType.
+var (
+	AuditEntityType_name = map[int32]string{
+		0: "AUDIT_ENTITY_TYPE_UNSPECIFIED",
+		1: "AUDIT_ENTITY_TYPE_ATTRIBUTE",
+		2: "AUDIT_ENTITY_TYPE_ATTRIBUTE_VALUE",
+		3: "AUDIT_ENTITY_TYPE_OPTION_REQUEST",
+	}
+	AuditEntityType_value = map[string]int32{
+		"AUDIT_ENTITY_TYPE_UNSPECIFIED":     0,
+		"AUDIT_ENTITY_TYPE_ATTRIBUTE":       1,
+		"AUDIT_ENTITY_TYPE_ATTRIBUTE_VALUE": 2,
+		"AUDIT_ENTITY_TYPE_OPTION_REQUEST":  3,
+	}
+)
+
+func (x AuditEntityType) Enum() *AuditEntityType {
+	p := new(AuditEntityType)
+	*p = x
+	return p
+}
+
+func (x AuditEntityType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AuditEntityType) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_optionhub_proto_enumTypes[7].Descriptor()
+}
+
+func (AuditEntityType) Type() protoreflect.EnumType {
+	return &file_api_optionhub_proto_enumTypes[7]
+}
+
+func (x AuditEntityType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AuditEntityType.Descriptor instead.
+func (AuditEntityType) EnumDescriptor() ([]byte, []int) {
+	return file_api_optionhub_proto_rawDescGZIP(), []int{7}
+}
+
+// kind of the catalog change, tells which payload of CatalogEvent is set
+type CatalogEventType int32
+
+const (
+	CatalogEventType_CATALOG_EVENT_TYPE_UNSPECIFIED             CatalogEventType = 0
+	CatalogEventType_CATALOG_EVENT_TYPE_ATTRIBUTE_CREATED       CatalogEventType = 1
+	CatalogEventType_CATALOG_EVENT_TYPE_ATTRIBUTE_VALUE_ADDED   CatalogEventType = 2
+	CatalogEventType_CATALOG_EVENT_TYPE_ATTRIBUTE_VALUE_UPDATED CatalogEventType = 3
+	CatalogEventType_CATALOG_EVENT_TYPE_ATTRIBUTE_VALUE_DELETED CatalogEventType = 4
+	CatalogEventType_CATALOG_EVENT_TYPE_OPTION_REQUEST_APPROVED CatalogEventType = 5
+	CatalogEventType_CATALOG_EVENT_TYPE_OPTION_REQUEST_REJECTED CatalogEventType = 6
+	CatalogEventType_CATALOG_EVENT_TYPE_OPTION_REQUEST_MERGED   CatalogEventType = 7
+	CatalogEventType_CATALOG_EVENT_TYPE_ATTRIBUTE_VALUE_MERGED  CatalogEventType = 8
+	CatalogEventType_CATALOG_EVENT_TYPE_ATTRIBUTE_UPDATED       CatalogEventType = 9
+	CatalogEventType_CATALOG_EVENT_TYPE_ATTRIBUTE_DELETED       CatalogEventType = 10
+)
+
+// Enum value maps for CatalogEventType.
+var (
+	CatalogEventType_name = map[int32]string{
+		0:  "CATALOG_EVENT_TYPE_UNSPECIFIED",
+		1:  "CATALOG_EVENT_TYPE_ATTRIBUTE_CREATED",
+		2:  "CATALOG_EVENT_TYPE_ATTRIBUTE_VALUE_ADDED",
+		3:  "CATALOG_EVENT_TYPE_ATTRIBUTE_VALUE_UPDATED",
+		4:  "CATALOG_EVENT_TYPE_ATTRIBUTE_VALUE_DELETED",
+		5:  "CATALOG_EVENT_TYPE_OPTION_REQUEST_APPROVED",
+		6:  "CATALOG_EVENT_TYPE_OPTION_REQUEST_REJECTED",
+		7:  "CATALOG_EVENT_TYPE_OPTION_REQUEST_MERGED",
+		8:  "CATALOG_EVENT_TYPE_ATTRIBUTE_VALUE_MERGED",
+		9:  "CATALOG_EVENT_TYPE_ATTRIBUTE_UPDATED",
+		10: "CATALOG_EVENT_TYPE_ATTRIBUTE_DELETED",
+	}
+	CatalogEventType_value = map[string]int32{
+		"CATALOG_EVENT_TYPE_UNSPECIFIED":             0,
+		"CATALOG_EVENT_TYPE_ATTRIBUTE_CREATED":       1,
+		"CATALOG_EVENT_TYPE_ATTRIBUTE_VALUE_ADDED":   2,
+		"CATALOG_EVENT_TYPE_ATTRIBUTE_VALUE_UPDATED": 3,
+		"CATALOG_EVENT_TYPE_ATTRIBUTE_VALUE_DELETED": 4,
+		"CATALOG_EVENT_TYPE_OPTION_REQUEST_APPROVED": 5,
+		"CATALOG_EVENT_TYPE_OPTION_REQUEST_REJECTED": 6,
+		"CATALOG_EVENT_TYPE_OPTION_REQUEST_MERGED":   7,
+		"CATALOG_EVENT_TYPE_ATTRIBUTE_VALUE_MERGED":  8,
+		"CATALOG_EVENT_TYPE_ATTRIBUTE_UPDATED":       9,
+		"CATALOG_EVENT_TYPE_ATTRIBUTE_DELETED":       10,
+	}
+)
+
+func (x CatalogEventType) Enum() *CatalogEventType {
+	p := new(CatalogEventType)
+	*p = x
+	return p
+}
+
+func (x CatalogEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CatalogEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_optionhub_proto_enumTypes[8].Descriptor()
+}
+
+func (CatalogEventType) Type() protoreflect.EnumType {
+	return &file_api_optionhub_proto_enumTypes[8]
+}
+
+func (x CatalogEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CatalogEventType.Descriptor instead.
+func (CatalogEventType) EnumDescriptor() ([]byte, []int) {
+	return file_api_optionhub_proto_rawDescGZIP(), []int{8}
+}
+
+type Attribute struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id of the attribute
+	AttributeId int64 `protobuf:"varint,1,opt,name=attribute_id,json=attributeId,proto3" json:"attribute_id,omitempty"`
+	// name of the attribute (os, city, hobby, etc.)
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// kind of values stored in the attribute
+	Type AttributeType `protobuf:"varint,3,opt,name=type,proto3,enum=AttributeType" json:"type,omitempty"`
+	// time of create attribute
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// version of the attribute values, grows on every value change
+	Version int64 `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
+	// translations of the name by locale
+	Translations map[string]string `protobuf:"bytes,6,rep,name=translations,proto3" json:"translations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *Attribute) Reset() {
+	*x = Attribute{}
+	mi := &file_api_optionhub_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Attribute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Attribute) ProtoMessage() {}
+
+func (x *Attribute) ProtoReflect() protoreflect.Message {
+	mi := &file_api_optionhub_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Attribute.ProtoReflect.Descriptor instead.
+func (*Attribute) Descriptor() ([]byte, []int) {
+	return file_api_optionhub_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Attribute) GetAttributeId() int64 {
+	if x != nil {
+		return x.AttributeId
+	}
+	return 0
+}
+
+func (x *Attribute) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Attribute) GetType() AttributeType {
+	if x != nil {
+		return x.Type
+	}
+	return AttributeType_ATTRIBUTE_TYPE_UNSPECIFIED
+}
+
+func (x *Attribute) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Attribute) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Attribute) GetTranslations() map[string]string {
+	if x != nil {
+		return x.Translations
+	}
+	return nil
+}
+
+type CreateAttributeIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// name of the new attribute
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// kind of values stored in the attribute
+	Type AttributeType `protobuf:"varint,2,opt,name=type,proto3,enum=AttributeType" json:"type,omitempty"`
+}
+
+func (x *CreateAttributeIn) Reset() {
+	*x = CreateAttributeIn{}
+	mi := &file_api_optionhub_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAttributeIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAttributeIn) ProtoMessage() {}
+
+func (x *CreateAttributeIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_optionhub_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAttributeIn.ProtoReflect.Descriptor instead.
+func (*CreateAttributeIn) Descriptor() ([]byte, []int) {
+	return file_api_optionhub_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateAttributeIn) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateAttributeIn) GetType() AttributeType {
+	if x != nil {
+		return x.Type
+	}
+	return AttributeType_ATTRIBUTE_TYPE_UNSPECIFIED
+}
+
+type CreateAttributeOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id of the created attribute
+	AttributeId int64 `protobuf:"varint,1,opt,name=attribute_id,json=attributeId,proto3" json:"attribute_id,omitempty"`
+}
+
+func (x *CreateAttributeOut) Reset() {
+	*x = CreateAttributeOut{}
+	mi := &file_api_optionhub_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAttributeOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAttributeOut) ProtoMessage() {}
+
+func (x *CreateAttributeOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_optionhub_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAttributeOut.ProtoReflect.Descriptor instead.
+func (*CreateAttributeOut) Descriptor() ([]byte, []int) {
+	return file_api_optionhub_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateAttributeOut) GetAttributeId() int64 {
+	if x != nil {
+		return x.AttributeId
+	}
+	return 0
+}
+
+type GetAttributeIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id of the attribute
+	AttributeId int64 `protobuf:"varint,1,opt,name=attribute_id,json=attributeId,proto3" json:"attribute_id,omitempty"`
+	// preferred locales separated by commas, e.g. "kk-KZ,ru"; each locale falls back to its language, then to the original text
+	Locale string `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale,omitempty"`
+}
+
+func (x *GetAttributeIn) Reset() {
+	*x = GetAttributeIn{}
+	mi := &file_api_optionhub_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAttributeIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttributeIn) ProtoMessage() {}
+
+func (x *GetAttributeIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_optionhub_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAttributeIn.ProtoReflect.Descriptor instead.
+func (*GetAttributeIn) Descriptor() ([]byte, []int) {
+	return file_api_optionhub_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetAttributeIn) GetAttributeId() int64 {
+	if x != nil {
+		return x.AttributeId
+	}
+	return 0
+}
+
+func (x *GetAttributeIn) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+type ListAttributesIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// preferred locales separated by commas, e.g. "kk-KZ,ru"; each locale falls back to its language, then to the original text
+	Locale string `protobuf:"bytes,1,opt,name=locale,proto3" json:"locale,omitempty"`
+}
+
+func (x *ListAttributesIn) Reset() {
+	*x = ListAttributesIn{}
+	mi := &file_api_optionhub_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAttributesIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAttributesIn) ProtoMessage() {}
+
+func (x *ListAttributesIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_optionhub_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAttributesIn.ProtoReflect.Descriptor instead.
+func (*ListAttributesIn) Descriptor() ([]byte, []int) {
+	return file_api_optionhub_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListAttributesIn) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+type ListAttributesOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// all attributes sorted by id
+	Attributes []*Attribute `protobuf:"bytes,1,rep,name=attributes,proto3" json:"attributes,omitempty"`
+}
+
+func (x *ListAttributesOut) Reset() {
+	*x = ListAttributesOut{}
+	mi := &file_api_optionhub_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAttributesOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAttributesOut) ProtoMessage() {}
+
+func (x *ListAttributesOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_optionhub_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAttributesOut.ProtoReflect.Descriptor instead.
+func (*ListAttributesOut) Descriptor() ([]byte, []int) {
+	return file_api_optionhub_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListAttributesOut) GetAttributes() []*Attribute {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+type UpdateAttributeIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id of the attribute
+	AttributeId int64 `protobuf:"varint,1,opt,name=attribute_id,json=attributeId,proto3" json:"attribute_id,omitempty"`
+	// new name of the attribute
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *UpdateAttributeIn) Reset() {
+	*x = UpdateAttributeIn{}
+	mi := &file_api_optionhub_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAttributeIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAttributeIn) ProtoMessage() {}
+
+func (x *UpdateAttributeIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_optionhub_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAttributeIn.ProtoReflect.Descriptor instead.
+func (*UpdateAttributeIn) Descriptor() ([]byte, []int) {
+	return file_api_optionhub_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdateAttributeIn) GetAttributeId() int64 {
+	if x != nil {
+		return x.AttributeId
+	}
+	return 0
+}
+
+func (x *UpdateAttributeIn) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DeleteAttributeIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id of the attribute; an attribute with values, including deleted ones, cannot be deleted
+	AttributeId int64 `protobuf:"varint,1,opt,name=attribute_id,json=attributeId,proto3" json:"attribute_id,omitempty"`
+}
+
+func (x *DeleteAttributeIn) Reset() {
+	*x = DeleteAttributeIn{}
+	mi := &file_api_optionhub_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAttributeIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAttributeIn) ProtoMessage() {}
+
+func (x *DeleteAttributeIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_optionhub_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAttributeIn.ProtoReflect.Descriptor instead.
+func (*DeleteAttributeIn) Descriptor() ([]byte, []int) {
+	return file_api_optionhub_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteAttributeIn) GetAttributeId() int64 {
+	if x != nil {
+		return x.AttributeId
+	}
+	return 0
+}
+
 type Option struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// id of the attribute option
+	// id of the attribute option
+	OptionId int64 `protobuf:"varint,1,opt,name=option_id,json=optionId,proto3" json:"option_id,omitempty"`
+	// value of the attribute option
+	OptionValue string `protobuf:"bytes,2,opt,name=option_value,json=optionValue,proto3" json:"option_value,omitempty"`
+	// option that inherits from this option
+	Children []*Option `protobuf:"bytes,3,rep,name=children,proto3" json:"children,omitempty"`
+	// option was deleted and is kept only for existing references
+	IsDeleted bool `protobuf:"varint,4,opt,name=is_deleted,json=isDeleted,proto3" json:"is_deleted,omitempty"`
+	// option has children that were not loaded, filled by GetSubtree and GetChildren
+	HasChildren bool `protobuf:"varint,5,opt,name=has_children,json=hasChildren,proto3" json:"has_children,omitempty"`
+	// translations of the value by locale
+	Translations map[string]string `protobuf:"bytes,6,rep,name=translations,proto3" json:"translations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *Option) Reset() {
+	*x = Option{}
+	mi := &file_api_optionhub_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Option) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Option) ProtoMessage() {}
+
+func (x *Option) ProtoReflect() protoreflect.Message {
+	mi := &file_api_optionhub_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Option.ProtoReflect.Descriptor instead.
+func (*Option) Descriptor() ([]byte, []int) {
+	return file_api_optionhub_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Option) GetOptionId() int64 {
+	if x != nil {
+		return x.OptionId
+	}
+	return 0
+}
+
+func (x *Option) GetOptionValue() string {
+	if x != nil {
+		return x.OptionValue
+	}
+	return ""
+}
+
+func (x *Option) GetChildren() []*Option {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+func (x *Option) GetIsDeleted() bool {
+	if x != nil {
+		return x.IsDeleted
+	}
+	return false
+}
+
+func (x *Option) GetHasChildren() bool {
+	if x != nil {
+		return x.HasChildren
+	}
+	return false
+}
+
+func (x *Option) GetTranslations() map[string]string {
+	if x != nil {
+		return x.Translations
+	}
+	return nil
+}
+
+type GetAttributeValuesIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id of the attribute
+	AttributeId int64 `protobuf:"varint,1,opt,name=attribute_id,json=attributeId,proto3" json:"attribute_id,omitempty"`
+	// return deleted options as well
+	IncludeDeleted bool `protobuf:"varint,2,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"`
+	// version known to the client; option_list is omitted if it is still current
+	IfVersion *int64 `protobuf:"varint,3,opt,name=if_version,json=ifVersion,proto3,oneof" json:"if_version,omitempty"`
+	// preferred locales separated by commas, e.g. "kk-KZ,ru"; each locale falls back to its language, then to the original text
+	Locale string `protobuf:"bytes,4,opt,name=locale,proto3" json:"locale,omitempty"`
+}
+
+func (x *GetAttributeValuesIn) Reset() {
+	*x = GetAttributeValuesIn{}
+	mi := &file_api_optionhub_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAttributeValuesIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttributeValuesIn) ProtoMessage() {}
+
+func (x *GetAttributeValuesIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_optionhub_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAttributeValuesIn.ProtoReflect.Descriptor instead.
+func (*GetAttributeValuesIn) Descriptor() ([]byte, []int) {
+	return file_api_optionhub_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetAttributeValuesIn) GetAttributeId() int64 {
+	if x != nil {
+		return x.AttributeId
+	}
+	return 0
+}
+
+func (x *GetAttributeValuesIn) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+func (x *GetAttributeValuesIn) GetIfVersion() int64 {
+	if x != nil && x.IfVersion != nil {
+		return *x.IfVersion
+	}
+	return 0
+}
+
+func (x *GetAttributeValuesIn) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+type GetAttributeValuesOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// attribute values trees
+	OptionList []*Option `protobuf:"bytes,1,rep,name=option_list,json=optionList,proto3" json:"option_list,omitempty"`
+	// current version of the attribute values
+	Version int64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// true if if_version matches the current version
+	NotModified bool `protobuf:"varint,3,opt,name=not_modified,json=notModified,proto3" json:"not_modified,omitempty"`
+}
+
+func (x *GetAttributeValuesOut) Reset() {
+	*x = GetAttributeValuesOut{}
+	mi := &file_api_optionhub_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAttributeValuesOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttributeValuesOut) ProtoMessage() {}
+
+func (x *GetAttributeValuesOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_optionhub_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAttributeValuesOut.ProtoReflect.Descriptor instead.
+func (*GetAttributeValuesOut) Descriptor() ([]byte, []int) {
+	return file_api_optionhub_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetAttributeValuesOut) GetOptionList() []*Option {
+	if x != nil {
+		return x.OptionList
+	}
+	return nil
+}
+
+func (x *GetAttributeValuesOut) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *GetAttributeValuesOut) GetNotModified() bool {
+	if x != nil {
+		return x.NotModified
+	}
+	return false
+}
+
+type WatchAttributeIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id of the attribute
+	AttributeId int64 `protobuf:"varint,1,opt,name=attribute_id,json=attributeId,proto3" json:"attribute_id,omitempty"`
+	// last version received by the client; without it the stream starts with a snapshot
+	FromVersion *int64 `protobuf:"varint,2,opt,name=from_version,json=fromVersion,proto3,oneof" json:"from_version,omitempty"`
+	// preferred locales separated by commas, e.g. "kk-KZ,ru"; each locale falls back to its language, then to the original text
+	Locale string `protobuf:"bytes,3,opt,name=locale,proto3" json:"locale,omitempty"`
+}
+
+func (x *WatchAttributeIn) Reset() {
+	*x = WatchAttributeIn{}
+	mi := &file_api_optionhub_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchAttributeIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchAttributeIn) ProtoMessage() {}
+
+func (x *WatchAttributeIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_optionhub_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchAttributeIn.ProtoReflect.Descriptor instead.
+func (*WatchAttributeIn) Descriptor() ([]byte, []int) {
+	return file_api_optionhub_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *WatchAttributeIn) GetAttributeId() int64 {
+	if x != nil {
+		return x.AttributeId
+	}
+	return 0
+}
+
+func (x *WatchAttributeIn) GetFromVersion() int64 {
+	if x != nil && x.FromVersion != nil {
+		return *x.FromVersion
+	}
+	return 0
+}
+
+func (x *WatchAttributeIn) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+// snapshot or single value change. Changes should be applied by option_id:
+// a change may repeat what the client already has
+type WatchAttributeOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// kind of the message
+	Type WatchEventType `protobuf:"varint,1,opt,name=type,proto3,enum=WatchEventType" json:"type,omitempty"`
+	// version of the attribute values after this message
+	Version int64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// attribute values trees, filled for snapshot
+	Snapshot []*Option `protobuf:"bytes,3,rep,name=snapshot,proto3" json:"snapshot,omitempty"`
+	// id of the changed value
+	OptionId int64 `protobuf:"varint,4,opt,name=option_id,json=optionId,proto3" json:"option_id,omitempty"`
+	// value after the change
+	Value string `protobuf:"bytes,5,opt,name=value,proto3" json:"value,omitempty"`
+	// id of the parent value after the change
+	ParentId *int64 `protobuf:"varint,6,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id,omitempty"`
+	// time of the change
+	OccurredAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+}
+
+func (x *WatchAttributeOut) Reset() {
+	*x = WatchAttributeOut{}
+	mi := &file_api_optionhub_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchAttributeOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchAttributeOut) ProtoMessage() {}
+
+func (x *WatchAttributeOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_optionhub_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchAttributeOut.ProtoReflect.Descriptor instead.
+func (*WatchAttributeOut) Descriptor() ([]byte, []int) {
+	return file_api_optionhub_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *WatchAttributeOut) GetType() WatchEventType {
+	if x != nil {
+		return x.Type
+	}
+	return WatchEventType_WATCH_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *WatchAttributeOut) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *WatchAttributeOut) GetSnapshot() []*Option {
+	if x != nil {
+		return x.Snapshot
+	}
+	return nil
+}
+
+func (x *WatchAttributeOut) GetOptionId() int64 {
+	if x != nil {
+		return x.OptionId
+	}
+	return 0
+}
+
+func (x *WatchAttributeOut) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *WatchAttributeOut) GetParentId() int64 {
+	if x != nil && x.ParentId != nil {
+		return *x.ParentId
+	}
+	return 0
+}
+
+func (x *WatchAttributeOut) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+// message request
+type AddAttributeValueIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id of the row in the db
+	AttributeId int64  `protobuf:"varint,1,opt,name=attribute_id,json=attributeId,proto3" json:"attribute_id,omitempty"`
+	Value       string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	ParentId    *int64 `protobuf:"varint,3,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id,omitempty"`
+}
+
+func (x *AddAttributeValueIn) Reset() {
+	*x = AddAttributeValueIn{}
+	mi := &file_api_optionhub_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddAttributeValueIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddAttributeValueIn) ProtoMessage() {}
+
+func (x *AddAttributeValueIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_optionhub_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddAttributeValueIn.ProtoReflect.Descriptor instead.
+func (*AddAttributeValueIn) Descriptor() ([]byte, []int) {
+	return file_api_optionhub_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *AddAttributeValueIn) GetAttributeId() int64 {
+	if x != nil {
+		return x.AttributeId
+	}
+	return 0
+}
+
+func (x *AddAttributeValueIn) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *AddAttributeValueIn) GetParentId() int64 {
+	if x != nil && x.ParentId != nil {
+		return *x.ParentId
+	}
+	return 0
+}
+
+type GetSubtreeIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id of the subtree root
+	OptionId int64 `protobuf:"varint,1,opt,name=option_id,json=optionId,proto3" json:"option_id,omitempty"`
+	// number of levels below the root to load, 0 for the whole subtree
+	Depth int32 `protobuf:"varint,2,opt,name=depth,proto3" json:"depth,omitempty"`
+	// preferred locales separated by commas, e.g. "kk-KZ,ru"; each locale falls back to its language, then to the original text
+	Locale string `protobuf:"bytes,3,opt,name=locale,proto3" json:"locale,omitempty"`
+}
+
+func (x *GetSubtreeIn) Reset() {
+	*x = GetSubtreeIn{}
+	mi := &file_api_optionhub_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSubtreeIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSubtreeIn) ProtoMessage() {}
+
+func (x *GetSubtreeIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_optionhub_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSubtreeIn.ProtoReflect.Descriptor instead.
+func (*GetSubtreeIn) Descriptor() ([]byte, []int) {
+	return file_api_optionhub_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetSubtreeIn) GetOptionId() int64 {
+	if x != nil {
+		return x.OptionId
+	}
+	return 0
+}
+
+func (x *GetSubtreeIn) GetDepth() int32 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+func (x *GetSubtreeIn) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+type GetSubtreeOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// subtree root with loaded descendants
+	Option *Option `protobuf:"bytes,1,opt,name=option,proto3" json:"option,omitempty"`
+}
+
+func (x *GetSubtreeOut) Reset() {
+	*x = GetSubtreeOut{}
+	mi := &file_api_optionhub_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSubtreeOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSubtreeOut) ProtoMessage() {}
+
+func (x *GetSubtreeOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_optionhub_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSubtreeOut.ProtoReflect.Descriptor instead.
+func (*GetSubtreeOut) Descriptor() ([]byte, []int) {
+	return file_api_optionhub_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetSubtreeOut) GetOption() *Option {
+	if x != nil {
+		return x.Option
+	}
+	return nil
+}
+
+type GetAncestorsIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id of the attribute option
+	OptionId int64 `protobuf:"varint,1,opt,name=option_id,json=optionId,proto3" json:"option_id,omitempty"`
+	// preferred locales separated by commas, e.g. "kk-KZ,ru"; each locale falls back to its language, then to the original text
+	Locale string `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale,omitempty"`
+}
+
+func (x *GetAncestorsIn) Reset() {
+	*x = GetAncestorsIn{}
+	mi := &file_api_optionhub_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAncestorsIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAncestorsIn) ProtoMessage() {}
+
+func (x *GetAncestorsIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_optionhub_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAncestorsIn.ProtoReflect.Descriptor instead.
+func (*GetAncestorsIn) Descriptor() ([]byte, []int) {
+	return file_api_optionhub_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetAncestorsIn) GetOptionId() int64 {
+	if x != nil {
+		return x.OptionId
+	}
+	return 0
+}
+
+func (x *GetAncestorsIn) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+type GetAncestorsOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ancestors from the root to the direct parent, without children
+	Ancestors []*Option `protobuf:"bytes,1,rep,name=ancestors,proto3" json:"ancestors,omitempty"`
+}
+
+func (x *GetAncestorsOut) Reset() {
+	*x = GetAncestorsOut{}
+	mi := &file_api_optionhub_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAncestorsOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAncestorsOut) ProtoMessage() {}
+
+func (x *GetAncestorsOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_optionhub_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAncestorsOut.ProtoReflect.Descriptor instead.
+func (*GetAncestorsOut) Descriptor() ([]byte, []int) {
+	return file_api_optionhub_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetAncestorsOut) GetAncestors() []*Option {
+	if x != nil {
+		return x.Ancestors
+	}
+	return nil
+}
+
+type GetChildrenIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id of the parent option
+	OptionId int64 `protobuf:"varint,1,opt,name=option_id,json=optionId,proto3" json:"option_id,omitempty"`
+	// preferred locales separated by commas, e.g. "kk-KZ,ru"; each locale falls back to its language, then to the original text
+	Locale string `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale,omitempty"`
+}
+
+func (x *GetChildrenIn) Reset() {
+	*x = GetChildrenIn{}
+	mi := &file_api_optionhub_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChildrenIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChildrenIn) ProtoMessage() {}
+
+func (x *GetChildrenIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_optionhub_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChildrenIn.ProtoReflect.Descriptor instead.
+func (*GetChildrenIn) Descriptor() ([]byte, []int) {
+	return file_api_optionhub_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetChildrenIn) GetOptionId() int64 {
+	if x != nil {
+		return x.OptionId
+	}
+	return 0
+}
+
+func (x *GetChildrenIn) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+type GetChildrenOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// direct children of the option, without their own children
+	Children []*Option `protobuf:"bytes,1,rep,name=children,proto3" json:"children,omitempty"`
+}
+
+func (x *GetChildrenOut) Reset() {
+	*x = GetChildrenOut{}
+	mi := &file_api_optionhub_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChildrenOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChildrenOut) ProtoMessage() {}
+
+func (x *GetChildrenOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_optionhub_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChildrenOut.ProtoReflect.Descriptor instead.
+func (*GetChildrenOut) Descriptor() ([]byte, []int) {
+	return file_api_optionhub_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetChildrenOut) GetChildren() []*Option {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+type SetAttributeTranslationIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id of the attribute
+	AttributeId int64 `protobuf:"varint,1,opt,name=attribute_id,json=attributeId,proto3" json:"attribute_id,omitempty"`
+	// locale of the translation, e.g. "en" or "en-US"
+	Locale string `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale,omitempty"`
+	// translated name
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *SetAttributeTranslationIn) Reset() {
+	*x = SetAttributeTranslationIn{}
+	mi := &file_api_optionhub_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetAttributeTranslationIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAttributeTranslationIn) ProtoMessage() {}
+
+func (x *SetAttributeTranslationIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_optionhub_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAttributeTranslationIn.ProtoReflect.Descriptor instead.
+func (*SetAttributeTranslationIn) Descriptor() ([]byte, []int) {
+	return file_api_optionhub_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SetAttributeTranslationIn) GetAttributeId() int64 {
+	if x != nil {
+		return x.AttributeId
+	}
+	return 0
+}
+
+func (x *SetAttributeTranslationIn) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *SetAttributeTranslationIn) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DeleteAttributeTranslationIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id of the attribute
+	AttributeId int64 `protobuf:"varint,1,opt,name=attribute_id,json=attributeId,proto3" json:"attribute_id,omitempty"`
+	// locale of the translation
+	Locale string `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale,omitempty"`
+}
+
+func (x *DeleteAttributeTranslationIn) Reset() {
+	*x = DeleteAttributeTranslationIn{}
+	mi := &file_api_optionhub_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAttributeTranslationIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAttributeTranslationIn) ProtoMessage() {}
+
+func (x *DeleteAttributeTranslationIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_optionhub_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAttributeTranslationIn.ProtoReflect.Descriptor instead.
+func (*DeleteAttributeTranslationIn) Descriptor() ([]byte, []int) {
+	return file_api_optionhub_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *DeleteAttributeTranslationIn) GetAttributeId() int64 {
+	if x != nil {
+		return x.AttributeId
+	}
+	return 0
+}
+
+func (x *DeleteAttributeTranslationIn) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+type SetAttributeValueTranslationIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id of the attribute option
+	OptionId int64 `protobuf:"varint,1,opt,name=option_id,json=optionId,proto3" json:"option_id,omitempty"`
+	// locale of the translation, e.g. "en" or "en-US"
+	Locale string `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale,omitempty"`
+	// translated value
+	Value string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *SetAttributeValueTranslationIn) Reset() {
+	*x = SetAttributeValueTranslationIn{}
+	mi := &file_api_optionhub_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetAttributeValueTranslationIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAttributeValueTranslationIn) ProtoMessage() {}
+
+func (x *SetAttributeValueTranslationIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_optionhub_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAttributeValueTranslationIn.ProtoReflect.Descriptor instead.
+func (*SetAttributeValueTranslationIn) Descriptor() ([]byte, []int) {
+	return file_api_optionhub_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SetAttributeValueTranslationIn) GetOptionId() int64 {
+	if x != nil {
+		return x.OptionId
+	}
+	return 0
+}
+
+func (x *SetAttributeValueTranslationIn) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *SetAttributeValueTranslationIn) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type DeleteAttributeValueTranslationIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id of the attribute option
+	OptionId int64 `protobuf:"varint,1,opt,name=option_id,json=optionId,proto3" json:"option_id,omitempty"`
+	// locale of the translation
+	Locale string `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale,omitempty"`
+}
+
+func (x *DeleteAttributeValueTranslationIn) Reset() {
+	*x = DeleteAttributeValueTranslationIn{}
+	mi := &file_api_optionhub_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAttributeValueTranslationIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAttributeValueTranslationIn) ProtoMessage() {}
+
+func (x *DeleteAttributeValueTranslationIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_optionhub_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAttributeValueTranslationIn.ProtoReflect.Descriptor instead.
+func (*DeleteAttributeValueTranslationIn) Descriptor() ([]byte, []int) {
+	return file_api_optionhub_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *DeleteAttributeValueTranslationIn) GetOptionId() int64 {
+	if x != nil {
+		return x.OptionId
+	}
+	return 0
+}
+
+func (x *DeleteAttributeValueTranslationIn) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+type ImportAttributeValuesIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id of the attribute
+	AttributeId int64 `protobuf:"varint,1,opt,name=attribute_id,json=attributeId,proto3" json:"attribute_id,omitempty"`
+	// format of data
+	Format ImportFormat `protobuf:"varint,2,opt,name=format,proto3,enum=ImportFormat" json:"format,omitempty"`
+	// values tree to import
+	Data []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	// only build the report, without changing the catalog
+	DryRun bool `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// create values whose only match is deleted instead of reporting a conflict
+	RecreateDeleted bool `protobuf:"varint,5,opt,name=recreate_deleted,json=recreateDeleted,proto3" json:"recreate_deleted,omitempty"`
+}
+
+func (x *ImportAttributeValuesIn) Reset() {
+	*x = ImportAttributeValuesIn{}
+	mi := &file_api_optionhub_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportAttributeValuesIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportAttributeValuesIn) ProtoMessage() {}
+
+func (x *ImportAttributeValuesIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_optionhub_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportAttributeValuesIn.ProtoReflect.Descriptor instead.
+func (*ImportAttributeValuesIn) Descriptor() ([]byte, []int) {
+	return file_api_optionhub_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ImportAttributeValuesIn) GetAttributeId() int64 {
+	if x != nil {
+		return x.AttributeId
+	}
+	return 0
+}
+
+func (x *ImportAttributeValuesIn) GetFormat() ImportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ImportFormat_IMPORT_FORMAT_UNSPECIFIED
+}
+
+func (x *ImportAttributeValuesIn) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ImportAttributeValuesIn) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ImportAttributeValuesIn) GetRecreateDeleted() bool {
+	if x != nil {
+		return x.RecreateDeleted
+	}
+	return false
+}
+
+// result of import of a single value
+type ImportRow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// values from the root to this value
+	Path   []string        `protobuf:"bytes,1,rep,name=path,proto3" json:"path,omitempty"`
+	Status ImportRowStatus `protobuf:"varint,2,opt,name=status,proto3,enum=ImportRowStatus" json:"status,omitempty"`
+	// id of the existing or created value, 0 for conflicts and dry run creations
+	OptionId int64 `protobuf:"varint,3,opt,name=option_id,json=optionId,proto3" json:"option_id,omitempty"`
+	// reason of the conflict
+	Message string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *ImportRow) Reset() {
+	*x = ImportRow{}
+	mi := &file_api_optionhub_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportRow) ProtoMessage() {}
+
+func (x *ImportRow) ProtoReflect() protoreflect.Message {
+	mi := &file_api_optionhub_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportRow.ProtoReflect.Descriptor instead.
+func (*ImportRow) Descriptor() ([]byte, []int) {
+	return file_api_optionhub_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ImportRow) GetPath() []string {
+	if x != nil {
+		return x.Path
+	}
+	return nil
+}
+
+func (x *ImportRow) GetStatus() ImportRowStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ImportRowStatus_IMPORT_ROW_STATUS_UNSPECIFIED
+}
+
+func (x *ImportRow) GetOptionId() int64 {
+	if x != nil {
+		return x.OptionId
+	}
+	return 0
+}
+
+func (x *ImportRow) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ImportAttributeValuesOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// every value of the import, parents before children
+	Rows      []*ImportRow `protobuf:"bytes,1,rep,name=rows,proto3" json:"rows,omitempty"`
+	Created   int32        `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+	Skipped   int32        `protobuf:"varint,3,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	Conflicts int32        `protobuf:"varint,4,opt,name=conflicts,proto3" json:"conflicts,omitempty"`
+}
+
+func (x *ImportAttributeValuesOut) Reset() {
+	*x = ImportAttributeValuesOut{}
+	mi := &file_api_optionhub_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportAttributeValuesOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportAttributeValuesOut) ProtoMessage() {}
+
+func (x *ImportAttributeValuesOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_optionhub_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportAttributeValuesOut.ProtoReflect.Descriptor instead.
+func (*ImportAttributeValuesOut) Descriptor() ([]byte, []int) {
+	return file_api_optionhub_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ImportAttributeValuesOut) GetRows() []*ImportRow {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+func (x *ImportAttributeValuesOut) GetCreated() int32 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *ImportAttributeValuesOut) GetSkipped() int32 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+func (x *ImportAttributeValuesOut) GetConflicts() int32 {
+	if x != nil {
+		return x.Conflicts
+	}
+	return 0
+}
+
+// alternative spelling of an option, e.g. "СПб" for "Санкт-Петербург"
+type AttributeValueAlias struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id of the alias
+	AliasId int64 `protobuf:"varint,1,opt,name=alias_id,json=aliasId,proto3" json:"alias_id,omitempty"`
+	// id of the option the alias points to
+	OptionId int64  `protobuf:"varint,2,opt,name=option_id,json=optionId,proto3" json:"option_id,omitempty"`
+	Alias    string `protobuf:"bytes,3,opt,name=alias,proto3" json:"alias,omitempty"`
+}
+
+func (x *AttributeValueAlias) Reset() {
+	*x = AttributeValueAlias{}
+	mi := &file_api_optionhub_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttributeValueAlias) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttributeValueAlias) ProtoMessage() {}
+
+func (x *AttributeValueAlias) ProtoReflect() protoreflect.Message {
+	mi := &file_api_optionhub_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttributeValueAlias.ProtoReflect.Descriptor instead.
+func (*AttributeValueAlias) Descriptor() ([]byte, []int) {
+	return file_api_optionhub_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *AttributeValueAlias) GetAliasId() int64 {
+	if x != nil {
+		return x.AliasId
+	}
+	return 0
+}
+
+func (x *AttributeValueAlias) GetOptionId() int64 {
+	if x != nil {
+		return x.OptionId
+	}
+	return 0
+}
+
+func (x *AttributeValueAlias) GetAlias() string {
+	if x != nil {
+		return x.Alias
+	}
+	return ""
+}
+
+type GetAttributeValueAliasesIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id of the attribute option
+	OptionId int64 `protobuf:"varint,1,opt,name=option_id,json=optionId,proto3" json:"option_id,omitempty"`
+}
+
+func (x *GetAttributeValueAliasesIn) Reset() {
+	*x = GetAttributeValueAliasesIn{}
+	mi := &file_api_optionhub_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAttributeValueAliasesIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttributeValueAliasesIn) ProtoMessage() {}
+
+func (x *GetAttributeValueAliasesIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_optionhub_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAttributeValueAliasesIn.ProtoReflect.Descriptor instead.
+func (*GetAttributeValueAliasesIn) Descriptor() ([]byte, []int) {
+	return file_api_optionhub_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetAttributeValueAliasesIn) GetOptionId() int64 {
+	if x != nil {
+		return x.OptionId
+	}
+	return 0
+}
+
+type GetAttributeValueAliasesOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Aliases []*AttributeValueAlias `protobuf:"bytes,1,rep,name=aliases,proto3" json:"aliases,omitempty"`
+}
+
+func (x *GetAttributeValueAliasesOut) Reset() {
+	*x = GetAttributeValueAliasesOut{}
+	mi := &file_api_optionhub_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAttributeValueAliasesOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttributeValueAliasesOut) ProtoMessage() {}
+
+func (x *GetAttributeValueAliasesOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_optionhub_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAttributeValueAliasesOut.ProtoReflect.Descriptor instead.
+func (*GetAttributeValueAliasesOut) Descriptor() ([]byte, []int) {
+	return file_api_optionhub_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetAttributeValueAliasesOut) GetAliases() []*AttributeValueAlias {
+	if x != nil {
+		return x.Aliases
+	}
+	return nil
+}
+
+type AddAttributeValueAliasIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id of the attribute option
+	OptionId int64 `protobuf:"varint,1,opt,name=option_id,json=optionId,proto3" json:"option_id,omitempty"`
+	// alias, unique within the attribute after normalization
+	Alias string `protobuf:"bytes,2,opt,name=alias,proto3" json:"alias,omitempty"`
+}
+
+func (x *AddAttributeValueAliasIn) Reset() {
+	*x = AddAttributeValueAliasIn{}
+	mi := &file_api_optionhub_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddAttributeValueAliasIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddAttributeValueAliasIn) ProtoMessage() {}
+
+func (x *AddAttributeValueAliasIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_optionhub_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddAttributeValueAliasIn.ProtoReflect.Descriptor instead.
+func (*AddAttributeValueAliasIn) Descriptor() ([]byte, []int) {
+	return file_api_optionhub_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *AddAttributeValueAliasIn) GetOptionId() int64 {
+	if x != nil {
+		return x.OptionId
+	}
+	return 0
+}
+
+func (x *AddAttributeValueAliasIn) GetAlias() string {
+	if x != nil {
+		return x.Alias
+	}
+	return ""
+}
+
+type AddAttributeValueAliasOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id of the created alias
+	AliasId int64 `protobuf:"varint,1,opt,name=alias_id,json=aliasId,proto3" json:"alias_id,omitempty"`
+}
+
+func (x *AddAttributeValueAliasOut) Reset() {
+	*x = AddAttributeValueAliasOut{}
+	mi := &file_api_optionhub_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddAttributeValueAliasOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddAttributeValueAliasOut) ProtoMessage() {}
+
+func (x *AddAttributeValueAliasOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_optionhub_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddAttributeValueAliasOut.ProtoReflect.Descriptor instead.
+func (*AddAttributeValueAliasOut) Descriptor() ([]byte, []int) {
+	return file_api_optionhub_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *AddAttributeValueAliasOut) GetAliasId() int64 {
+	if x != nil {
+		return x.AliasId
+	}
+	return 0
+}
+
+type DeleteAttributeValueAliasIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id of the alias
+	AliasId int64 `protobuf:"varint,1,opt,name=alias_id,json=aliasId,proto3" json:"alias_id,omitempty"`
+}
+
+func (x *DeleteAttributeValueAliasIn) Reset() {
+	*x = DeleteAttributeValueAliasIn{}
+	mi := &file_api_optionhub_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAttributeValueAliasIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAttributeValueAliasIn) ProtoMessage() {}
+
+func (x *DeleteAttributeValueAliasIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_optionhub_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAttributeValueAliasIn.ProtoReflect.Descriptor instead.
+func (*DeleteAttributeValueAliasIn) Descriptor() ([]byte, []int) {
+	return file_api_optionhub_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *DeleteAttributeValueAliasIn) GetAliasId() int64 {
+	if x != nil {
+		return x.AliasId
+	}
+	return 0
+}
+
+type ExportCatalogIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// format of data
+	Format ExportFormat `protobuf:"varint,1,opt,name=format,proto3,enum=ExportFormat" json:"format,omitempty"`
+	// export deleted values too, marked as deleted
+	IncludeDeleted bool `protobuf:"varint,2,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"`
+}
+
+func (x *ExportCatalogIn) Reset() {
+	*x = ExportCatalogIn{}
+	mi := &file_api_optionhub_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportCatalogIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportCatalogIn) ProtoMessage() {}
+
+func (x *ExportCatalogIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_optionhub_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportCatalogIn.ProtoReflect.Descriptor instead.
+func (*ExportCatalogIn) Descriptor() ([]byte, []int) {
+	return file_api_optionhub_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ExportCatalogIn) GetFormat() ExportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ExportFormat_EXPORT_FORMAT_UNSPECIFIED
+}
+
+func (x *ExportCatalogIn) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+type ExportCatalogOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// all attributes and value trees, sorted by name and value
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *ExportCatalogOut) Reset() {
+	*x = ExportCatalogOut{}
+	mi := &file_api_optionhub_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportCatalogOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportCatalogOut) ProtoMessage() {}
+
+func (x *ExportCatalogOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_optionhub_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportCatalogOut.ProtoReflect.Descriptor instead.
+func (*ExportCatalogOut) Descriptor() ([]byte, []int) {
+	return file_api_optionhub_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ExportCatalogOut) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ResolveOptionsIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ids of the options to resolve, may belong to different attributes, 1000 at most
+	OptionIds []int64 `protobuf:"varint,1,rep,packed,name=option_ids,json=optionIds,proto3" json:"option_ids,omitempty"`
+	// preferred locales separated by commas, e.g. "kk-KZ,ru"; each locale falls back to its language, then to the original text
+	Locale string `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale,omitempty"`
+}
+
+func (x *ResolveOptionsIn) Reset() {
+	*x = ResolveOptionsIn{}
+	mi := &file_api_optionhub_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveOptionsIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveOptionsIn) ProtoMessage() {}
+
+func (x *ResolveOptionsIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_optionhub_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveOptionsIn.ProtoReflect.Descriptor instead.
+func (*ResolveOptionsIn) Descriptor() ([]byte, []int) {
+	return file_api_optionhub_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ResolveOptionsIn) GetOptionIds() []int64 {
+	if x != nil {
+		return x.OptionIds
+	}
+	return nil
+}
+
+func (x *ResolveOptionsIn) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+type ResolvedOption struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id of the option
+	OptionId int64 `protobuf:"varint,1,opt,name=option_id,json=optionId,proto3" json:"option_id,omitempty"`
+	// value of the option
+	OptionValue string `protobuf:"bytes,2,opt,name=option_value,json=optionValue,proto3" json:"option_value,omitempty"`
+	// id of the attribute the option belongs to
+	AttributeId int64 `protobuf:"varint,3,opt,name=attribute_id,json=attributeId,proto3" json:"attribute_id,omitempty"`
+	// name of the attribute the option belongs to
+	AttributeName string `protobuf:"bytes,4,opt,name=attribute_name,json=attributeName,proto3" json:"attribute_name,omitempty"`
+	// values from the root to the option itself
+	Path []string `protobuf:"bytes,5,rep,name=path,proto3" json:"path,omitempty"`
+	// option was deleted and is kept only for existing references
+	IsDeleted bool `protobuf:"varint,6,opt,name=is_deleted,json=isDeleted,proto3" json:"is_deleted,omitempty"`
+	// requested id, set when it was merged into option_id
+	RedirectedFrom *int64 `protobuf:"varint,7,opt,name=redirected_from,json=redirectedFrom,proto3,oneof" json:"redirected_from,omitempty"`
+}
+
+func (x *ResolvedOption) Reset() {
+	*x = ResolvedOption{}
+	mi := &file_api_optionhub_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolvedOption) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolvedOption) ProtoMessage() {}
+
+func (x *ResolvedOption) ProtoReflect() protoreflect.Message {
+	mi := &file_api_optionhub_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolvedOption.ProtoReflect.Descriptor instead.
+func (*ResolvedOption) Descriptor() ([]byte, []int) {
+	return file_api_optionhub_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ResolvedOption) GetOptionId() int64 {
+	if x != nil {
+		return x.OptionId
+	}
+	return 0
+}
+
+func (x *ResolvedOption) GetOptionValue() string {
+	if x != nil {
+		return x.OptionValue
+	}
+	return ""
+}
+
+func (x *ResolvedOption) GetAttributeId() int64 {
+	if x != nil {
+		return x.AttributeId
+	}
+	return 0
+}
+
+func (x *ResolvedOption) GetAttributeName() string {
+	if x != nil {
+		return x.AttributeName
+	}
+	return ""
+}
+
+func (x *ResolvedOption) GetPath() []string {
+	if x != nil {
+		return x.Path
+	}
+	return nil
+}
+
+func (x *ResolvedOption) GetIsDeleted() bool {
+	if x != nil {
+		return x.IsDeleted
+	}
+	return false
+}
+
+func (x *ResolvedOption) GetRedirectedFrom() int64 {
+	if x != nil && x.RedirectedFrom != nil {
+		return *x.RedirectedFrom
+	}
+	return 0
+}
+
+type ResolveOptionsOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// resolved options in the order of requested ids
+	Options []*ResolvedOption `protobuf:"bytes,1,rep,name=options,proto3" json:"options,omitempty"`
+	// requested ids that do not exist
+	NotFoundIds []int64 `protobuf:"varint,2,rep,packed,name=not_found_ids,json=notFoundIds,proto3" json:"not_found_ids,omitempty"`
+}
+
+func (x *ResolveOptionsOut) Reset() {
+	*x = ResolveOptionsOut{}
+	mi := &file_api_optionhub_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveOptionsOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveOptionsOut) ProtoMessage() {}
+
+func (x *ResolveOptionsOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_optionhub_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveOptionsOut.ProtoReflect.Descriptor instead.
+func (*ResolveOptionsOut) Descriptor() ([]byte, []int) {
+	return file_api_optionhub_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ResolveOptionsOut) GetOptions() []*ResolvedOption {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *ResolveOptionsOut) GetNotFoundIds() []int64 {
+	if x != nil {
+		return x.NotFoundIds
+	}
+	return nil
+}
+
+type SearchAttributeValuesIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id of the attribute to search in
+	AttributeId int64 `protobuf:"varint,1,opt,name=attribute_id,json=attributeId,proto3" json:"attribute_id,omitempty"`
+	// text typed by user
+	Query string `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	// max number of hits, 10 by default, 50 at most
+	Limit int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	// preferred locales separated by commas, e.g. "kk-KZ,ru"; each locale falls back to its language, then to the original text
+	Locale string `protobuf:"bytes,4,opt,name=locale,proto3" json:"locale,omitempty"`
+}
+
+func (x *SearchAttributeValuesIn) Reset() {
+	*x = SearchAttributeValuesIn{}
+	mi := &file_api_optionhub_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchAttributeValuesIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchAttributeValuesIn) ProtoMessage() {}
+
+func (x *SearchAttributeValuesIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_optionhub_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchAttributeValuesIn.ProtoReflect.Descriptor instead.
+func (*SearchAttributeValuesIn) Descriptor() ([]byte, []int) {
+	return file_api_optionhub_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *SearchAttributeValuesIn) GetAttributeId() int64 {
+	if x != nil {
+		return x.AttributeId
+	}
+	return 0
+}
+
+func (x *SearchAttributeValuesIn) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchAttributeValuesIn) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SearchAttributeValuesIn) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+type SearchHit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id of the found option
+	OptionId int64 `protobuf:"varint,1,opt,name=option_id,json=optionId,proto3" json:"option_id,omitempty"`
+	// value of the found option
+	OptionValue string `protobuf:"bytes,2,opt,name=option_value,json=optionValue,proto3" json:"option_value,omitempty"`
+	// parent of the found option
+	ParentId *int64 `protobuf:"varint,3,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id,omitempty"`
+	// values from the root to the found option, e.g. ["Россия", "Москва", "Курьяново"]
+	Path []string `protobuf:"bytes,4,rep,name=path,proto3" json:"path,omitempty"`
+	// alias of the option that matched the query, empty if the value itself matched
+	MatchedAlias *string `protobuf:"bytes,5,opt,name=matched_alias,json=matchedAlias,proto3,oneof" json:"matched_alias,omitempty"`
+}
+
+func (x *SearchHit) Reset() {
+	*x = SearchHit{}
+	mi := &file_api_optionhub_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchHit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchHit) ProtoMessage() {}
+
+func (x *SearchHit) ProtoReflect() protoreflect.Message {
+	mi := &file_api_optionhub_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchHit.ProtoReflect.Descriptor instead.
+func (*SearchHit) Descriptor() ([]byte, []int) {
+	return file_api_optionhub_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *SearchHit) GetOptionId() int64 {
+	if x != nil {
+		return x.OptionId
+	}
+	return 0
+}
+
+func (x *SearchHit) GetOptionValue() string {
+	if x != nil {
+		return x.OptionValue
+	}
+	return ""
+}
+
+func (x *SearchHit) GetParentId() int64 {
+	if x != nil && x.ParentId != nil {
+		return *x.ParentId
+	}
+	return 0
+}
+
+func (x *SearchHit) GetPath() []string {
+	if x != nil {
+		return x.Path
+	}
+	return nil
+}
+
+func (x *SearchHit) GetMatchedAlias() string {
+	if x != nil && x.MatchedAlias != nil {
+		return *x.MatchedAlias
+	}
+	return ""
+}
+
+type SearchAttributeValuesOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// prefix matches first, then fuzzy matches by similarity
+	Hits []*SearchHit `protobuf:"bytes,1,rep,name=hits,proto3" json:"hits,omitempty"`
+}
+
+func (x *SearchAttributeValuesOut) Reset() {
+	*x = SearchAttributeValuesOut{}
+	mi := &file_api_optionhub_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchAttributeValuesOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchAttributeValuesOut) ProtoMessage() {}
+
+func (x *SearchAttributeValuesOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_optionhub_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchAttributeValuesOut.ProtoReflect.Descriptor instead.
+func (*SearchAttributeValuesOut) Descriptor() ([]byte, []int) {
+	return file_api_optionhub_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *SearchAttributeValuesOut) GetHits() []*SearchHit {
+	if x != nil {
+		return x.Hits
+	}
+	return nil
+}
+
+type UpdateAttributeValueIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id of the attribute option
+	OptionId int64 `protobuf:"varint,1,opt,name=option_id,json=optionId,proto3" json:"option_id,omitempty"`
+	// new value of the option
+	Value *string `protobuf:"bytes,2,opt,name=value,proto3,oneof" json:"value,omitempty"`
+	// new parent of the option
+	ParentId *int64 `protobuf:"varint,3,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id,omitempty"`
+	// make the option a root, parent_id is ignored
+	ClearParent bool `protobuf:"varint,4,opt,name=clear_parent,json=clearParent,proto3" json:"clear_parent,omitempty"`
+}
+
+func (x *UpdateAttributeValueIn) Reset() {
+	*x = UpdateAttributeValueIn{}
+	mi := &file_api_optionhub_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAttributeValueIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAttributeValueIn) ProtoMessage() {}
+
+func (x *UpdateAttributeValueIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_optionhub_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAttributeValueIn.ProtoReflect.Descriptor instead.
+func (*UpdateAttributeValueIn) Descriptor() ([]byte, []int) {
+	return file_api_optionhub_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *UpdateAttributeValueIn) GetOptionId() int64 {
+	if x != nil {
+		return x.OptionId
+	}
+	return 0
+}
+
+func (x *UpdateAttributeValueIn) GetValue() string {
+	if x != nil && x.Value != nil {
+		return *x.Value
+	}
+	return ""
+}
+
+func (x *UpdateAttributeValueIn) GetParentId() int64 {
+	if x != nil && x.ParentId != nil {
+		return *x.ParentId
+	}
+	return 0
+}
+
+func (x *UpdateAttributeValueIn) GetClearParent() bool {
+	if x != nil {
+		return x.ClearParent
+	}
+	return false
+}
+
+// details of codes.AlreadyExists: the value matches an existing one after normalization
+// (trimmed, whitespace collapsed, NFC, case folded)
+type DuplicateAttributeValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id of the existing option
+	OptionId int64 `protobuf:"varint,1,opt,name=option_id,json=optionId,proto3" json:"option_id,omitempty"`
+	// value of the existing option
+	OptionValue string `protobuf:"bytes,2,opt,name=option_value,json=optionValue,proto3" json:"option_value,omitempty"`
+}
+
+func (x *DuplicateAttributeValue) Reset() {
+	*x = DuplicateAttributeValue{}
+	mi := &file_api_optionhub_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DuplicateAttributeValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DuplicateAttributeValue) ProtoMessage() {}
+
+func (x *DuplicateAttributeValue) ProtoReflect() protoreflect.Message {
+	mi := &file_api_optionhub_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DuplicateAttributeValue.ProtoReflect.Descriptor instead.
+func (*DuplicateAttributeValue) Descriptor() ([]byte, []int) {
+	return file_api_optionhub_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *DuplicateAttributeValue) GetOptionId() int64 {
+	if x != nil {
+		return x.OptionId
+	}
+	return 0
+}
+
+func (x *DuplicateAttributeValue) GetOptionValue() string {
+	if x != nil {
+		return x.OptionValue
+	}
+	return ""
+}
+
+type DeleteAttributeValueIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id of the attribute option
+	OptionId int64 `protobuf:"varint,1,opt,name=option_id,json=optionId,proto3" json:"option_id,omitempty"`
+}
+
+func (x *DeleteAttributeValueIn) Reset() {
+	*x = DeleteAttributeValueIn{}
+	mi := &file_api_optionhub_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAttributeValueIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAttributeValueIn) ProtoMessage() {}
+
+func (x *DeleteAttributeValueIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_optionhub_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAttributeValueIn.ProtoReflect.Descriptor instead.
+func (*DeleteAttributeValueIn) Descriptor() ([]byte, []int) {
+	return file_api_optionhub_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *DeleteAttributeValueIn) GetOptionId() int64 {
+	if x != nil {
+		return x.OptionId
+	}
+	return 0
+}
+
+type MergeAttributeValuesIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// duplicate option, deleted after the merge; its id keeps resolving to the target
+	SourceOptionId int64 `protobuf:"varint,1,opt,name=source_option_id,json=sourceOptionId,proto3" json:"source_option_id,omitempty"`
+	// option that stays, must belong to the same attribute
+	TargetOptionId int64 `protobuf:"varint,2,opt,name=target_option_id,json=targetOptionId,proto3" json:"target_option_id,omitempty"`
+}
+
+func (x *MergeAttributeValuesIn) Reset() {
+	*x = MergeAttributeValuesIn{}
+	mi := &file_api_optionhub_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeAttributeValuesIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeAttributeValuesIn) ProtoMessage() {}
+
+func (x *MergeAttributeValuesIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_optionhub_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeAttributeValuesIn.ProtoReflect.Descriptor instead.
+func (*MergeAttributeValuesIn) Descriptor() ([]byte, []int) {
+	return file_api_optionhub_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *MergeAttributeValuesIn) GetSourceOptionId() int64 {
+	if x != nil {
+		return x.SourceOptionId
+	}
+	return 0
+}
+
+func (x *MergeAttributeValuesIn) GetTargetOptionId() int64 {
+	if x != nil {
+		return x.TargetOptionId
+	}
+	return 0
+}
+
+type MergeAttributeValuesOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// children of the source moved under the target
+	MovedChildIds []int64 `protobuf:"varint,1,rep,packed,name=moved_child_ids,json=movedChildIds,proto3" json:"moved_child_ids,omitempty"`
+}
+
+func (x *MergeAttributeValuesOut) Reset() {
+	*x = MergeAttributeValuesOut{}
+	mi := &file_api_optionhub_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeAttributeValuesOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeAttributeValuesOut) ProtoMessage() {}
+
+func (x *MergeAttributeValuesOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_optionhub_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeAttributeValuesOut.ProtoReflect.Descriptor instead.
+func (*MergeAttributeValuesOut) Descriptor() ([]byte, []int) {
+	return file_api_optionhub_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *MergeAttributeValuesOut) GetMovedChildIds() []int64 {
+	if x != nil {
+		return x.MovedChildIds
+	}
+	return nil
+}
+
+// Describe
+type OptionRequestItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id of requested note in db
+	OptionRequestId int64 `protobuf:"varint,1,opt,name=option_request_id,json=optionRequestId,proto3" json:"option_request_id,omitempty"`
+	// value of requested option
+	OptionRequestValue string `protobuf:"bytes,2,opt,name=option_request_value,json=optionRequestValue,proto3" json:"option_request_value,omitempty"`
+	// time of create note
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// value of attribute where option requested in
+	AttributeValue string `protobuf:"bytes,4,opt,name=attribute_value,json=attributeValue,proto3" json:"attribute_value,omitempty"`
+	// id of requested attribute
+	AttributeId int64 `protobuf:"varint,5,opt,name=attribute_id,json=attributeId,proto3" json:"attribute_id,omitempty"`
+	// user_uuid for ban
+	UserUuid string `protobuf:"bytes,6,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
+	// moderation status of the request
+	Status OptionRequestStatus `protobuf:"varint,7,opt,name=status,proto3,enum=OptionRequestStatus" json:"status,omitempty"`
+	// proposed parent value for tree attributes
+	ParentId *int64 `protobuf:"varint,8,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id,omitempty"`
+}
+
+func (x *OptionRequestItem) Reset() {
+	*x = OptionRequestItem{}
+	mi := &file_api_optionhub_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OptionRequestItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OptionRequestItem) ProtoMessage() {}
+
+func (x *OptionRequestItem) ProtoReflect() protoreflect.Message {
+	mi := &file_api_optionhub_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OptionRequestItem.ProtoReflect.Descriptor instead.
+func (*OptionRequestItem) Descriptor() ([]byte, []int) {
+	return file_api_optionhub_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *OptionRequestItem) GetOptionRequestId() int64 {
+	if x != nil {
+		return x.OptionRequestId
+	}
+	return 0
+}
+
+func (x *OptionRequestItem) GetOptionRequestValue() string {
+	if x != nil {
+		return x.OptionRequestValue
+	}
+	return ""
+}
+
+func (x *OptionRequestItem) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *OptionRequestItem) GetAttributeValue() string {
+	if x != nil {
+		return x.AttributeValue
+	}
+	return ""
+}
+
+func (x *OptionRequestItem) GetAttributeId() int64 {
+	if x != nil {
+		return x.AttributeId
+	}
+	return 0
+}
+
+func (x *OptionRequestItem) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *OptionRequestItem) GetStatus() OptionRequestStatus {
+	if x != nil {
+		return x.Status
+	}
+	return OptionRequestStatus_OPTION_REQUEST_STATUS_UNSPECIFIED
+}
+
+func (x *OptionRequestItem) GetParentId() int64 {
+	if x != nil && x.ParentId != nil {
+		return *x.ParentId
+	}
+	return 0
+}
+
+type CreateOptionRequestIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id of the attribute the value is proposed for
+	AttributeId int64 `protobuf:"varint,1,opt,name=attribute_id,json=attributeId,proto3" json:"attribute_id,omitempty"`
+	// proposed value
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// id of the existing parent value, for tree attributes
+	ParentId *int64 `protobuf:"varint,3,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id,omitempty"`
+}
+
+func (x *CreateOptionRequestIn) Reset() {
+	*x = CreateOptionRequestIn{}
+	mi := &file_api_optionhub_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOptionRequestIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOptionRequestIn) ProtoMessage() {}
+
+func (x *CreateOptionRequestIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_optionhub_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOptionRequestIn.ProtoReflect.Descriptor instead.
+func (*CreateOptionRequestIn) Descriptor() ([]byte, []int) {
+	return file_api_optionhub_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *CreateOptionRequestIn) GetAttributeId() int64 {
+	if x != nil {
+		return x.AttributeId
+	}
+	return 0
+}
+
+func (x *CreateOptionRequestIn) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *CreateOptionRequestIn) GetParentId() int64 {
+	if x != nil && x.ParentId != nil {
+		return *x.ParentId
+	}
+	return 0
+}
+
+type CreateOptionRequestOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id of the created option request
+	OptionRequestId int64 `protobuf:"varint,1,opt,name=option_request_id,json=optionRequestId,proto3" json:"option_request_id,omitempty"`
+}
+
+func (x *CreateOptionRequestOut) Reset() {
+	*x = CreateOptionRequestOut{}
+	mi := &file_api_optionhub_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOptionRequestOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOptionRequestOut) ProtoMessage() {}
+
+func (x *CreateOptionRequestOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_optionhub_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOptionRequestOut.ProtoReflect.Descriptor instead.
+func (*CreateOptionRequestOut) Descriptor() ([]byte, []int) {
+	return file_api_optionhub_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *CreateOptionRequestOut) GetOptionRequestId() int64 {
+	if x != nil {
+		return x.OptionRequestId
+	}
+	return 0
+}
+
+type ApproveOptionRequestIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id of the option request
+	OptionRequestId int64 `protobuf:"varint,1,opt,name=option_request_id,json=optionRequestId,proto3" json:"option_request_id,omitempty"`
+	// parent of the value to create, overrides parent proposed by user
+	ParentId *int64 `protobuf:"varint,2,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id,omitempty"`
+}
+
+func (x *ApproveOptionRequestIn) Reset() {
+	*x = ApproveOptionRequestIn{}
+	mi := &file_api_optionhub_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveOptionRequestIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveOptionRequestIn) ProtoMessage() {}
+
+func (x *ApproveOptionRequestIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_optionhub_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveOptionRequestIn.ProtoReflect.Descriptor instead.
+func (*ApproveOptionRequestIn) Descriptor() ([]byte, []int) {
+	return file_api_optionhub_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ApproveOptionRequestIn) GetOptionRequestId() int64 {
+	if x != nil {
+		return x.OptionRequestId
+	}
+	return 0
+}
+
+func (x *ApproveOptionRequestIn) GetParentId() int64 {
+	if x != nil && x.ParentId != nil {
+		return *x.ParentId
+	}
+	return 0
+}
+
+type ApproveOptionRequestOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id of the created attribute value
+	OptionId int64 `protobuf:"varint,1,opt,name=option_id,json=optionId,proto3" json:"option_id,omitempty"`
+}
+
+func (x *ApproveOptionRequestOut) Reset() {
+	*x = ApproveOptionRequestOut{}
+	mi := &file_api_optionhub_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveOptionRequestOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveOptionRequestOut) ProtoMessage() {}
+
+func (x *ApproveOptionRequestOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_optionhub_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveOptionRequestOut.ProtoReflect.Descriptor instead.
+func (*ApproveOptionRequestOut) Descriptor() ([]byte, []int) {
+	return file_api_optionhub_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ApproveOptionRequestOut) GetOptionId() int64 {
+	if x != nil {
+		return x.OptionId
+	}
+	return 0
+}
+
+type RejectOptionRequestIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id of the option request
+	OptionRequestId int64 `protobuf:"varint,1,opt,name=option_request_id,json=optionRequestId,proto3" json:"option_request_id,omitempty"`
+	// why the request was declined
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *RejectOptionRequestIn) Reset() {
+	*x = RejectOptionRequestIn{}
+	mi := &file_api_optionhub_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectOptionRequestIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectOptionRequestIn) ProtoMessage() {}
+
+func (x *RejectOptionRequestIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_optionhub_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectOptionRequestIn.ProtoReflect.Descriptor instead.
+func (*RejectOptionRequestIn) Descriptor() ([]byte, []int) {
+	return file_api_optionhub_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *RejectOptionRequestIn) GetOptionRequestId() int64 {
+	if x != nil {
+		return x.OptionRequestId
+	}
+	return 0
+}
+
+func (x *RejectOptionRequestIn) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type MergeOptionRequestIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id of the option request
+	OptionRequestId int64 `protobuf:"varint,1,opt,name=option_request_id,json=optionRequestId,proto3" json:"option_request_id,omitempty"`
+	// id of the existing attribute value
+	OptionId int64 `protobuf:"varint,2,opt,name=option_id,json=optionId,proto3" json:"option_id,omitempty"`
+}
+
+func (x *MergeOptionRequestIn) Reset() {
+	*x = MergeOptionRequestIn{}
+	mi := &file_api_optionhub_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeOptionRequestIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeOptionRequestIn) ProtoMessage() {}
+
+func (x *MergeOptionRequestIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_optionhub_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeOptionRequestIn.ProtoReflect.Descriptor instead.
+func (*MergeOptionRequestIn) Descriptor() ([]byte, []int) {
+	return file_api_optionhub_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *MergeOptionRequestIn) GetOptionRequestId() int64 {
+	if x != nil {
+		return x.OptionRequestId
+	}
+	return 0
+}
+
+func (x *MergeOptionRequestIn) GetOptionId() int64 {
+	if x != nil {
+		return x.OptionId
+	}
+	return 0
+}
+
+// message request with filters for requested options
+type GetOptionRequestsIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// max items on page, 50 by default, 500 at most
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// next_page_token from the previous response, empty for the first page
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// only requests for this attribute
+	AttributeId *int64 `protobuf:"varint,3,opt,name=attribute_id,json=attributeId,proto3,oneof" json:"attribute_id,omitempty"`
+	// only requests of this user
+	UserUuid *string `protobuf:"bytes,4,opt,name=user_uuid,json=userUuid,proto3,oneof" json:"user_uuid,omitempty"`
+	// only requests created at or after this time
+	CreatedFrom *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_from,json=createdFrom,proto3" json:"created_from,omitempty"`
+	// only requests created before this time
+	CreatedTo *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_to,json=createdTo,proto3" json:"created_to,omitempty"`
+	// only requests in this status, all statuses if unspecified
+	Status OptionRequestStatus `protobuf:"varint,7,opt,name=status,proto3,enum=OptionRequestStatus" json:"status,omitempty"`
+	// sort field, id by default
+	SortBy OptionRequestSortField `protobuf:"varint,8,opt,name=sort_by,json=sortBy,proto3,enum=OptionRequestSortField" json:"sort_by,omitempty"`
+	// sort ascending instead of descending
+	Ascending bool `protobuf:"varint,9,opt,name=ascending,proto3" json:"ascending,omitempty"`
+}
+
+func (x *GetOptionRequestsIn) Reset() {
+	*x = GetOptionRequestsIn{}
+	mi := &file_api_optionhub_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOptionRequestsIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOptionRequestsIn) ProtoMessage() {}
+
+func (x *GetOptionRequestsIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_optionhub_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOptionRequestsIn.ProtoReflect.Descriptor instead.
+func (*GetOptionRequestsIn) Descriptor() ([]byte, []int) {
+	return file_api_optionhub_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *GetOptionRequestsIn) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetOptionRequestsIn) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetOptionRequestsIn) GetAttributeId() int64 {
+	if x != nil && x.AttributeId != nil {
+		return *x.AttributeId
+	}
+	return 0
+}
+
+func (x *GetOptionRequestsIn) GetUserUuid() string {
+	if x != nil && x.UserUuid != nil {
+		return *x.UserUuid
+	}
+	return ""
+}
+
+func (x *GetOptionRequestsIn) GetCreatedFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedFrom
+	}
+	return nil
+}
+
+func (x *GetOptionRequestsIn) GetCreatedTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedTo
+	}
+	return nil
+}
+
+func (x *GetOptionRequestsIn) GetStatus() OptionRequestStatus {
+	if x != nil {
+		return x.Status
+	}
+	return OptionRequestStatus_OPTION_REQUEST_STATUS_UNSPECIFIED
+}
+
+func (x *GetOptionRequestsIn) GetSortBy() OptionRequestSortField {
+	if x != nil {
+		return x.SortBy
+	}
+	return OptionRequestSortField_OPTION_REQUEST_SORT_FIELD_ID
+}
+
+func (x *GetOptionRequestsIn) GetAscending() bool {
+	if x != nil {
+		return x.Ascending
+	}
+	return false
+}
+
+// message response with requested options
+type GetOptionRequestsOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// array of items
+	OptionRequestItem []*OptionRequestItem `protobuf:"bytes,1,rep,name=optionRequestItem,proto3" json:"optionRequestItem,omitempty"`
+	// token for the next page, empty on the last page
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// number of requests matching the filters
+	TotalCount int64 `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+}
+
+func (x *GetOptionRequestsOut) Reset() {
+	*x = GetOptionRequestsOut{}
+	mi := &file_api_optionhub_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOptionRequestsOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOptionRequestsOut) ProtoMessage() {}
+
+func (x *GetOptionRequestsOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_optionhub_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOptionRequestsOut.ProtoReflect.Descriptor instead.
+func (*GetOptionRequestsOut) Descriptor() ([]byte, []int) {
+	return file_api_optionhub_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *GetOptionRequestsOut) GetOptionRequestItem() []*OptionRequestItem {
+	if x != nil {
+		return x.OptionRequestItem
+	}
+	return nil
+}
+
+func (x *GetOptionRequestsOut) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *GetOptionRequestsOut) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+// record about one change of the catalog
+type AuditRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id of the record
+	AuditRecordId int64 `protobuf:"varint,1,opt,name=audit_record_id,json=auditRecordId,proto3" json:"audit_record_id,omitempty"`
+	// uuid of the user who made the change
+	ActorUuid string `protobuf:"bytes,2,opt,name=actor_uuid,json=actorUuid,proto3" json:"actor_uuid,omitempty"`
+	// rpc method that made the change
+	Method string `protobuf:"bytes,3,opt,name=method,proto3" json:"method,omitempty"`
+	// kind of the changed entity
+	EntityType AuditEntityType `protobuf:"varint,4,opt,name=entity_type,json=entityType,proto3,enum=AuditEntityType" json:"entity_type,omitempty"`
+	// id of the changed entity
+	EntityId int64 `protobuf:"varint,5,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	// entity before the change in JSON, empty for created entities
+	Before string `protobuf:"bytes,6,opt,name=before,proto3" json:"before,omitempty"`
+	// entity after the change in JSON, empty for deleted entities
+	After string `protobuf:"bytes,7,opt,name=after,proto3" json:"after,omitempty"`
+	// id of the request from x-request-id metadata
+	RequestId string `protobuf:"bytes,8,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// time of the change
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *AuditRecord) Reset() {
+	*x = AuditRecord{}
+	mi := &file_api_optionhub_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditRecord) ProtoMessage() {}
+
+func (x *AuditRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_api_optionhub_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditRecord.ProtoReflect.Descriptor instead.
+func (*AuditRecord) Descriptor() ([]byte, []int) {
+	return file_api_optionhub_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *AuditRecord) GetAuditRecordId() int64 {
+	if x != nil {
+		return x.AuditRecordId
+	}
+	return 0
+}
+
+func (x *AuditRecord) GetActorUuid() string {
+	if x != nil {
+		return x.ActorUuid
+	}
+	return ""
+}
+
+func (x *AuditRecord) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *AuditRecord) GetEntityType() AuditEntityType {
+	if x != nil {
+		return x.EntityType
+	}
+	return AuditEntityType_AUDIT_ENTITY_TYPE_UNSPECIFIED
+}
+
+func (x *AuditRecord) GetEntityId() int64 {
+	if x != nil {
+		return x.EntityId
+	}
+	return 0
+}
+
+func (x *AuditRecord) GetBefore() string {
+	if x != nil {
+		return x.Before
+	}
+	return ""
+}
+
+func (x *AuditRecord) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+func (x *AuditRecord) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *AuditRecord) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type GetAuditLogIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// max records on page, 50 by default, 500 at most
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// next_page_token from the previous response, empty for the first page
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// only changes of this kind of entity
+	EntityType AuditEntityType `protobuf:"varint,3,opt,name=entity_type,json=entityType,proto3,enum=AuditEntityType" json:"entity_type,omitempty"`
+	// only changes of this entity, requires entity_type
+	EntityId *int64 `protobuf:"varint,4,opt,name=entity_id,json=entityId,proto3,oneof" json:"entity_id,omitempty"`
+	// only changes made by this user
+	ActorUuid *string `protobuf:"bytes,5,opt,name=actor_uuid,json=actorUuid,proto3,oneof" json:"actor_uuid,omitempty"`
+	// only changes made at or after this time
+	CreatedFrom *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_from,json=createdFrom,proto3" json:"created_from,omitempty"`
+	// only changes made before this time
+	CreatedTo *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_to,json=createdTo,proto3" json:"created_to,omitempty"`
+}
+
+func (x *GetAuditLogIn) Reset() {
+	*x = GetAuditLogIn{}
+	mi := &file_api_optionhub_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAuditLogIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAuditLogIn) ProtoMessage() {}
+
+func (x *GetAuditLogIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_optionhub_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAuditLogIn.ProtoReflect.Descriptor instead.
+func (*GetAuditLogIn) Descriptor() ([]byte, []int) {
+	return file_api_optionhub_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *GetAuditLogIn) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetAuditLogIn) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetAuditLogIn) GetEntityType() AuditEntityType {
+	if x != nil {
+		return x.EntityType
+	}
+	return AuditEntityType_AUDIT_ENTITY_TYPE_UNSPECIFIED
+}
+
+func (x *GetAuditLogIn) GetEntityId() int64 {
+	if x != nil && x.EntityId != nil {
+		return *x.EntityId
+	}
+	return 0
+}
+
+func (x *GetAuditLogIn) GetActorUuid() string {
+	if x != nil && x.ActorUuid != nil {
+		return *x.ActorUuid
+	}
+	return ""
+}
+
+func (x *GetAuditLogIn) GetCreatedFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedFrom
+	}
+	return nil
+}
+
+func (x *GetAuditLogIn) GetCreatedTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedTo
+	}
+	return nil
+}
+
+type GetAuditLogOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// records, newest first
+	Records []*AuditRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	// token for the next page, empty on the last page
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *GetAuditLogOut) Reset() {
+	*x = GetAuditLogOut{}
+	mi := &file_api_optionhub_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAuditLogOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAuditLogOut) ProtoMessage() {}
+
+func (x *GetAuditLogOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_optionhub_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAuditLogOut.ProtoReflect.Descriptor instead.
+func (*GetAuditLogOut) Descriptor() ([]byte, []int) {
+	return file_api_optionhub_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GetAuditLogOut) GetRecords() []*AuditRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+func (x *GetAuditLogOut) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type SetNewAttribute struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id of the row in the db
+	AttributeId int64 `protobuf:"varint,1,opt,name=attribute_id,json=attributeId,proto3" json:"attribute_id,omitempty"`
+}
+
+func (x *SetNewAttribute) Reset() {
+	*x = SetNewAttribute{}
+	mi := &file_api_optionhub_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetNewAttribute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetNewAttribute) ProtoMessage() {}
+
+func (x *SetNewAttribute) ProtoReflect() protoreflect.Message {
+	mi := &file_api_optionhub_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetNewAttribute.ProtoReflect.Descriptor instead.
+func (*SetNewAttribute) Descriptor() ([]byte, []int) {
+	return file_api_optionhub_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *SetNewAttribute) GetAttributeId() int64 {
+	if x != nil {
+		return x.AttributeId
+	}
+	return 0
+}
+
+// option request sent by other services instead of calling CreateOptionRequest
+type OptionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// unique id of the message, redelivered messages with the same id are skipped
+	MessageId string `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	// id of the attribute the value is proposed for
+	AttributeId int64 `protobuf:"varint,2,opt,name=attribute_id,json=attributeId,proto3" json:"attribute_id,omitempty"`
+	// proposed value
+	Value string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	// id of the existing parent value, for tree attributes
+	ParentId *int64 `protobuf:"varint,4,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id,omitempty"`
+	// uuid of the user who typed the value
+	UserUuid string `protobuf:"bytes,5,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
+}
+
+func (x *OptionRequest) Reset() {
+	*x = OptionRequest{}
+	mi := &file_api_optionhub_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OptionRequest) ProtoMessage() {}
+
+func (x *OptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_optionhub_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OptionRequest.ProtoReflect.Descriptor instead.
+func (*OptionRequest) Descriptor() ([]byte, []int) {
+	return file_api_optionhub_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *OptionRequest) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *OptionRequest) GetAttributeId() int64 {
+	if x != nil {
+		return x.AttributeId
+	}
+	return 0
+}
+
+func (x *OptionRequest) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *OptionRequest) GetParentId() int64 {
+	if x != nil && x.ParentId != nil {
+		return *x.ParentId
+	}
+	return 0
+}
+
+func (x *OptionRequest) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+// change of the catalog, published with attribute_id as the partition key
+type CatalogEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// schema version of the event, bumped on incompatible changes
+	Version int32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// kind of the change
+	Type CatalogEventType `protobuf:"varint,2,opt,name=type,proto3,enum=CatalogEventType" json:"type,omitempty"`
+	// id of the changed attribute
+	AttributeId int64 `protobuf:"varint,3,opt,name=attribute_id,json=attributeId,proto3" json:"attribute_id,omitempty"`
+	// uuid of the user who made the change
+	ActorUuid string `protobuf:"bytes,4,opt,name=actor_uuid,json=actorUuid,proto3" json:"actor_uuid,omitempty"`
+	// time of the change
+	OccurredAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	// set for CATALOG_EVENT_TYPE_ATTRIBUTE_CREATED
+	AttributeCreated *AttributeCreated `protobuf:"bytes,6,opt,name=attribute_created,json=attributeCreated,proto3" json:"attribute_created,omitempty"`
+	// set for CATALOG_EVENT_TYPE_ATTRIBUTE_VALUE_ADDED
+	AttributeValueAdded *AttributeValueAdded `protobuf:"bytes,7,opt,name=attribute_value_added,json=attributeValueAdded,proto3" json:"attribute_value_added,omitempty"`
+	// set for CATALOG_EVENT_TYPE_ATTRIBUTE_VALUE_UPDATED
+	AttributeValueUpdated *AttributeValueUpdated `protobuf:"bytes,8,opt,name=attribute_value_updated,json=attributeValueUpdated,proto3" json:"attribute_value_updated,omitempty"`
+	// set for CATALOG_EVENT_TYPE_ATTRIBUTE_VALUE_DELETED
+	AttributeValueDeleted *AttributeValueDeleted `protobuf:"bytes,9,opt,name=attribute_value_deleted,json=attributeValueDeleted,proto3" json:"attribute_value_deleted,omitempty"`
+	// set for CATALOG_EVENT_TYPE_OPTION_REQUEST_APPROVED
+	OptionRequestApproved *OptionRequestApproved `protobuf:"bytes,10,opt,name=option_request_approved,json=optionRequestApproved,proto3" json:"option_request_approved,omitempty"`
+	// set for CATALOG_EVENT_TYPE_OPTION_REQUEST_REJECTED
+	OptionRequestRejected *OptionRequestRejected `protobuf:"bytes,11,opt,name=option_request_rejected,json=optionRequestRejected,proto3" json:"option_request_rejected,omitempty"`
+	// set for CATALOG_EVENT_TYPE_OPTION_REQUEST_MERGED
+	OptionRequestMerged *OptionRequestMerged `protobuf:"bytes,12,opt,name=option_request_merged,json=optionRequestMerged,proto3" json:"option_request_merged,omitempty"`
+	// set for CATALOG_EVENT_TYPE_ATTRIBUTE_VALUE_MERGED
+	AttributeValueMerged *AttributeValueMerged `protobuf:"bytes,13,opt,name=attribute_value_merged,json=attributeValueMerged,proto3" json:"attribute_value_merged,omitempty"`
+	// set for CATALOG_EVENT_TYPE_ATTRIBUTE_UPDATED
+	AttributeUpdated *AttributeUpdated `protobuf:"bytes,14,opt,name=attribute_updated,json=attributeUpdated,proto3" json:"attribute_updated,omitempty"`
+	// set for CATALOG_EVENT_TYPE_ATTRIBUTE_DELETED
+	AttributeDeleted *AttributeDeleted `protobuf:"bytes,15,opt,name=attribute_deleted,json=attributeDeleted,proto3" json:"attribute_deleted,omitempty"`
+}
+
+func (x *CatalogEvent) Reset() {
+	*x = CatalogEvent{}
+	mi := &file_api_optionhub_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CatalogEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CatalogEvent) ProtoMessage() {}
+
+func (x *CatalogEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_api_optionhub_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CatalogEvent.ProtoReflect.Descriptor instead.
+func (*CatalogEvent) Descriptor() ([]byte, []int) {
+	return file_api_optionhub_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *CatalogEvent) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *CatalogEvent) GetType() CatalogEventType {
+	if x != nil {
+		return x.Type
+	}
+	return CatalogEventType_CATALOG_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *CatalogEvent) GetAttributeId() int64 {
+	if x != nil {
+		return x.AttributeId
+	}
+	return 0
+}
+
+func (x *CatalogEvent) GetActorUuid() string {
+	if x != nil {
+		return x.ActorUuid
+	}
+	return ""
+}
+
+func (x *CatalogEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+func (x *CatalogEvent) GetAttributeCreated() *AttributeCreated {
+	if x != nil {
+		return x.AttributeCreated
+	}
+	return nil
+}
+
+func (x *CatalogEvent) GetAttributeValueAdded() *AttributeValueAdded {
+	if x != nil {
+		return x.AttributeValueAdded
+	}
+	return nil
+}
+
+func (x *CatalogEvent) GetAttributeValueUpdated() *AttributeValueUpdated {
+	if x != nil {
+		return x.AttributeValueUpdated
+	}
+	return nil
+}
+
+func (x *CatalogEvent) GetAttributeValueDeleted() *AttributeValueDeleted {
+	if x != nil {
+		return x.AttributeValueDeleted
+	}
+	return nil
+}
+
+func (x *CatalogEvent) GetOptionRequestApproved() *OptionRequestApproved {
+	if x != nil {
+		return x.OptionRequestApproved
+	}
+	return nil
+}
+
+func (x *CatalogEvent) GetOptionRequestRejected() *OptionRequestRejected {
+	if x != nil {
+		return x.OptionRequestRejected
+	}
+	return nil
+}
+
+func (x *CatalogEvent) GetOptionRequestMerged() *OptionRequestMerged {
+	if x != nil {
+		return x.OptionRequestMerged
+	}
+	return nil
+}
+
+func (x *CatalogEvent) GetAttributeValueMerged() *AttributeValueMerged {
+	if x != nil {
+		return x.AttributeValueMerged
+	}
+	return nil
+}
+
+func (x *CatalogEvent) GetAttributeUpdated() *AttributeUpdated {
+	if x != nil {
+		return x.AttributeUpdated
+	}
+	return nil
+}
+
+func (x *CatalogEvent) GetAttributeDeleted() *AttributeDeleted {
+	if x != nil {
+		return x.AttributeDeleted
+	}
+	return nil
+}
+
+type AttributeCreated struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// name of the attribute
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// kind of values stored in the attribute
+	Type AttributeType `protobuf:"varint,2,opt,name=type,proto3,enum=AttributeType" json:"type,omitempty"`
+}
+
+func (x *AttributeCreated) Reset() {
+	*x = AttributeCreated{}
+	mi := &file_api_optionhub_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttributeCreated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttributeCreated) ProtoMessage() {}
+
+func (x *AttributeCreated) ProtoReflect() protoreflect.Message {
+	mi := &file_api_optionhub_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttributeCreated.ProtoReflect.Descriptor instead.
+func (*AttributeCreated) Descriptor() ([]byte, []int) {
+	return file_api_optionhub_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *AttributeCreated) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AttributeCreated) GetType() AttributeType {
+	if x != nil {
+		return x.Type
+	}
+	return AttributeType_ATTRIBUTE_TYPE_UNSPECIFIED
+}
+
+type AttributeUpdated struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// name before the change
+	OldName string `protobuf:"bytes,1,opt,name=old_name,json=oldName,proto3" json:"old_name,omitempty"`
+	// name after the change
+	NewName string `protobuf:"bytes,2,opt,name=new_name,json=newName,proto3" json:"new_name,omitempty"`
+}
+
+func (x *AttributeUpdated) Reset() {
+	*x = AttributeUpdated{}
+	mi := &file_api_optionhub_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttributeUpdated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttributeUpdated) ProtoMessage() {}
+
+func (x *AttributeUpdated) ProtoReflect() protoreflect.Message {
+	mi := &file_api_optionhub_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttributeUpdated.ProtoReflect.Descriptor instead.
+func (*AttributeUpdated) Descriptor() ([]byte, []int) {
+	return file_api_optionhub_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *AttributeUpdated) GetOldName() string {
+	if x != nil {
+		return x.OldName
+	}
+	return ""
+}
+
+func (x *AttributeUpdated) GetNewName() string {
+	if x != nil {
+		return x.NewName
+	}
+	return ""
+}
+
+// the attribute was removed together with all its values, no separate events are sent for the values
+type AttributeDeleted struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// name of the attribute
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// kind of values stored in the attribute
+	Type AttributeType `protobuf:"varint,2,opt,name=type,proto3,enum=AttributeType" json:"type,omitempty"`
+	// always empty: an attribute with values, including deleted ones, cannot be deleted
+	OptionIds []int64 `protobuf:"varint,3,rep,packed,name=option_ids,json=optionIds,proto3" json:"option_ids,omitempty"`
+}
+
+func (x *AttributeDeleted) Reset() {
+	*x = AttributeDeleted{}
+	mi := &file_api_optionhub_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttributeDeleted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttributeDeleted) ProtoMessage() {}
+
+func (x *AttributeDeleted) ProtoReflect() protoreflect.Message {
+	mi := &file_api_optionhub_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttributeDeleted.ProtoReflect.Descriptor instead.
+func (*AttributeDeleted) Descriptor() ([]byte, []int) {
+	return file_api_optionhub_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *AttributeDeleted) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AttributeDeleted) GetType() AttributeType {
+	if x != nil {
+		return x.Type
+	}
+	return AttributeType_ATTRIBUTE_TYPE_UNSPECIFIED
+}
+
+func (x *AttributeDeleted) GetOptionIds() []int64 {
+	if x != nil {
+		return x.OptionIds
+	}
+	return nil
+}
+
+type AttributeValueAdded struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id of the new value
 	OptionId int64 `protobuf:"varint,1,opt,name=option_id,json=optionId,proto3" json:"option_id,omitempty"`
-	// value of the attribute option
-	OptionValue string `protobuf:"bytes,2,opt,name=option_value,json=optionValue,proto3" json:"option_value,omitempty"`
-	// option that inherits from this option
-	Children []*Option `protobuf:"bytes,3,rep,name=children,proto3" json:"children,omitempty"`
+	// the value
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// id of the parent value
+	ParentId *int64 `protobuf:"varint,3,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id,omitempty"`
 }
 
-func (x *Option) Reset() {
-	*x = Option{}
-	mi := &file_api_optionhub_proto_msgTypes[0]
+func (x *AttributeValueAdded) Reset() {
+	*x = AttributeValueAdded{}
+	mi := &file_api_optionhub_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Option) String() string {
+func (x *AttributeValueAdded) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Option) ProtoMessage() {}
+func (*AttributeValueAdded) ProtoMessage() {}
 
-func (x *Option) ProtoReflect() protoreflect.Message {
-	mi := &file_api_optionhub_proto_msgTypes[0]
+func (x *AttributeValueAdded) ProtoReflect() protoreflect.Message {
+	mi := &file_api_optionhub_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -60,56 +4709,64 @@ func (x *Option) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Option.ProtoReflect.Descriptor instead.
-func (*Option) Descriptor() ([]byte, []int) {
-	return file_api_optionhub_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use AttributeValueAdded.ProtoReflect.Descriptor instead.
+func (*AttributeValueAdded) Descriptor() ([]byte, []int) {
+	return file_api_optionhub_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *Option) GetOptionId() int64 {
+func (x *AttributeValueAdded) GetOptionId() int64 {
 	if x != nil {
 		return x.OptionId
 	}
 	return 0
 }
 
-func (x *Option) GetOptionValue() string {
+func (x *AttributeValueAdded) GetValue() string {
 	if x != nil {
-		return x.OptionValue
+		return x.Value
 	}
 	return ""
 }
 
-func (x *Option) GetChildren() []*Option {
-	if x != nil {
-		return x.Children
+func (x *AttributeValueAdded) GetParentId() int64 {
+	if x != nil && x.ParentId != nil {
+		return *x.ParentId
 	}
-	return nil
+	return 0
 }
 
-type GetAttributeValuesIn struct {
+type AttributeValueUpdated struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// id of the attribute
-	AttributeId int64 `protobuf:"varint,1,opt,name=attribute_id,json=attributeId,proto3" json:"attribute_id,omitempty"`
+	// id of the changed value
+	OptionId int64 `protobuf:"varint,1,opt,name=option_id,json=optionId,proto3" json:"option_id,omitempty"`
+	// value before the change
+	OldValue string `protobuf:"bytes,2,opt,name=old_value,json=oldValue,proto3" json:"old_value,omitempty"`
+	// value after the change
+	NewValue string `protobuf:"bytes,3,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"`
+	// parent before the change
+	OldParentId *int64 `protobuf:"varint,4,opt,name=old_parent_id,json=oldParentId,proto3,oneof" json:"old_parent_id,omitempty"`
+	// parent after the change
+	NewParentId *int64 `protobuf:"varint,5,opt,name=new_parent_id,json=newParentId,proto3,oneof" json:"new_parent_id,omitempty"`
 }
 
-func (x *GetAttributeValuesIn) Reset() {
-	*x = GetAttributeValuesIn{}
-	mi := &file_api_optionhub_proto_msgTypes[1]
+func (x *AttributeValueUpdated) Reset() {
+	*x = AttributeValueUpdated{}
+	mi := &file_api_optionhub_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAttributeValuesIn) String() string {
+func (x *AttributeValueUpdated) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAttributeValuesIn) ProtoMessage() {}
+func (*AttributeValueUpdated) ProtoMessage() {}
 
-func (x *GetAttributeValuesIn) ProtoReflect() protoreflect.Message {
-	mi := &file_api_optionhub_proto_msgTypes[1]
+func (x *AttributeValueUpdated) ProtoReflect() protoreflect.Message {
+	mi := &file_api_optionhub_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -120,42 +4777,74 @@ func (x *GetAttributeValuesIn) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAttributeValuesIn.ProtoReflect.Descriptor instead.
-func (*GetAttributeValuesIn) Descriptor() ([]byte, []int) {
-	return file_api_optionhub_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use AttributeValueUpdated.ProtoReflect.Descriptor instead.
+func (*AttributeValueUpdated) Descriptor() ([]byte, []int) {
+	return file_api_optionhub_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *GetAttributeValuesIn) GetAttributeId() int64 {
+func (x *AttributeValueUpdated) GetOptionId() int64 {
 	if x != nil {
-		return x.AttributeId
+		return x.OptionId
 	}
 	return 0
 }
 
-type GetAttributeValuesOut struct {
+func (x *AttributeValueUpdated) GetOldValue() string {
+	if x != nil {
+		return x.OldValue
+	}
+	return ""
+}
+
+func (x *AttributeValueUpdated) GetNewValue() string {
+	if x != nil {
+		return x.NewValue
+	}
+	return ""
+}
+
+func (x *AttributeValueUpdated) GetOldParentId() int64 {
+	if x != nil && x.OldParentId != nil {
+		return *x.OldParentId
+	}
+	return 0
+}
+
+func (x *AttributeValueUpdated) GetNewParentId() int64 {
+	if x != nil && x.NewParentId != nil {
+		return *x.NewParentId
+	}
+	return 0
+}
+
+type AttributeValueDeleted struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// attribute values trees
-	OptionList []*Option `protobuf:"bytes,1,rep,name=option_list,json=optionList,proto3" json:"option_list,omitempty"`
+	// id of the deleted value
+	OptionId int64 `protobuf:"varint,1,opt,name=option_id,json=optionId,proto3" json:"option_id,omitempty"`
+	// the value
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// id of the parent value
+	ParentId *int64 `protobuf:"varint,3,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id,omitempty"`
 }
 
-func (x *GetAttributeValuesOut) Reset() {
-	*x = GetAttributeValuesOut{}
-	mi := &file_api_optionhub_proto_msgTypes[2]
+func (x *AttributeValueDeleted) Reset() {
+	*x = AttributeValueDeleted{}
+	mi := &file_api_optionhub_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAttributeValuesOut) String() string {
+func (x *AttributeValueDeleted) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAttributeValuesOut) ProtoMessage() {}
+func (*AttributeValueDeleted) ProtoMessage() {}
 
-func (x *GetAttributeValuesOut) ProtoReflect() protoreflect.Message {
-	mi := &file_api_optionhub_proto_msgTypes[2]
+func (x *AttributeValueDeleted) ProtoReflect() protoreflect.Message {
+	mi := &file_api_optionhub_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -166,45 +4855,65 @@ func (x *GetAttributeValuesOut) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAttributeValuesOut.ProtoReflect.Descriptor instead.
-func (*GetAttributeValuesOut) Descriptor() ([]byte, []int) {
-	return file_api_optionhub_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use AttributeValueDeleted.ProtoReflect.Descriptor instead.
+func (*AttributeValueDeleted) Descriptor() ([]byte, []int) {
+	return file_api_optionhub_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *GetAttributeValuesOut) GetOptionList() []*Option {
+func (x *AttributeValueDeleted) GetOptionId() int64 {
 	if x != nil {
-		return x.OptionList
+		return x.OptionId
 	}
-	return nil
+	return 0
 }
 
-// message request
-type AddAttributeValueIn struct {
+func (x *AttributeValueDeleted) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *AttributeValueDeleted) GetParentId() int64 {
+	if x != nil && x.ParentId != nil {
+		return *x.ParentId
+	}
+	return 0
+}
+
+// the source value was merged into the target: stored source ids should be replaced with the target id
+type AttributeValueMerged struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// id of the row in the db
-	AttributeId int64  `protobuf:"varint,1,opt,name=attribute_id,json=attributeId,proto3" json:"attribute_id,omitempty"`
-	Value       string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
-	ParentId    *int64 `protobuf:"varint,3,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id,omitempty"`
+	// id of the merged value, now deleted
+	SourceOptionId int64 `protobuf:"varint,1,opt,name=source_option_id,json=sourceOptionId,proto3" json:"source_option_id,omitempty"`
+	// the merged value
+	SourceValue string `protobuf:"bytes,2,opt,name=source_value,json=sourceValue,proto3" json:"source_value,omitempty"`
+	// id of the value that stays
+	TargetOptionId int64 `protobuf:"varint,3,opt,name=target_option_id,json=targetOptionId,proto3" json:"target_option_id,omitempty"`
+	// the value that stays
+	TargetValue string `protobuf:"bytes,4,opt,name=target_value,json=targetValue,proto3" json:"target_value,omitempty"`
+	// children of the source moved under the target
+	MovedChildIds []int64 `protobuf:"varint,5,rep,packed,name=moved_child_ids,json=movedChildIds,proto3" json:"moved_child_ids,omitempty"`
 }
 
-func (x *AddAttributeValueIn) Reset() {
-	*x = AddAttributeValueIn{}
-	mi := &file_api_optionhub_proto_msgTypes[3]
+func (x *AttributeValueMerged) Reset() {
+	*x = AttributeValueMerged{}
+	mi := &file_api_optionhub_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddAttributeValueIn) String() string {
+func (x *AttributeValueMerged) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddAttributeValueIn) ProtoMessage() {}
+func (*AttributeValueMerged) ProtoMessage() {}
 
-func (x *AddAttributeValueIn) ProtoReflect() protoreflect.Message {
-	mi := &file_api_optionhub_proto_msgTypes[3]
+func (x *AttributeValueMerged) ProtoReflect() protoreflect.Message {
+	mi := &file_api_optionhub_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -215,67 +4924,78 @@ func (x *AddAttributeValueIn) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddAttributeValueIn.ProtoReflect.Descriptor instead.
-func (*AddAttributeValueIn) Descriptor() ([]byte, []int) {
-	return file_api_optionhub_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use AttributeValueMerged.ProtoReflect.Descriptor instead.
+func (*AttributeValueMerged) Descriptor() ([]byte, []int) {
+	return file_api_optionhub_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *AddAttributeValueIn) GetAttributeId() int64 {
+func (x *AttributeValueMerged) GetSourceOptionId() int64 {
 	if x != nil {
-		return x.AttributeId
+		return x.SourceOptionId
 	}
 	return 0
 }
 
-func (x *AddAttributeValueIn) GetValue() string {
+func (x *AttributeValueMerged) GetSourceValue() string {
 	if x != nil {
-		return x.Value
+		return x.SourceValue
 	}
 	return ""
 }
 
-func (x *AddAttributeValueIn) GetParentId() int64 {
-	if x != nil && x.ParentId != nil {
-		return *x.ParentId
+func (x *AttributeValueMerged) GetTargetOptionId() int64 {
+	if x != nil {
+		return x.TargetOptionId
 	}
 	return 0
 }
 
-// Describe
-type OptionRequestItem struct {
+func (x *AttributeValueMerged) GetTargetValue() string {
+	if x != nil {
+		return x.TargetValue
+	}
+	return ""
+}
+
+func (x *AttributeValueMerged) GetMovedChildIds() []int64 {
+	if x != nil {
+		return x.MovedChildIds
+	}
+	return nil
+}
+
+type OptionRequestApproved struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// id of requested note in db
+	// id of the option request
 	OptionRequestId int64 `protobuf:"varint,1,opt,name=option_request_id,json=optionRequestId,proto3" json:"option_request_id,omitempty"`
-	// value of requested option
-	OptionRequestValue string `protobuf:"bytes,2,opt,name=option_request_value,json=optionRequestValue,proto3" json:"option_request_value,omitempty"`
-	// time of create note
-	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	// value of attribute where option requested in
-	AttributeValue string `protobuf:"bytes,4,opt,name=attribute_value,json=attributeValue,proto3" json:"attribute_value,omitempty"`
-	// id of requested attribute
-	AttributeId int64 `protobuf:"varint,5,opt,name=attribute_id,json=attributeId,proto3" json:"attribute_id,omitempty"`
-	// user_uuid for ban
-	UserUuid string `protobuf:"bytes,6,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
+	// id of the value created from the request
+	OptionId int64 `protobuf:"varint,2,opt,name=option_id,json=optionId,proto3" json:"option_id,omitempty"`
+	// the value
+	Value string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	// id of the parent value
+	ParentId *int64 `protobuf:"varint,4,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id,omitempty"`
+	// uuid of the user who sent the request
+	UserUuid string `protobuf:"bytes,5,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
 }
 
-func (x *OptionRequestItem) Reset() {
-	*x = OptionRequestItem{}
-	mi := &file_api_optionhub_proto_msgTypes[4]
+func (x *OptionRequestApproved) Reset() {
+	*x = OptionRequestApproved{}
+	mi := &file_api_optionhub_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OptionRequestItem) String() string {
+func (x *OptionRequestApproved) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OptionRequestItem) ProtoMessage() {}
+func (*OptionRequestApproved) ProtoMessage() {}
 
-func (x *OptionRequestItem) ProtoReflect() protoreflect.Message {
-	mi := &file_api_optionhub_proto_msgTypes[4]
+func (x *OptionRequestApproved) ProtoReflect() protoreflect.Message {
+	mi := &file_api_optionhub_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -286,78 +5006,76 @@ func (x *OptionRequestItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OptionRequestItem.ProtoReflect.Descriptor instead.
-func (*OptionRequestItem) Descriptor() ([]byte, []int) {
-	return file_api_optionhub_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use OptionRequestApproved.ProtoReflect.Descriptor instead.
+func (*OptionRequestApproved) Descriptor() ([]byte, []int) {
+	return file_api_optionhub_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *OptionRequestItem) GetOptionRequestId() int64 {
+func (x *OptionRequestApproved) GetOptionRequestId() int64 {
 	if x != nil {
 		return x.OptionRequestId
 	}
 	return 0
 }
 
-func (x *OptionRequestItem) GetOptionRequestValue() string {
-	if x != nil {
-		return x.OptionRequestValue
-	}
-	return ""
-}
-
-func (x *OptionRequestItem) GetCreatedAt() *timestamppb.Timestamp {
+func (x *OptionRequestApproved) GetOptionId() int64 {
 	if x != nil {
-		return x.CreatedAt
+		return x.OptionId
 	}
-	return nil
+	return 0
 }
 
-func (x *OptionRequestItem) GetAttributeValue() string {
+func (x *OptionRequestApproved) GetValue() string {
 	if x != nil {
-		return x.AttributeValue
+		return x.Value
 	}
 	return ""
 }
 
-func (x *OptionRequestItem) GetAttributeId() int64 {
-	if x != nil {
-		return x.AttributeId
+func (x *OptionRequestApproved) GetParentId() int64 {
+	if x != nil && x.ParentId != nil {
+		return *x.ParentId
 	}
 	return 0
 }
 
-func (x *OptionRequestItem) GetUserUuid() string {
+func (x *OptionRequestApproved) GetUserUuid() string {
 	if x != nil {
 		return x.UserUuid
 	}
 	return ""
 }
 
-// message response with requested options
-type GetOptionRequestsOut struct {
+type OptionRequestRejected struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// array of items
-	OptionRequestItem []*OptionRequestItem `protobuf:"bytes,1,rep,name=optionRequestItem,proto3" json:"optionRequestItem,omitempty"`
+	// id of the option request
+	OptionRequestId int64 `protobuf:"varint,1,opt,name=option_request_id,json=optionRequestId,proto3" json:"option_request_id,omitempty"`
+	// declined value
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// why the request was declined
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// uuid of the user who sent the request
+	UserUuid string `protobuf:"bytes,4,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
 }
 
-func (x *GetOptionRequestsOut) Reset() {
-	*x = GetOptionRequestsOut{}
-	mi := &file_api_optionhub_proto_msgTypes[5]
+func (x *OptionRequestRejected) Reset() {
+	*x = OptionRequestRejected{}
+	mi := &file_api_optionhub_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOptionRequestsOut) String() string {
+func (x *OptionRequestRejected) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOptionRequestsOut) ProtoMessage() {}
+func (*OptionRequestRejected) ProtoMessage() {}
 
-func (x *GetOptionRequestsOut) ProtoReflect() protoreflect.Message {
-	mi := &file_api_optionhub_proto_msgTypes[5]
+func (x *OptionRequestRejected) ProtoReflect() protoreflect.Message {
+	mi := &file_api_optionhub_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -368,42 +5086,69 @@ func (x *GetOptionRequestsOut) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOptionRequestsOut.ProtoReflect.Descriptor instead.
-func (*GetOptionRequestsOut) Descriptor() ([]byte, []int) {
-	return file_api_optionhub_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use OptionRequestRejected.ProtoReflect.Descriptor instead.
+func (*OptionRequestRejected) Descriptor() ([]byte, []int) {
+	return file_api_optionhub_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *GetOptionRequestsOut) GetOptionRequestItem() []*OptionRequestItem {
+func (x *OptionRequestRejected) GetOptionRequestId() int64 {
 	if x != nil {
-		return x.OptionRequestItem
+		return x.OptionRequestId
 	}
-	return nil
+	return 0
 }
 
-type SetNewAttribute struct {
+func (x *OptionRequestRejected) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *OptionRequestRejected) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *OptionRequestRejected) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+type OptionRequestMerged struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// id of the row in the db
-	AttributeId int64 `protobuf:"varint,1,opt,name=attribute_id,json=attributeId,proto3" json:"attribute_id,omitempty"`
+	// id of the option request
+	OptionRequestId int64 `protobuf:"varint,1,opt,name=option_request_id,json=optionRequestId,proto3" json:"option_request_id,omitempty"`
+	// id of the existing value the request was mapped to
+	OptionId int64 `protobuf:"varint,2,opt,name=option_id,json=optionId,proto3" json:"option_id,omitempty"`
+	// requested value
+	Value string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	// uuid of the user who sent the request
+	UserUuid string `protobuf:"bytes,4,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
 }
 
-func (x *SetNewAttribute) Reset() {
-	*x = SetNewAttribute{}
-	mi := &file_api_optionhub_proto_msgTypes[6]
+func (x *OptionRequestMerged) Reset() {
+	*x = OptionRequestMerged{}
+	mi := &file_api_optionhub_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetNewAttribute) String() string {
+func (x *OptionRequestMerged) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetNewAttribute) ProtoMessage() {}
+func (*OptionRequestMerged) ProtoMessage() {}
 
-func (x *SetNewAttribute) ProtoReflect() protoreflect.Message {
-	mi := &file_api_optionhub_proto_msgTypes[6]
+func (x *OptionRequestMerged) ProtoReflect() protoreflect.Message {
+	mi := &file_api_optionhub_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -414,18 +5159,39 @@ func (x *SetNewAttribute) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetNewAttribute.ProtoReflect.Descriptor instead.
-func (*SetNewAttribute) Descriptor() ([]byte, []int) {
-	return file_api_optionhub_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use OptionRequestMerged.ProtoReflect.Descriptor instead.
+func (*OptionRequestMerged) Descriptor() ([]byte, []int) {
+	return file_api_optionhub_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *SetNewAttribute) GetAttributeId() int64 {
+func (x *OptionRequestMerged) GetOptionRequestId() int64 {
 	if x != nil {
-		return x.AttributeId
+		return x.OptionRequestId
+	}
+	return 0
+}
+
+func (x *OptionRequestMerged) GetOptionId() int64 {
+	if x != nil {
+		return x.OptionId
 	}
 	return 0
 }
 
+func (x *OptionRequestMerged) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *OptionRequestMerged) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
 var File_api_optionhub_proto protoreflect.FileDescriptor
 
 var file_api_optionhub_proto_rawDesc = []byte{
@@ -434,72 +5200,883 @@ var file_api_optionhub_proto_rawDesc = []byte{
 	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x22, 0x6d, 0x0a, 0x06, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a,
-	0x09, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x08, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x23, 0x0a,
-	0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x07, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72,
-	0x65, 0x6e, 0x22, 0x39, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
-	0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x49, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x74,
-	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0b, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x49, 0x64, 0x22, 0x41, 0x0a,
-	0x15, 0x47, 0x65, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x73, 0x4f, 0x75, 0x74, 0x12, 0x28, 0x0a, 0x0b, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x4f, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74,
-	0x22, 0x7e, 0x0a, 0x13, 0x41, 0x64, 0x64, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x49, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x74, 0x74, 0x72, 0x69,
-	0x62, 0x75, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x61,
-	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6f, 0x74, 0x6f, 0x22, 0xbe, 0x02, 0x0a, 0x09, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x39, 0x0a, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x40, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x1a, 0x3f, 0x0a, 0x11, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
 	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x12, 0x20, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0x4b, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x74,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x49, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x41, 0x74,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x22, 0x37, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x4f, 0x75, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x61,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x49, 0x64, 0x22, 0x4b, 0x0a, 0x0e, 0x47, 0x65,
+	0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x49, 0x6e, 0x12, 0x21, 0x0a, 0x0c,
+	0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0b, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x49, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x22, 0x2a, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x41,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x49, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6c,
+	0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x63,
+	0x61, 0x6c, 0x65, 0x22, 0x3f, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x73, 0x4f, 0x75, 0x74, 0x12, 0x2a, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x41,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x73, 0x22, 0x4a, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x74,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x49, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0b, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x22, 0x36, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x49, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x61, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x49, 0x64, 0x22, 0xaf, 0x02, 0x0a, 0x06, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x23, 0x0a, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08,
+	0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x64,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x68, 0x61, 0x73, 0x5f, 0x63,
+	0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x68,
+	0x61, 0x73, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x12, 0x3d, 0x0a, 0x0c, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x3f, 0x0a, 0x11, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xad, 0x01, 0x0a, 0x14, 0x47,
+	0x65, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x49, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x61, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12,
+	0x22, 0x0a, 0x0a, 0x69, 0x66, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x09, 0x69, 0x66, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x88, 0x01, 0x01, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x42, 0x0d, 0x0a, 0x0b, 0x5f,
+	0x69, 0x66, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x7e, 0x0a, 0x15, 0x47, 0x65,
+	0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73,
+	0x4f, 0x75, 0x74, 0x12, 0x28, 0x0a, 0x0b, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x69,
+	0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0a, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x6f, 0x74, 0x5f, 0x6d,
+	0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x6e,
+	0x6f, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x22, 0x86, 0x01, 0x0a, 0x10, 0x57,
+	0x61, 0x74, 0x63, 0x68, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x49, 0x6e, 0x12,
+	0x21, 0x0a, 0x0c, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x49, 0x64, 0x12, 0x26, 0x0a, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x0b, 0x66, 0x72, 0x6f, 0x6d,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f,
+	0x63, 0x61, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61,
+	0x6c, 0x65, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x22, 0x97, 0x02, 0x0a, 0x11, 0x57, 0x61, 0x74, 0x63, 0x68, 0x41, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x4f, 0x75, 0x74, 0x12, 0x23, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x08, 0x73, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x1b, 0x0a,
+	0x09, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x20, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20,
 	0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x88,
-	0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64,
-	0x22, 0x95, 0x02, 0x0a, 0x11, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x2a, 0x0a, 0x11, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x0f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x49, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x12, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
-	0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
-	0x27, 0x0a, 0x0f, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x5f, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62,
-	0x75, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x74, 0x74, 0x72,
-	0x69, 0x62, 0x75, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b,
-	0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x75,
-	0x73, 0x65, 0x72, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x75, 0x73, 0x65, 0x72, 0x55, 0x75, 0x69, 0x64, 0x22, 0x58, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x4f, 0x75, 0x74,
-	0x12, 0x40, 0x0a, 0x11, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x49, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x4f, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52,
-	0x11, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x74,
-	0x65, 0x6d, 0x22, 0x34, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x4e, 0x65, 0x77, 0x41, 0x74, 0x74, 0x72,
-	0x69, 0x62, 0x75, 0x74, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x01, 0x01, 0x12, 0x3b, 0x0a, 0x0b, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x0a, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x64, 0x41, 0x74, 0x42,
+	0x0c, 0x0a, 0x0a, 0x5f, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x22, 0x7e, 0x0a,
+	0x13, 0x41, 0x64, 0x64, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x49, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x61, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x20, 0x0a,
+	0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x48, 0x00, 0x52, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42,
+	0x0c, 0x0a, 0x0a, 0x5f, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x22, 0x59, 0x0a,
+	0x0c, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x74, 0x72, 0x65, 0x65, 0x49, 0x6e, 0x12, 0x1b, 0x0a,
+	0x09, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65,
+	0x70, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68,
+	0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x22, 0x30, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x53,
+	0x75, 0x62, 0x74, 0x72, 0x65, 0x65, 0x4f, 0x75, 0x74, 0x12, 0x1f, 0x0a, 0x06, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x06, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x45, 0x0a, 0x0e, 0x47, 0x65,
+	0x74, 0x41, 0x6e, 0x63, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x73, 0x49, 0x6e, 0x12, 0x1b, 0x0a, 0x09,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63,
+	0x61, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
+	0x65, 0x22, 0x38, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x41, 0x6e, 0x63, 0x65, 0x73, 0x74, 0x6f, 0x72,
+	0x73, 0x4f, 0x75, 0x74, 0x12, 0x25, 0x0a, 0x09, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x74, 0x6f, 0x72,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x09, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x73, 0x22, 0x44, 0x0a, 0x0d, 0x47,
+	0x65, 0x74, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x49, 0x6e, 0x12, 0x1b, 0x0a, 0x09,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63,
+	0x61, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
+	0x65, 0x22, 0x35, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e,
+	0x4f, 0x75, 0x74, 0x12, 0x23, 0x0a, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08,
+	0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x22, 0x6a, 0x0a, 0x19, 0x53, 0x65, 0x74, 0x41,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
 	0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x61, 0x74, 0x74,
-	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x49, 0x64, 0x32, 0xe4, 0x01, 0x0a, 0x10, 0x4f, 0x70, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61,
+	0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x22, 0x59, 0x0a, 0x1c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x74,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x61, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x22,
+	0x6b, 0x0a, 0x1e, 0x53, 0x65, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x58, 0x0a, 0x21,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x22, 0xbb, 0x01, 0x0a, 0x17, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73,
+	0x49, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0f, 0x72, 0x65, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x22, 0x80, 0x01, 0x0a, 0x09, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52,
+	0x6f, 0x77, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x28, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52,
+	0x6f, 0x77, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x8c, 0x01, 0x0a, 0x18, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x4f, 0x75, 0x74, 0x12, 0x1e, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x6f, 0x77, 0x52, 0x04,
+	0x72, 0x6f, 0x77, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x66,
+	0x6c, 0x69, 0x63, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x63, 0x6f, 0x6e,
+	0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x22, 0x63, 0x0a, 0x13, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x19, 0x0a,
+	0x08, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x22, 0x39, 0x0a, 0x1a, 0x47,
+	0x65, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x41, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x49, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x4d, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x41, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x41, 0x6c, 0x69, 0x61, 0x73,
+	0x65, 0x73, 0x4f, 0x75, 0x74, 0x12, 0x2e, 0x0a, 0x07, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x52, 0x07, 0x61, 0x6c,
+	0x69, 0x61, 0x73, 0x65, 0x73, 0x22, 0x4d, 0x0a, 0x18, 0x41, 0x64, 0x64, 0x41, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x49,
+	0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61,
+	0x6c, 0x69, 0x61, 0x73, 0x22, 0x36, 0x0a, 0x19, 0x41, 0x64, 0x64, 0x41, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x4f, 0x75,
+	0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x49, 0x64, 0x22, 0x38, 0x0a, 0x1b,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x49, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x61,
+	0x6c, 0x69, 0x61, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x61,
+	0x6c, 0x69, 0x61, 0x73, 0x49, 0x64, 0x22, 0x61, 0x0a, 0x0f, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x49, 0x6e, 0x12, 0x25, 0x0a, 0x06, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x64, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75,
+	0x64, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x22, 0x26, 0x0a, 0x10, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x4f, 0x75, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x22, 0x49, 0x0a, 0x10, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x49, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x52, 0x09, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x22, 0x8f, 0x02, 0x0a,
+	0x0e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x1b, 0x0a, 0x09, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x21, 0x0a, 0x0c, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x1d, 0x0a,
+	0x0a, 0x69, 0x73, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x09, 0x69, 0x73, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x2c, 0x0a, 0x0f,
+	0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x0e, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x88, 0x01, 0x01, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x72,
+	0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x22, 0x62,
+	0x0a, 0x11, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x4f, 0x75, 0x74, 0x12, 0x29, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x22,
+	0x0a, 0x0d, 0x6e, 0x6f, 0x74, 0x5f, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0b, 0x6e, 0x6f, 0x74, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x49,
+	0x64, 0x73, 0x22, 0x80, 0x01, 0x0a, 0x17, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x49, 0x6e, 0x12, 0x21,
+	0x0a, 0x0c, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x49,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c,
+	0x6f, 0x63, 0x61, 0x6c, 0x65, 0x22, 0xcb, 0x01, 0x0a, 0x09, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x48, 0x69, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x20, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x28, 0x0a, 0x0d, 0x6d, 0x61, 0x74,
+	0x63, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x01, 0x52, 0x0c, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x41, 0x6c, 0x69, 0x61, 0x73,
+	0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x6c,
+	0x69, 0x61, 0x73, 0x22, 0x3a, 0x0a, 0x18, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x4f, 0x75, 0x74, 0x12,
+	0x1e, 0x0a, 0x04, 0x68, 0x69, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x48, 0x69, 0x74, 0x52, 0x04, 0x68, 0x69, 0x74, 0x73, 0x22,
+	0xad, 0x01, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x49, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x88,
+	0x01, 0x01, 0x12, 0x20, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x5f, 0x70, 0x61,
+	0x72, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x63, 0x6c, 0x65, 0x61,
+	0x72, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x22,
+	0x59, 0x0a, 0x17, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x41, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x35, 0x0a, 0x16, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x49, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x22, 0x6c, 0x0a, 0x16, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x49, 0x6e, 0x12, 0x28, 0x0a, 0x10, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22,
+	0x41, 0x0a, 0x17, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x4f, 0x75, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x6f,
+	0x76, 0x65, 0x64, 0x5f, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x03, 0x52, 0x0d, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x49,
+	0x64, 0x73, 0x22, 0xf3, 0x02, 0x0a, 0x11, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x2a, 0x0a, 0x11, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x12, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x5f, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x74,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0b, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a,
+	0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x55, 0x75, 0x69, 0x64, 0x12, 0x2c, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x20, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x65,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x08, 0x70,
+	0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x70,
+	0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x22, 0x80, 0x01, 0x0a, 0x15, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x49, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x20, 0x0a, 0x09, 0x70,
+	0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00,
+	0x52, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a,
+	0x0a, 0x5f, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x22, 0x44, 0x0a, 0x16, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x4f, 0x75, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
+	0x64, 0x22, 0x74, 0x0a, 0x16, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x6e, 0x12, 0x2a, 0x0a, 0x11, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x08, 0x70, 0x61,
+	0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x70, 0x61,
+	0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x22, 0x36, 0x0a, 0x17, 0x41, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4f,
+	0x75, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22,
+	0x5b, 0x0a, 0x15, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x6e, 0x12, 0x2a, 0x0a, 0x11, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x5f, 0x0a, 0x14,
+	0x4d, 0x65, 0x72, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x49, 0x6e, 0x12, 0x2a, 0x0a, 0x11, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0xb2, 0x03,
+	0x0a, 0x13, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x73, 0x49, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69,
+	0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69,
+	0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x26, 0x0a, 0x0c, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x0b, 0x61, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x08,
+	0x75, 0x73, 0x65, 0x72, 0x55, 0x75, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x3d, 0x0a, 0x0c, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x54, 0x6f, 0x12, 0x2c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x30, 0x0a, 0x07, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x62, 0x79, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x53, 0x6f, 0x72, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x06, 0x73,
+	0x6f, 0x72, 0x74, 0x42, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x73, 0x63, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x5f, 0x69, 0x64, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x75, 0x75,
+	0x69, 0x64, 0x22, 0xa1, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x4f, 0x75, 0x74, 0x12, 0x40, 0x0a, 0x11, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x11, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x26, 0x0a,
+	0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xc4, 0x02, 0x0a, 0x0b, 0x41, 0x75, 0x64, 0x69, 0x74,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x61, 0x75, 0x64, 0x69, 0x74, 0x5f,
+	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0d, 0x61, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x64, 0x12, 0x1d,
+	0x0a, 0x0a, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x55, 0x75, 0x69, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d,
+	0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x31, 0x0a, 0x0b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x41, 0x75, 0x64,
+	0x69, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x66,
+	0x74, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xdb, 0x02,
+	0x0a, 0x0d, 0x47, 0x65, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x49, 0x6e, 0x12,
+	0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x31, 0x0a, 0x0b, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x10, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x0a, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x20,
+	0x0a, 0x09, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x48, 0x00, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x64, 0x88, 0x01, 0x01,
+	0x12, 0x22, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x09, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x55, 0x75, 0x69,
+	0x64, 0x88, 0x01, 0x01, 0x12, 0x3d, 0x0a, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x66, 0x72, 0x6f, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x46,
+	0x72, 0x6f, 0x6d, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x74,
+	0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x54, 0x6f, 0x42, 0x0c,
+	0x0a, 0x0a, 0x5f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x42, 0x0d, 0x0a, 0x0b,
+	0x5f, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x22, 0x60, 0x0a, 0x0e, 0x47,
+	0x65, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x4f, 0x75, 0x74, 0x12, 0x26, 0x0a,
+	0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x07, 0x72, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61,
+	0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x34, 0x0a,
+	0x0f, 0x53, 0x65, 0x74, 0x4e, 0x65, 0x77, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x12, 0x21, 0x0a, 0x0c, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x49, 0x64, 0x22, 0xb4, 0x01, 0x0a, 0x0d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x61, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x20, 0x0a,
+	0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x48, 0x00, 0x52, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12,
+	0x1b, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x55, 0x75, 0x69, 0x64, 0x42, 0x0c, 0x0a, 0x0a,
+	0x5f, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x22, 0xaf, 0x07, 0x0a, 0x0c, 0x43,
+	0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x21, 0x0a, 0x0c,
+	0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0b, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x49, 0x64, 0x12,
+	0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x55, 0x75, 0x69, 0x64, 0x12, 0x3b,
+	0x0a, 0x0b, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x0a, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3e, 0x0a, 0x11, 0x61,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x52, 0x10, 0x61, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x48, 0x0a, 0x15, 0x61,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x61,
+	0x64, 0x64, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x41, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x41, 0x64, 0x64, 0x65, 0x64,
+	0x52, 0x13, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x41, 0x64, 0x64, 0x65, 0x64, 0x12, 0x4e, 0x0a, 0x17, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x52, 0x15,
+	0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x4e, 0x0a, 0x17, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x52, 0x15,
+	0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x4e, 0x0a, 0x17, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x52, 0x15,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x70, 0x70,
+	0x72, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x4e, 0x0a, 0x17, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x15,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x6a,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x48, 0x0a, 0x15, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x64, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x64, 0x52, 0x13, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x64, 0x12,
+	0x4b, 0x0a, 0x16, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x5f, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x5f, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x4d, 0x65, 0x72, 0x67, 0x65, 0x64, 0x52, 0x14, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x64, 0x12, 0x3e, 0x0a, 0x11,
+	0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x52, 0x10, 0x61, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x3e, 0x0a, 0x11,
+	0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x52, 0x10, 0x61, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x22, 0x4a, 0x0a, 0x10,
+	0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x48, 0x0a, 0x10, 0x41, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x19, 0x0a, 0x08,
+	0x6f, 0x6c, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6f, 0x6c, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x65, 0x77, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x77, 0x4e, 0x61,
+	0x6d, 0x65, 0x22, 0x69, 0x0a, 0x10, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x03, 0x52, 0x09, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x22, 0x78, 0x0a,
+	0x13, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x41,
+	0x64, 0x64, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x20, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x08, 0x70, 0x61,
+	0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x70, 0x61,
+	0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x22, 0xe4, 0x01, 0x0a, 0x15, 0x41, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1b,
+	0x0a, 0x09, 0x6f, 0x6c, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x6f, 0x6c, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6e,
+	0x65, 0x77, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x6e, 0x65, 0x77, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x27, 0x0a, 0x0d, 0x6f, 0x6c, 0x64, 0x5f,
+	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x48,
+	0x00, 0x52, 0x0b, 0x6f, 0x6c, 0x64, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x88, 0x01,
+	0x01, 0x12, 0x27, 0x0a, 0x0d, 0x6e, 0x65, 0x77, 0x5f, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x50,
+	0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x6f,
+	0x6c, 0x64, 0x5f, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x42, 0x10, 0x0a, 0x0e,
+	0x5f, 0x6e, 0x65, 0x77, 0x5f, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x22, 0x7a,
+	0x0a, 0x15, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x20, 0x0a, 0x09, 0x70, 0x61,
+	0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52,
+	0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a,
+	0x5f, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x22, 0xd8, 0x01, 0x0a, 0x14, 0x41,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4d, 0x65, 0x72,
+	0x67, 0x65, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x21, 0x0a,
+	0x0c, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x28, 0x0a, 0x10, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x26, 0x0a,
+	0x0f, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x5f, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x5f, 0x69, 0x64, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0d, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x43, 0x68, 0x69,
+	0x6c, 0x64, 0x49, 0x64, 0x73, 0x22, 0xc3, 0x01, 0x0a, 0x15, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x12,
+	0x2a, 0x0a, 0x11, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x20,
+	0x0a, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x48, 0x00, 0x52, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01,
+	0x12, 0x1b, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x55, 0x75, 0x69, 0x64, 0x42, 0x0c, 0x0a,
+	0x0a, 0x5f, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x22, 0x8e, 0x01, 0x0a, 0x15,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x6a,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12,
+	0x1b, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x55, 0x75, 0x69, 0x64, 0x22, 0x91, 0x01, 0x0a,
+	0x13, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65,
+	0x72, 0x67, 0x65, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x75, 0x75, 0x69, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x55, 0x75, 0x69, 0x64,
+	0x2a, 0xd5, 0x01, 0x0a, 0x0d, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x1e, 0x0a, 0x1a, 0x41, 0x54, 0x54, 0x52, 0x49, 0x42, 0x55, 0x54, 0x45, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x41, 0x54, 0x54, 0x52, 0x49, 0x42, 0x55, 0x54, 0x45, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x45, 0x4e, 0x55, 0x4d, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x41,
+	0x54, 0x54, 0x52, 0x49, 0x42, 0x55, 0x54, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x54, 0x52,
+	0x45, 0x45, 0x10, 0x02, 0x12, 0x1c, 0x0a, 0x18, 0x41, 0x54, 0x54, 0x52, 0x49, 0x42, 0x55, 0x54,
+	0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x46, 0x52, 0x45, 0x45, 0x5f, 0x54, 0x45, 0x58, 0x54,
+	0x10, 0x03, 0x12, 0x1f, 0x0a, 0x1b, 0x41, 0x54, 0x54, 0x52, 0x49, 0x42, 0x55, 0x54, 0x45, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x4e, 0x55, 0x4d, 0x42, 0x45, 0x52, 0x5f, 0x52, 0x41, 0x4e, 0x47,
+	0x45, 0x10, 0x04, 0x12, 0x17, 0x0a, 0x13, 0x41, 0x54, 0x54, 0x52, 0x49, 0x42, 0x55, 0x54, 0x45,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x44, 0x41, 0x54, 0x45, 0x10, 0x05, 0x12, 0x1a, 0x0a, 0x16,
+	0x41, 0x54, 0x54, 0x52, 0x49, 0x42, 0x55, 0x54, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x42,
+	0x4f, 0x4f, 0x4c, 0x45, 0x41, 0x4e, 0x10, 0x06, 0x2a, 0xbb, 0x01, 0x0a, 0x0e, 0x57, 0x61, 0x74,
+	0x63, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x1c, 0x57,
+	0x41, 0x54, 0x43, 0x48, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1d, 0x0a,
+	0x19, 0x57, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x53, 0x4e, 0x41, 0x50, 0x53, 0x48, 0x4f, 0x54, 0x10, 0x01, 0x12, 0x20, 0x0a, 0x1c,
+	0x57, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x56, 0x41, 0x4c, 0x55, 0x45, 0x5f, 0x41, 0x44, 0x44, 0x45, 0x44, 0x10, 0x02, 0x12, 0x22,
+	0x0a, 0x1e, 0x57, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x56, 0x41, 0x4c, 0x55, 0x45, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x44,
+	0x10, 0x03, 0x12, 0x22, 0x0a, 0x1e, 0x57, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x45, 0x56, 0x45, 0x4e,
+	0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x56, 0x41, 0x4c, 0x55, 0x45, 0x5f, 0x44, 0x45, 0x4c,
+	0x45, 0x54, 0x45, 0x44, 0x10, 0x04, 0x2a, 0x5c, 0x0a, 0x0c, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x1d, 0x0a, 0x19, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54,
+	0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f,
+	0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x43, 0x53, 0x56, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12,
+	0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x4a, 0x53,
+	0x4f, 0x4e, 0x10, 0x02, 0x2a, 0x92, 0x01, 0x0a, 0x0f, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52,
+	0x6f, 0x77, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x21, 0x0a, 0x1d, 0x49, 0x4d, 0x50, 0x4f,
+	0x52, 0x54, 0x5f, 0x52, 0x4f, 0x57, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x49,
+	0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x52, 0x4f, 0x57, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x49, 0x4d,
+	0x50, 0x4f, 0x52, 0x54, 0x5f, 0x52, 0x4f, 0x57, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
+	0x53, 0x4b, 0x49, 0x50, 0x50, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1e, 0x0a, 0x1a, 0x49, 0x4d, 0x50,
+	0x4f, 0x52, 0x54, 0x5f, 0x52, 0x4f, 0x57, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43,
+	0x4f, 0x4e, 0x46, 0x4c, 0x49, 0x43, 0x54, 0x10, 0x03, 0x2a, 0x74, 0x0a, 0x0c, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x1d, 0x0a, 0x19, 0x45, 0x58, 0x50,
+	0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x45, 0x58, 0x50, 0x4f,
+	0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x4a, 0x53, 0x4f, 0x4e, 0x10, 0x01,
+	0x12, 0x15, 0x0a, 0x11, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41,
+	0x54, 0x5f, 0x43, 0x53, 0x56, 0x10, 0x02, 0x12, 0x16, 0x0a, 0x12, 0x45, 0x58, 0x50, 0x4f, 0x52,
+	0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x59, 0x41, 0x4d, 0x4c, 0x10, 0x03, 0x2a,
+	0xc9, 0x01, 0x0a, 0x13, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x25, 0x0a, 0x21, 0x4f, 0x50, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x21,
+	0x0a, 0x1d, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10,
+	0x01, 0x12, 0x22, 0x0a, 0x1e, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x51, 0x55,
+	0x45, 0x53, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x50, 0x50, 0x52, 0x4f,
+	0x56, 0x45, 0x44, 0x10, 0x02, 0x12, 0x22, 0x0a, 0x1e, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52,
+	0x45, 0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x03, 0x12, 0x20, 0x0a, 0x1c, 0x4f, 0x50, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x4d, 0x45, 0x52, 0x47, 0x45, 0x44, 0x10, 0x04, 0x2a, 0x64, 0x0a, 0x16, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x6f, 0x72, 0x74,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x20, 0x0a, 0x1c, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x49, 0x45,
+	0x4c, 0x44, 0x5f, 0x49, 0x44, 0x10, 0x00, 0x12, 0x28, 0x0a, 0x24, 0x4f, 0x50, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x46,
+	0x49, 0x45, 0x4c, 0x44, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x5f, 0x41, 0x54, 0x10,
+	0x01, 0x2a, 0xa2, 0x01, 0x0a, 0x0f, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x21, 0x0a, 0x1d, 0x41, 0x55, 0x44, 0x49, 0x54, 0x5f, 0x45,
+	0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1f, 0x0a, 0x1b, 0x41, 0x55, 0x44, 0x49,
+	0x54, 0x5f, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x54,
+	0x54, 0x52, 0x49, 0x42, 0x55, 0x54, 0x45, 0x10, 0x01, 0x12, 0x25, 0x0a, 0x21, 0x41, 0x55, 0x44,
+	0x49, 0x54, 0x5f, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41,
+	0x54, 0x54, 0x52, 0x49, 0x42, 0x55, 0x54, 0x45, 0x5f, 0x56, 0x41, 0x4c, 0x55, 0x45, 0x10, 0x02,
+	0x12, 0x24, 0x0a, 0x20, 0x41, 0x55, 0x44, 0x49, 0x54, 0x5f, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x51,
+	0x55, 0x45, 0x53, 0x54, 0x10, 0x03, 0x2a, 0xff, 0x03, 0x0a, 0x10, 0x43, 0x61, 0x74, 0x61, 0x6c,
+	0x6f, 0x67, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x22, 0x0a, 0x1e, 0x43,
+	0x41, 0x54, 0x41, 0x4c, 0x4f, 0x47, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x28, 0x0a, 0x24, 0x43, 0x41, 0x54, 0x41, 0x4c, 0x4f, 0x47, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x54, 0x54, 0x52, 0x49, 0x42, 0x55, 0x54, 0x45, 0x5f,
+	0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x2c, 0x0a, 0x28, 0x43, 0x41, 0x54,
+	0x41, 0x4c, 0x4f, 0x47, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x41, 0x54, 0x54, 0x52, 0x49, 0x42, 0x55, 0x54, 0x45, 0x5f, 0x56, 0x41, 0x4c, 0x55, 0x45, 0x5f,
+	0x41, 0x44, 0x44, 0x45, 0x44, 0x10, 0x02, 0x12, 0x2e, 0x0a, 0x2a, 0x43, 0x41, 0x54, 0x41, 0x4c,
+	0x4f, 0x47, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x54,
+	0x54, 0x52, 0x49, 0x42, 0x55, 0x54, 0x45, 0x5f, 0x56, 0x41, 0x4c, 0x55, 0x45, 0x5f, 0x55, 0x50,
+	0x44, 0x41, 0x54, 0x45, 0x44, 0x10, 0x03, 0x12, 0x2e, 0x0a, 0x2a, 0x43, 0x41, 0x54, 0x41, 0x4c,
+	0x4f, 0x47, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x54,
+	0x54, 0x52, 0x49, 0x42, 0x55, 0x54, 0x45, 0x5f, 0x56, 0x41, 0x4c, 0x55, 0x45, 0x5f, 0x44, 0x45,
+	0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x04, 0x12, 0x2e, 0x0a, 0x2a, 0x43, 0x41, 0x54, 0x41, 0x4c,
+	0x4f, 0x47, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4f, 0x50,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x41, 0x50, 0x50,
+	0x52, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x05, 0x12, 0x2e, 0x0a, 0x2a, 0x43, 0x41, 0x54, 0x41, 0x4c,
+	0x4f, 0x47, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4f, 0x50,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x52, 0x45, 0x4a,
+	0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x06, 0x12, 0x2c, 0x0a, 0x28, 0x43, 0x41, 0x54, 0x41, 0x4c,
+	0x4f, 0x47, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4f, 0x50,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x4d, 0x45, 0x52,
+	0x47, 0x45, 0x44, 0x10, 0x07, 0x12, 0x2d, 0x0a, 0x29, 0x43, 0x41, 0x54, 0x41, 0x4c, 0x4f, 0x47,
+	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x54, 0x54, 0x52,
+	0x49, 0x42, 0x55, 0x54, 0x45, 0x5f, 0x56, 0x41, 0x4c, 0x55, 0x45, 0x5f, 0x4d, 0x45, 0x52, 0x47,
+	0x45, 0x44, 0x10, 0x08, 0x12, 0x28, 0x0a, 0x24, 0x43, 0x41, 0x54, 0x41, 0x4c, 0x4f, 0x47, 0x5f,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x54, 0x54, 0x52, 0x49,
+	0x42, 0x55, 0x54, 0x45, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x44, 0x10, 0x09, 0x12, 0x28,
+	0x0a, 0x24, 0x43, 0x41, 0x54, 0x41, 0x4c, 0x4f, 0x47, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x54, 0x54, 0x52, 0x49, 0x42, 0x55, 0x54, 0x45, 0x5f, 0x44,
+	0x45, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x0a, 0x32, 0x93, 0x11, 0x0a, 0x10, 0x4f, 0x70, 0x74,
 	0x69, 0x6f, 0x6e, 0x68, 0x75, 0x62, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x43, 0x0a,
 	0x11, 0x41, 0x64, 0x64, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x56, 0x61, 0x6c,
 	0x75, 0x65, 0x12, 0x14, 0x2e, 0x41, 0x64, 0x64, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
 	0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x49, 0x6e, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
 	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x22, 0x00, 0x12, 0x44, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
-	0x15, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x73, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x41,
-	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x15,
-	0x2e, 0x47, 0x65, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x73, 0x49, 0x6e, 0x1a, 0x16, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69,
-	0x62, 0x75, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x42,
-	0x0f, 0x5a, 0x0d, 0x70, 0x6b, 0x67, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x68, 0x75, 0x62,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x22, 0x00, 0x12, 0x42, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x14, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x49, 0x6e, 0x1a, 0x15, 0x2e,
+	0x47, 0x65, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x73, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x41, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x15, 0x2e, 0x47,
+	0x65, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x49, 0x6e, 0x1a, 0x16, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x4e, 0x0a,
+	0x15, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x18, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x49, 0x6e,
+	0x1a, 0x19, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x2d, 0x0a,
+	0x0a, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x74, 0x72, 0x65, 0x65, 0x12, 0x0d, 0x2e, 0x47, 0x65,
+	0x74, 0x53, 0x75, 0x62, 0x74, 0x72, 0x65, 0x65, 0x49, 0x6e, 0x1a, 0x0e, 0x2e, 0x47, 0x65, 0x74,
+	0x53, 0x75, 0x62, 0x74, 0x72, 0x65, 0x65, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x0c,
+	0x47, 0x65, 0x74, 0x41, 0x6e, 0x63, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x0f, 0x2e, 0x47,
+	0x65, 0x74, 0x41, 0x6e, 0x63, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x73, 0x49, 0x6e, 0x1a, 0x10, 0x2e,
+	0x47, 0x65, 0x74, 0x41, 0x6e, 0x63, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x73, 0x4f, 0x75, 0x74, 0x22,
+	0x00, 0x12, 0x30, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e,
+	0x12, 0x0e, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x49, 0x6e,
+	0x1a, 0x0f, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x4f, 0x75,
+	0x74, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x11, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x49, 0x6e, 0x1a, 0x12, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c,
+	0x76, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x49,
+	0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x17, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x49, 0x6e, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x14, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x17, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x49, 0x6e, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x14, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x41, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x17, 0x2e, 0x4d,
+	0x65, 0x72, 0x67, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x73, 0x49, 0x6e, 0x1a, 0x18, 0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x41, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x4f, 0x75, 0x74, 0x22,
+	0x00, 0x12, 0x3b, 0x0a, 0x0e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x12, 0x11, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x41, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x49, 0x6e, 0x1a, 0x12, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x41, 0x74,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x30, 0x01, 0x12, 0x4e,
+	0x0a, 0x15, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x18, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x49,
+	0x6e, 0x1a, 0x19, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x3c,
+	0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x12, 0x12, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x49, 0x6e, 0x1a, 0x13, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x74,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x2d, 0x0a, 0x0c,
+	0x47, 0x65, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x12, 0x0f, 0x2e, 0x47,
+	0x65, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x49, 0x6e, 0x1a, 0x0a, 0x2e,
+	0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x0e, 0x4c,
+	0x69, 0x73, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x11, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x49, 0x6e,
+	0x1a, 0x12, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x73, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x12, 0x12, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x49, 0x6e, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x12, 0x12, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x49, 0x6e, 0x1a, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x16, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x6e, 0x1a, 0x17, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4f, 0x75, 0x74,
+	0x22, 0x00, 0x12, 0x4b, 0x0a, 0x14, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x2e, 0x41, 0x70, 0x70,
+	0x72, 0x6f, 0x76, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x49, 0x6e, 0x1a, 0x18, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12,
+	0x47, 0x0a, 0x13, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x6e, 0x1a, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x12, 0x4d, 0x65, 0x72, 0x67,
+	0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15,
+	0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x49, 0x6e, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12,
+	0x30, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x12, 0x0e,
+	0x2e, 0x47, 0x65, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x49, 0x6e, 0x1a, 0x0f,
+	0x2e, 0x47, 0x65, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x4f, 0x75, 0x74, 0x22,
+	0x00, 0x12, 0x36, 0x0a, 0x0d, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x61, 0x74, 0x61, 0x6c,
+	0x6f, 0x67, 0x12, 0x10, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x61, 0x74, 0x61, 0x6c,
+	0x6f, 0x67, 0x49, 0x6e, 0x1a, 0x11, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x61, 0x74,
+	0x61, 0x6c, 0x6f, 0x67, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x17, 0x53, 0x65, 0x74,
+	0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x2e, 0x53, 0x65, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e,
+	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x1a, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
+	0x00, 0x12, 0x59, 0x0a, 0x1c, 0x53, 0x65, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x1f, 0x2e, 0x53, 0x65, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x6e, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x1f,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x22, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x6e, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x57, 0x0a,
+	0x18, 0x47, 0x65, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x12, 0x1b, 0x2e, 0x47, 0x65, 0x74, 0x41,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x41, 0x6c, 0x69,
+	0x61, 0x73, 0x65, 0x73, 0x49, 0x6e, 0x1a, 0x1c, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x65,
+	0x73, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x16, 0x41, 0x64, 0x64, 0x41, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x41, 0x6c, 0x69, 0x61, 0x73,
+	0x12, 0x19, 0x2e, 0x41, 0x64, 0x64, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x49, 0x6e, 0x1a, 0x1a, 0x2e, 0x41, 0x64,
+	0x64, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x41,
+	0x6c, 0x69, 0x61, 0x73, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x19, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x1c, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x41, 0x6c, 0x69,
+	0x61, 0x73, 0x49, 0x6e, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x42, 0x0f,
+	0x5a, 0x0d, 0x70, 0x6b, 0x67, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x68, 0x75, 0x62, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -514,34 +6091,210 @@ func file_api_optionhub_proto_rawDescGZIP() []byte {
 	return file_api_optionhub_proto_rawDescData
 }
 
-var file_api_optionhub_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_api_optionhub_proto_enumTypes = make([]protoimpl.EnumInfo, 9)
+var file_api_optionhub_proto_msgTypes = make([]protoimpl.MessageInfo, 73)
 var file_api_optionhub_proto_goTypes = []any{
-	(*Option)(nil),                // 0: Option
-	(*GetAttributeValuesIn)(nil),  // 1: GetAttributeValuesIn
-	(*GetAttributeValuesOut)(nil), // 2: GetAttributeValuesOut
-	(*AddAttributeValueIn)(nil),   // 3: AddAttributeValueIn
-	(*OptionRequestItem)(nil),     // 4: OptionRequestItem
-	(*GetOptionRequestsOut)(nil),  // 5: GetOptionRequestsOut
-	(*SetNewAttribute)(nil),       // 6: SetNewAttribute
-	(*timestamppb.Timestamp)(nil), // 7: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),         // 8: google.protobuf.Empty
+	(AttributeType)(0),                        // 0: AttributeType
+	(WatchEventType)(0),                       // 1: WatchEventType
+	(ImportFormat)(0),                         // 2: ImportFormat
+	(ImportRowStatus)(0),                      // 3: ImportRowStatus
+	(ExportFormat)(0),                         // 4: ExportFormat
+	(OptionRequestStatus)(0),                  // 5: OptionRequestStatus
+	(OptionRequestSortField)(0),               // 6: OptionRequestSortField
+	(AuditEntityType)(0),                      // 7: AuditEntityType
+	(CatalogEventType)(0),                     // 8: CatalogEventType
+	(*Attribute)(nil),                         // 9: Attribute
+	(*CreateAttributeIn)(nil),                 // 10: CreateAttributeIn
+	(*CreateAttributeOut)(nil),                // 11: CreateAttributeOut
+	(*GetAttributeIn)(nil),                    // 12: GetAttributeIn
+	(*ListAttributesIn)(nil),                  // 13: ListAttributesIn
+	(*ListAttributesOut)(nil),                 // 14: ListAttributesOut
+	(*UpdateAttributeIn)(nil),                 // 15: UpdateAttributeIn
+	(*DeleteAttributeIn)(nil),                 // 16: DeleteAttributeIn
+	(*Option)(nil),                            // 17: Option
+	(*GetAttributeValuesIn)(nil),              // 18: GetAttributeValuesIn
+	(*GetAttributeValuesOut)(nil),             // 19: GetAttributeValuesOut
+	(*WatchAttributeIn)(nil),                  // 20: WatchAttributeIn
+	(*WatchAttributeOut)(nil),                 // 21: WatchAttributeOut
+	(*AddAttributeValueIn)(nil),               // 22: AddAttributeValueIn
+	(*GetSubtreeIn)(nil),                      // 23: GetSubtreeIn
+	(*GetSubtreeOut)(nil),                     // 24: GetSubtreeOut
+	(*GetAncestorsIn)(nil),                    // 25: GetAncestorsIn
+	(*GetAncestorsOut)(nil),                   // 26: GetAncestorsOut
+	(*GetChildrenIn)(nil),                     // 27: GetChildrenIn
+	(*GetChildrenOut)(nil),                    // 28: GetChildrenOut
+	(*SetAttributeTranslationIn)(nil),         // 29: SetAttributeTranslationIn
+	(*DeleteAttributeTranslationIn)(nil),      // 30: DeleteAttributeTranslationIn
+	(*SetAttributeValueTranslationIn)(nil),    // 31: SetAttributeValueTranslationIn
+	(*DeleteAttributeValueTranslationIn)(nil), // 32: DeleteAttributeValueTranslationIn
+	(*ImportAttributeValuesIn)(nil),           // 33: ImportAttributeValuesIn
+	(*ImportRow)(nil),                         // 34: ImportRow
+	(*ImportAttributeValuesOut)(nil),          // 35: ImportAttributeValuesOut
+	(*AttributeValueAlias)(nil),               // 36: AttributeValueAlias
+	(*GetAttributeValueAliasesIn)(nil),        // 37: GetAttributeValueAliasesIn
+	(*GetAttributeValueAliasesOut)(nil),       // 38: GetAttributeValueAliasesOut
+	(*AddAttributeValueAliasIn)(nil),          // 39: AddAttributeValueAliasIn
+	(*AddAttributeValueAliasOut)(nil),         // 40: AddAttributeValueAliasOut
+	(*DeleteAttributeValueAliasIn)(nil),       // 41: DeleteAttributeValueAliasIn
+	(*ExportCatalogIn)(nil),                   // 42: ExportCatalogIn
+	(*ExportCatalogOut)(nil),                  // 43: ExportCatalogOut
+	(*ResolveOptionsIn)(nil),                  // 44: ResolveOptionsIn
+	(*ResolvedOption)(nil),                    // 45: ResolvedOption
+	(*ResolveOptionsOut)(nil),                 // 46: ResolveOptionsOut
+	(*SearchAttributeValuesIn)(nil),           // 47: SearchAttributeValuesIn
+	(*SearchHit)(nil),                         // 48: SearchHit
+	(*SearchAttributeValuesOut)(nil),          // 49: SearchAttributeValuesOut
+	(*UpdateAttributeValueIn)(nil),            // 50: UpdateAttributeValueIn
+	(*DuplicateAttributeValue)(nil),           // 51: DuplicateAttributeValue
+	(*DeleteAttributeValueIn)(nil),            // 52: DeleteAttributeValueIn
+	(*MergeAttributeValuesIn)(nil),            // 53: MergeAttributeValuesIn
+	(*MergeAttributeValuesOut)(nil),           // 54: MergeAttributeValuesOut
+	(*OptionRequestItem)(nil),                 // 55: OptionRequestItem
+	(*CreateOptionRequestIn)(nil),             // 56: CreateOptionRequestIn
+	(*CreateOptionRequestOut)(nil),            // 57: CreateOptionRequestOut
+	(*ApproveOptionRequestIn)(nil),            // 58: ApproveOptionRequestIn
+	(*ApproveOptionRequestOut)(nil),           // 59: ApproveOptionRequestOut
+	(*RejectOptionRequestIn)(nil),             // 60: RejectOptionRequestIn
+	(*MergeOptionRequestIn)(nil),              // 61: MergeOptionRequestIn
+	(*GetOptionRequestsIn)(nil),               // 62: GetOptionRequestsIn
+	(*GetOptionRequestsOut)(nil),              // 63: GetOptionRequestsOut
+	(*AuditRecord)(nil),                       // 64: AuditRecord
+	(*GetAuditLogIn)(nil),                     // 65: GetAuditLogIn
+	(*GetAuditLogOut)(nil),                    // 66: GetAuditLogOut
+	(*SetNewAttribute)(nil),                   // 67: SetNewAttribute
+	(*OptionRequest)(nil),                     // 68: OptionRequest
+	(*CatalogEvent)(nil),                      // 69: CatalogEvent
+	(*AttributeCreated)(nil),                  // 70: AttributeCreated
+	(*AttributeUpdated)(nil),                  // 71: AttributeUpdated
+	(*AttributeDeleted)(nil),                  // 72: AttributeDeleted
+	(*AttributeValueAdded)(nil),               // 73: AttributeValueAdded
+	(*AttributeValueUpdated)(nil),             // 74: AttributeValueUpdated
+	(*AttributeValueDeleted)(nil),             // 75: AttributeValueDeleted
+	(*AttributeValueMerged)(nil),              // 76: AttributeValueMerged
+	(*OptionRequestApproved)(nil),             // 77: OptionRequestApproved
+	(*OptionRequestRejected)(nil),             // 78: OptionRequestRejected
+	(*OptionRequestMerged)(nil),               // 79: OptionRequestMerged
+	nil,                                       // 80: Attribute.TranslationsEntry
+	nil,                                       // 81: Option.TranslationsEntry
+	(*timestamppb.Timestamp)(nil),             // 82: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                     // 83: google.protobuf.Empty
 }
 var file_api_optionhub_proto_depIdxs = []int32{
-	0, // 0: Option.children:type_name -> Option
-	0, // 1: GetAttributeValuesOut.option_list:type_name -> Option
-	7, // 2: OptionRequestItem.created_at:type_name -> google.protobuf.Timestamp
-	4, // 3: GetOptionRequestsOut.optionRequestItem:type_name -> OptionRequestItem
-	3, // 4: OptionhubService.AddAttributeValue:input_type -> AddAttributeValueIn
-	8, // 5: OptionhubService.GetOptionRequests:input_type -> google.protobuf.Empty
-	1, // 6: OptionhubService.GetAttributeValues:input_type -> GetAttributeValuesIn
-	8, // 7: OptionhubService.AddAttributeValue:output_type -> google.protobuf.Empty
-	5, // 8: OptionhubService.GetOptionRequests:output_type -> GetOptionRequestsOut
-	2, // 9: OptionhubService.GetAttributeValues:output_type -> GetAttributeValuesOut
-	7, // [7:10] is the sub-list for method output_type
-	4, // [4:7] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	0,  // 0: Attribute.type:type_name -> AttributeType
+	82, // 1: Attribute.created_at:type_name -> google.protobuf.Timestamp
+	80, // 2: Attribute.translations:type_name -> Attribute.TranslationsEntry
+	0,  // 3: CreateAttributeIn.type:type_name -> AttributeType
+	9,  // 4: ListAttributesOut.attributes:type_name -> Attribute
+	17, // 5: Option.children:type_name -> Option
+	81, // 6: Option.translations:type_name -> Option.TranslationsEntry
+	17, // 7: GetAttributeValuesOut.option_list:type_name -> Option
+	1,  // 8: WatchAttributeOut.type:type_name -> WatchEventType
+	17, // 9: WatchAttributeOut.snapshot:type_name -> Option
+	82, // 10: WatchAttributeOut.occurred_at:type_name -> google.protobuf.Timestamp
+	17, // 11: GetSubtreeOut.option:type_name -> Option
+	17, // 12: GetAncestorsOut.ancestors:type_name -> Option
+	17, // 13: GetChildrenOut.children:type_name -> Option
+	2,  // 14: ImportAttributeValuesIn.format:type_name -> ImportFormat
+	3,  // 15: ImportRow.status:type_name -> ImportRowStatus
+	34, // 16: ImportAttributeValuesOut.rows:type_name -> ImportRow
+	36, // 17: GetAttributeValueAliasesOut.aliases:type_name -> AttributeValueAlias
+	4,  // 18: ExportCatalogIn.format:type_name -> ExportFormat
+	45, // 19: ResolveOptionsOut.options:type_name -> ResolvedOption
+	48, // 20: SearchAttributeValuesOut.hits:type_name -> SearchHit
+	82, // 21: OptionRequestItem.created_at:type_name -> google.protobuf.Timestamp
+	5,  // 22: OptionRequestItem.status:type_name -> OptionRequestStatus
+	82, // 23: GetOptionRequestsIn.created_from:type_name -> google.protobuf.Timestamp
+	82, // 24: GetOptionRequestsIn.created_to:type_name -> google.protobuf.Timestamp
+	5,  // 25: GetOptionRequestsIn.status:type_name -> OptionRequestStatus
+	6,  // 26: GetOptionRequestsIn.sort_by:type_name -> OptionRequestSortField
+	55, // 27: GetOptionRequestsOut.optionRequestItem:type_name -> OptionRequestItem
+	7,  // 28: AuditRecord.entity_type:type_name -> AuditEntityType
+	82, // 29: AuditRecord.created_at:type_name -> google.protobuf.Timestamp
+	7,  // 30: GetAuditLogIn.entity_type:type_name -> AuditEntityType
+	82, // 31: GetAuditLogIn.created_from:type_name -> google.protobuf.Timestamp
+	82, // 32: GetAuditLogIn.created_to:type_name -> google.protobuf.Timestamp
+	64, // 33: GetAuditLogOut.records:type_name -> AuditRecord
+	8,  // 34: CatalogEvent.type:type_name -> CatalogEventType
+	82, // 35: CatalogEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	70, // 36: CatalogEvent.attribute_created:type_name -> AttributeCreated
+	73, // 37: CatalogEvent.attribute_value_added:type_name -> AttributeValueAdded
+	74, // 38: CatalogEvent.attribute_value_updated:type_name -> AttributeValueUpdated
+	75, // 39: CatalogEvent.attribute_value_deleted:type_name -> AttributeValueDeleted
+	77, // 40: CatalogEvent.option_request_approved:type_name -> OptionRequestApproved
+	78, // 41: CatalogEvent.option_request_rejected:type_name -> OptionRequestRejected
+	79, // 42: CatalogEvent.option_request_merged:type_name -> OptionRequestMerged
+	76, // 43: CatalogEvent.attribute_value_merged:type_name -> AttributeValueMerged
+	71, // 44: CatalogEvent.attribute_updated:type_name -> AttributeUpdated
+	72, // 45: CatalogEvent.attribute_deleted:type_name -> AttributeDeleted
+	0,  // 46: AttributeCreated.type:type_name -> AttributeType
+	0,  // 47: AttributeDeleted.type:type_name -> AttributeType
+	22, // 48: OptionhubService.AddAttributeValue:input_type -> AddAttributeValueIn
+	62, // 49: OptionhubService.GetOptionRequests:input_type -> GetOptionRequestsIn
+	18, // 50: OptionhubService.GetAttributeValues:input_type -> GetAttributeValuesIn
+	47, // 51: OptionhubService.SearchAttributeValues:input_type -> SearchAttributeValuesIn
+	23, // 52: OptionhubService.GetSubtree:input_type -> GetSubtreeIn
+	25, // 53: OptionhubService.GetAncestors:input_type -> GetAncestorsIn
+	27, // 54: OptionhubService.GetChildren:input_type -> GetChildrenIn
+	44, // 55: OptionhubService.ResolveOptions:input_type -> ResolveOptionsIn
+	50, // 56: OptionhubService.UpdateAttributeValue:input_type -> UpdateAttributeValueIn
+	52, // 57: OptionhubService.DeleteAttributeValue:input_type -> DeleteAttributeValueIn
+	53, // 58: OptionhubService.MergeAttributeValues:input_type -> MergeAttributeValuesIn
+	20, // 59: OptionhubService.WatchAttribute:input_type -> WatchAttributeIn
+	33, // 60: OptionhubService.ImportAttributeValues:input_type -> ImportAttributeValuesIn
+	10, // 61: OptionhubService.CreateAttribute:input_type -> CreateAttributeIn
+	12, // 62: OptionhubService.GetAttribute:input_type -> GetAttributeIn
+	13, // 63: OptionhubService.ListAttributes:input_type -> ListAttributesIn
+	15, // 64: OptionhubService.UpdateAttribute:input_type -> UpdateAttributeIn
+	16, // 65: OptionhubService.DeleteAttribute:input_type -> DeleteAttributeIn
+	56, // 66: OptionhubService.CreateOptionRequest:input_type -> CreateOptionRequestIn
+	58, // 67: OptionhubService.ApproveOptionRequest:input_type -> ApproveOptionRequestIn
+	60, // 68: OptionhubService.RejectOptionRequest:input_type -> RejectOptionRequestIn
+	61, // 69: OptionhubService.MergeOptionRequest:input_type -> MergeOptionRequestIn
+	65, // 70: OptionhubService.GetAuditLog:input_type -> GetAuditLogIn
+	42, // 71: OptionhubService.ExportCatalog:input_type -> ExportCatalogIn
+	29, // 72: OptionhubService.SetAttributeTranslation:input_type -> SetAttributeTranslationIn
+	30, // 73: OptionhubService.DeleteAttributeTranslation:input_type -> DeleteAttributeTranslationIn
+	31, // 74: OptionhubService.SetAttributeValueTranslation:input_type -> SetAttributeValueTranslationIn
+	32, // 75: OptionhubService.DeleteAttributeValueTranslation:input_type -> DeleteAttributeValueTranslationIn
+	37, // 76: OptionhubService.GetAttributeValueAliases:input_type -> GetAttributeValueAliasesIn
+	39, // 77: OptionhubService.AddAttributeValueAlias:input_type -> AddAttributeValueAliasIn
+	41, // 78: OptionhubService.DeleteAttributeValueAlias:input_type -> DeleteAttributeValueAliasIn
+	83, // 79: OptionhubService.AddAttributeValue:output_type -> google.protobuf.Empty
+	63, // 80: OptionhubService.GetOptionRequests:output_type -> GetOptionRequestsOut
+	19, // 81: OptionhubService.GetAttributeValues:output_type -> GetAttributeValuesOut
+	49, // 82: OptionhubService.SearchAttributeValues:output_type -> SearchAttributeValuesOut
+	24, // 83: OptionhubService.GetSubtree:output_type -> GetSubtreeOut
+	26, // 84: OptionhubService.GetAncestors:output_type -> GetAncestorsOut
+	28, // 85: OptionhubService.GetChildren:output_type -> GetChildrenOut
+	46, // 86: OptionhubService.ResolveOptions:output_type -> ResolveOptionsOut
+	83, // 87: OptionhubService.UpdateAttributeValue:output_type -> google.protobuf.Empty
+	83, // 88: OptionhubService.DeleteAttributeValue:output_type -> google.protobuf.Empty
+	54, // 89: OptionhubService.MergeAttributeValues:output_type -> MergeAttributeValuesOut
+	21, // 90: OptionhubService.WatchAttribute:output_type -> WatchAttributeOut
+	35, // 91: OptionhubService.ImportAttributeValues:output_type -> ImportAttributeValuesOut
+	11, // 92: OptionhubService.CreateAttribute:output_type -> CreateAttributeOut
+	9,  // 93: OptionhubService.GetAttribute:output_type -> Attribute
+	14, // 94: OptionhubService.ListAttributes:output_type -> ListAttributesOut
+	83, // 95: OptionhubService.UpdateAttribute:output_type -> google.protobuf.Empty
+	83, // 96: OptionhubService.DeleteAttribute:output_type -> google.protobuf.Empty
+	57, // 97: OptionhubService.CreateOptionRequest:output_type -> CreateOptionRequestOut
+	59, // 98: OptionhubService.ApproveOptionRequest:output_type -> ApproveOptionRequestOut
+	83, // 99: OptionhubService.RejectOptionRequest:output_type -> google.protobuf.Empty
+	83, // 100: OptionhubService.MergeOptionRequest:output_type -> google.protobuf.Empty
+	66, // 101: OptionhubService.GetAuditLog:output_type -> GetAuditLogOut
+	43, // 102: OptionhubService.ExportCatalog:output_type -> ExportCatalogOut
+	83, // 103: OptionhubService.SetAttributeTranslation:output_type -> google.protobuf.Empty
+	83, // 104: OptionhubService.DeleteAttributeTranslation:output_type -> google.protobuf.Empty
+	83, // 105: OptionhubService.SetAttributeValueTranslation:output_type -> google.protobuf.Empty
+	83, // 106: OptionhubService.DeleteAttributeValueTranslation:output_type -> google.protobuf.Empty
+	38, // 107: OptionhubService.GetAttributeValueAliases:output_type -> GetAttributeValueAliasesOut
+	40, // 108: OptionhubService.AddAttributeValueAlias:output_type -> AddAttributeValueAliasOut
+	83, // 109: OptionhubService.DeleteAttributeValueAlias:output_type -> google.protobuf.Empty
+	79, // [79:110] is the sub-list for method output_type
+	48, // [48:79] is the sub-list for method input_type
+	48, // [48:48] is the sub-list for extension type_name
+	48, // [48:48] is the sub-list for extension extendee
+	0,  // [0:48] is the sub-list for field type_name
 }
 
 func init() { file_api_optionhub_proto_init() }
@@ -549,19 +6302,36 @@ func file_api_optionhub_proto_init() {
 	if File_api_optionhub_proto != nil {
 		return
 	}
-	file_api_optionhub_proto_msgTypes[3].OneofWrappers = []any{}
+	file_api_optionhub_proto_msgTypes[9].OneofWrappers = []any{}
+	file_api_optionhub_proto_msgTypes[11].OneofWrappers = []any{}
+	file_api_optionhub_proto_msgTypes[12].OneofWrappers = []any{}
+	file_api_optionhub_proto_msgTypes[13].OneofWrappers = []any{}
+	file_api_optionhub_proto_msgTypes[36].OneofWrappers = []any{}
+	file_api_optionhub_proto_msgTypes[39].OneofWrappers = []any{}
+	file_api_optionhub_proto_msgTypes[41].OneofWrappers = []any{}
+	file_api_optionhub_proto_msgTypes[46].OneofWrappers = []any{}
+	file_api_optionhub_proto_msgTypes[47].OneofWrappers = []any{}
+	file_api_optionhub_proto_msgTypes[49].OneofWrappers = []any{}
+	file_api_optionhub_proto_msgTypes[53].OneofWrappers = []any{}
+	file_api_optionhub_proto_msgTypes[56].OneofWrappers = []any{}
+	file_api_optionhub_proto_msgTypes[59].OneofWrappers = []any{}
+	file_api_optionhub_proto_msgTypes[64].OneofWrappers = []any{}
+	file_api_optionhub_proto_msgTypes[65].OneofWrappers = []any{}
+	file_api_optionhub_proto_msgTypes[66].OneofWrappers = []any{}
+	file_api_optionhub_proto_msgTypes[68].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_optionhub_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   7,
+			NumEnums:      9,
+			NumMessages:   73,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_api_optionhub_proto_goTypes,
 		DependencyIndexes: file_api_optionhub_proto_depIdxs,
+		EnumInfos:         file_api_optionhub_proto_enumTypes,
 		MessageInfos:      file_api_optionhub_proto_msgTypes,
 	}.Build()
 	File_api_optionhub_proto = out.File
@@ -20,9 +20,37 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	OptionhubService_AddAttributeValue_FullMethodName  = "/OptionhubService/AddAttributeValue"
-	OptionhubService_GetOptionRequests_FullMethodName  = "/OptionhubService/GetOptionRequests"
-	OptionhubService_GetAttributeValues_FullMethodName = "/OptionhubService/GetAttributeValues"
+	OptionhubService_AddAttributeValue_FullMethodName               = "/OptionhubService/AddAttributeValue"
+	OptionhubService_GetOptionRequests_FullMethodName               = "/OptionhubService/GetOptionRequests"
+	OptionhubService_GetAttributeValues_FullMethodName              = "/OptionhubService/GetAttributeValues"
+	OptionhubService_SearchAttributeValues_FullMethodName           = "/OptionhubService/SearchAttributeValues"
+	OptionhubService_GetSubtree_FullMethodName                      = "/OptionhubService/GetSubtree"
+	OptionhubService_GetAncestors_FullMethodName                    = "/OptionhubService/GetAncestors"
+	OptionhubService_GetChildren_FullMethodName                     = "/OptionhubService/GetChildren"
+	OptionhubService_ResolveOptions_FullMethodName                  = "/OptionhubService/ResolveOptions"
+	OptionhubService_UpdateAttributeValue_FullMethodName            = "/OptionhubService/UpdateAttributeValue"
+	OptionhubService_DeleteAttributeValue_FullMethodName            = "/OptionhubService/DeleteAttributeValue"
+	OptionhubService_MergeAttributeValues_FullMethodName            = "/OptionhubService/MergeAttributeValues"
+	OptionhubService_WatchAttribute_FullMethodName                  = "/OptionhubService/WatchAttribute"
+	OptionhubService_ImportAttributeValues_FullMethodName           = "/OptionhubService/ImportAttributeValues"
+	OptionhubService_CreateAttribute_FullMethodName                 = "/OptionhubService/CreateAttribute"
+	OptionhubService_GetAttribute_FullMethodName                    = "/OptionhubService/GetAttribute"
+	OptionhubService_ListAttributes_FullMethodName                  = "/OptionhubService/ListAttributes"
+	OptionhubService_UpdateAttribute_FullMethodName                 = "/OptionhubService/UpdateAttribute"
+	OptionhubService_DeleteAttribute_FullMethodName                 = "/OptionhubService/DeleteAttribute"
+	OptionhubService_CreateOptionRequest_FullMethodName             = "/OptionhubService/CreateOptionRequest"
+	OptionhubService_ApproveOptionRequest_FullMethodName            = "/OptionhubService/ApproveOptionRequest"
+	OptionhubService_RejectOptionRequest_FullMethodName             = "/OptionhubService/RejectOptionRequest"
+	OptionhubService_MergeOptionRequest_FullMethodName              = "/OptionhubService/MergeOptionRequest"
+	OptionhubService_GetAuditLog_FullMethodName                     = "/OptionhubService/GetAuditLog"
+	OptionhubService_ExportCatalog_FullMethodName                   = "/OptionhubService/ExportCatalog"
+	OptionhubService_SetAttributeTranslation_FullMethodName         = "/OptionhubService/SetAttributeTranslation"
+	OptionhubService_DeleteAttributeTranslation_FullMethodName      = "/OptionhubService/DeleteAttributeTranslation"
+	OptionhubService_SetAttributeValueTranslation_FullMethodName    = "/OptionhubService/SetAttributeValueTranslation"
+	OptionhubService_DeleteAttributeValueTranslation_FullMethodName = "/OptionhubService/DeleteAttributeValueTranslation"
+	OptionhubService_GetAttributeValueAliases_FullMethodName        = "/OptionhubService/GetAttributeValueAliases"
+	OptionhubService_AddAttributeValueAlias_FullMethodName          = "/OptionhubService/AddAttributeValueAlias"
+	OptionhubService_DeleteAttributeValueAlias_FullMethodName       = "/OptionhubService/DeleteAttributeValueAlias"
 )
 
 // OptionhubServiceClient is the client API for OptionhubService service.
@@ -30,8 +58,36 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type OptionhubServiceClient interface {
 	AddAttributeValue(ctx context.Context, in *AddAttributeValueIn, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	GetOptionRequests(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetOptionRequestsOut, error)
+	GetOptionRequests(ctx context.Context, in *GetOptionRequestsIn, opts ...grpc.CallOption) (*GetOptionRequestsOut, error)
 	GetAttributeValues(ctx context.Context, in *GetAttributeValuesIn, opts ...grpc.CallOption) (*GetAttributeValuesOut, error)
+	SearchAttributeValues(ctx context.Context, in *SearchAttributeValuesIn, opts ...grpc.CallOption) (*SearchAttributeValuesOut, error)
+	GetSubtree(ctx context.Context, in *GetSubtreeIn, opts ...grpc.CallOption) (*GetSubtreeOut, error)
+	GetAncestors(ctx context.Context, in *GetAncestorsIn, opts ...grpc.CallOption) (*GetAncestorsOut, error)
+	GetChildren(ctx context.Context, in *GetChildrenIn, opts ...grpc.CallOption) (*GetChildrenOut, error)
+	ResolveOptions(ctx context.Context, in *ResolveOptionsIn, opts ...grpc.CallOption) (*ResolveOptionsOut, error)
+	UpdateAttributeValue(ctx context.Context, in *UpdateAttributeValueIn, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeleteAttributeValue(ctx context.Context, in *DeleteAttributeValueIn, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	MergeAttributeValues(ctx context.Context, in *MergeAttributeValuesIn, opts ...grpc.CallOption) (*MergeAttributeValuesOut, error)
+	WatchAttribute(ctx context.Context, in *WatchAttributeIn, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchAttributeOut], error)
+	ImportAttributeValues(ctx context.Context, in *ImportAttributeValuesIn, opts ...grpc.CallOption) (*ImportAttributeValuesOut, error)
+	CreateAttribute(ctx context.Context, in *CreateAttributeIn, opts ...grpc.CallOption) (*CreateAttributeOut, error)
+	GetAttribute(ctx context.Context, in *GetAttributeIn, opts ...grpc.CallOption) (*Attribute, error)
+	ListAttributes(ctx context.Context, in *ListAttributesIn, opts ...grpc.CallOption) (*ListAttributesOut, error)
+	UpdateAttribute(ctx context.Context, in *UpdateAttributeIn, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeleteAttribute(ctx context.Context, in *DeleteAttributeIn, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	CreateOptionRequest(ctx context.Context, in *CreateOptionRequestIn, opts ...grpc.CallOption) (*CreateOptionRequestOut, error)
+	ApproveOptionRequest(ctx context.Context, in *ApproveOptionRequestIn, opts ...grpc.CallOption) (*ApproveOptionRequestOut, error)
+	RejectOptionRequest(ctx context.Context, in *RejectOptionRequestIn, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	MergeOptionRequest(ctx context.Context, in *MergeOptionRequestIn, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetAuditLog(ctx context.Context, in *GetAuditLogIn, opts ...grpc.CallOption) (*GetAuditLogOut, error)
+	ExportCatalog(ctx context.Context, in *ExportCatalogIn, opts ...grpc.CallOption) (*ExportCatalogOut, error)
+	SetAttributeTranslation(ctx context.Context, in *SetAttributeTranslationIn, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeleteAttributeTranslation(ctx context.Context, in *DeleteAttributeTranslationIn, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SetAttributeValueTranslation(ctx context.Context, in *SetAttributeValueTranslationIn, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeleteAttributeValueTranslation(ctx context.Context, in *DeleteAttributeValueTranslationIn, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetAttributeValueAliases(ctx context.Context, in *GetAttributeValueAliasesIn, opts ...grpc.CallOption) (*GetAttributeValueAliasesOut, error)
+	AddAttributeValueAlias(ctx context.Context, in *AddAttributeValueAliasIn, opts ...grpc.CallOption) (*AddAttributeValueAliasOut, error)
+	DeleteAttributeValueAlias(ctx context.Context, in *DeleteAttributeValueAliasIn, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type optionhubServiceClient struct {
@@ -52,7 +108,7 @@ func (c *optionhubServiceClient) AddAttributeValue(ctx context.Context, in *AddA
 	return out, nil
 }
 
-func (c *optionhubServiceClient) GetOptionRequests(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetOptionRequestsOut, error) {
+func (c *optionhubServiceClient) GetOptionRequests(ctx context.Context, in *GetOptionRequestsIn, opts ...grpc.CallOption) (*GetOptionRequestsOut, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetOptionRequestsOut)
 	err := c.cc.Invoke(ctx, OptionhubService_GetOptionRequests_FullMethodName, in, out, cOpts...)
@@ -72,13 +128,330 @@ func (c *optionhubServiceClient) GetAttributeValues(ctx context.Context, in *Get
 	return out, nil
 }
 
+func (c *optionhubServiceClient) SearchAttributeValues(ctx context.Context, in *SearchAttributeValuesIn, opts ...grpc.CallOption) (*SearchAttributeValuesOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchAttributeValuesOut)
+	err := c.cc.Invoke(ctx, OptionhubService_SearchAttributeValues_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *optionhubServiceClient) GetSubtree(ctx context.Context, in *GetSubtreeIn, opts ...grpc.CallOption) (*GetSubtreeOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSubtreeOut)
+	err := c.cc.Invoke(ctx, OptionhubService_GetSubtree_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *optionhubServiceClient) GetAncestors(ctx context.Context, in *GetAncestorsIn, opts ...grpc.CallOption) (*GetAncestorsOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAncestorsOut)
+	err := c.cc.Invoke(ctx, OptionhubService_GetAncestors_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *optionhubServiceClient) GetChildren(ctx context.Context, in *GetChildrenIn, opts ...grpc.CallOption) (*GetChildrenOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetChildrenOut)
+	err := c.cc.Invoke(ctx, OptionhubService_GetChildren_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *optionhubServiceClient) ResolveOptions(ctx context.Context, in *ResolveOptionsIn, opts ...grpc.CallOption) (*ResolveOptionsOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolveOptionsOut)
+	err := c.cc.Invoke(ctx, OptionhubService_ResolveOptions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *optionhubServiceClient) UpdateAttributeValue(ctx context.Context, in *UpdateAttributeValueIn, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, OptionhubService_UpdateAttributeValue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *optionhubServiceClient) DeleteAttributeValue(ctx context.Context, in *DeleteAttributeValueIn, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, OptionhubService_DeleteAttributeValue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *optionhubServiceClient) MergeAttributeValues(ctx context.Context, in *MergeAttributeValuesIn, opts ...grpc.CallOption) (*MergeAttributeValuesOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MergeAttributeValuesOut)
+	err := c.cc.Invoke(ctx, OptionhubService_MergeAttributeValues_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *optionhubServiceClient) WatchAttribute(ctx context.Context, in *WatchAttributeIn, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchAttributeOut], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &OptionhubService_ServiceDesc.Streams[0], OptionhubService_WatchAttribute_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchAttributeIn, WatchAttributeOut]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type OptionhubService_WatchAttributeClient = grpc.ServerStreamingClient[WatchAttributeOut]
+
+func (c *optionhubServiceClient) ImportAttributeValues(ctx context.Context, in *ImportAttributeValuesIn, opts ...grpc.CallOption) (*ImportAttributeValuesOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportAttributeValuesOut)
+	err := c.cc.Invoke(ctx, OptionhubService_ImportAttributeValues_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *optionhubServiceClient) CreateAttribute(ctx context.Context, in *CreateAttributeIn, opts ...grpc.CallOption) (*CreateAttributeOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateAttributeOut)
+	err := c.cc.Invoke(ctx, OptionhubService_CreateAttribute_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *optionhubServiceClient) GetAttribute(ctx context.Context, in *GetAttributeIn, opts ...grpc.CallOption) (*Attribute, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Attribute)
+	err := c.cc.Invoke(ctx, OptionhubService_GetAttribute_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *optionhubServiceClient) ListAttributes(ctx context.Context, in *ListAttributesIn, opts ...grpc.CallOption) (*ListAttributesOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAttributesOut)
+	err := c.cc.Invoke(ctx, OptionhubService_ListAttributes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *optionhubServiceClient) UpdateAttribute(ctx context.Context, in *UpdateAttributeIn, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, OptionhubService_UpdateAttribute_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *optionhubServiceClient) DeleteAttribute(ctx context.Context, in *DeleteAttributeIn, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, OptionhubService_DeleteAttribute_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *optionhubServiceClient) CreateOptionRequest(ctx context.Context, in *CreateOptionRequestIn, opts ...grpc.CallOption) (*CreateOptionRequestOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateOptionRequestOut)
+	err := c.cc.Invoke(ctx, OptionhubService_CreateOptionRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *optionhubServiceClient) ApproveOptionRequest(ctx context.Context, in *ApproveOptionRequestIn, opts ...grpc.CallOption) (*ApproveOptionRequestOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApproveOptionRequestOut)
+	err := c.cc.Invoke(ctx, OptionhubService_ApproveOptionRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *optionhubServiceClient) RejectOptionRequest(ctx context.Context, in *RejectOptionRequestIn, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, OptionhubService_RejectOptionRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *optionhubServiceClient) MergeOptionRequest(ctx context.Context, in *MergeOptionRequestIn, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, OptionhubService_MergeOptionRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *optionhubServiceClient) GetAuditLog(ctx context.Context, in *GetAuditLogIn, opts ...grpc.CallOption) (*GetAuditLogOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAuditLogOut)
+	err := c.cc.Invoke(ctx, OptionhubService_GetAuditLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *optionhubServiceClient) ExportCatalog(ctx context.Context, in *ExportCatalogIn, opts ...grpc.CallOption) (*ExportCatalogOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportCatalogOut)
+	err := c.cc.Invoke(ctx, OptionhubService_ExportCatalog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *optionhubServiceClient) SetAttributeTranslation(ctx context.Context, in *SetAttributeTranslationIn, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, OptionhubService_SetAttributeTranslation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *optionhubServiceClient) DeleteAttributeTranslation(ctx context.Context, in *DeleteAttributeTranslationIn, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, OptionhubService_DeleteAttributeTranslation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *optionhubServiceClient) SetAttributeValueTranslation(ctx context.Context, in *SetAttributeValueTranslationIn, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, OptionhubService_SetAttributeValueTranslation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *optionhubServiceClient) DeleteAttributeValueTranslation(ctx context.Context, in *DeleteAttributeValueTranslationIn, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, OptionhubService_DeleteAttributeValueTranslation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *optionhubServiceClient) GetAttributeValueAliases(ctx context.Context, in *GetAttributeValueAliasesIn, opts ...grpc.CallOption) (*GetAttributeValueAliasesOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAttributeValueAliasesOut)
+	err := c.cc.Invoke(ctx, OptionhubService_GetAttributeValueAliases_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *optionhubServiceClient) AddAttributeValueAlias(ctx context.Context, in *AddAttributeValueAliasIn, opts ...grpc.CallOption) (*AddAttributeValueAliasOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddAttributeValueAliasOut)
+	err := c.cc.Invoke(ctx, OptionhubService_AddAttributeValueAlias_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *optionhubServiceClient) DeleteAttributeValueAlias(ctx context.Context, in *DeleteAttributeValueAliasIn, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, OptionhubService_DeleteAttributeValueAlias_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OptionhubServiceServer is the server API for OptionhubService service.
 // All implementations must embed UnimplementedOptionhubServiceServer
 // for forward compatibility.
 type OptionhubServiceServer interface {
 	AddAttributeValue(context.Context, *AddAttributeValueIn) (*emptypb.Empty, error)
-	GetOptionRequests(context.Context, *emptypb.Empty) (*GetOptionRequestsOut, error)
+	GetOptionRequests(context.Context, *GetOptionRequestsIn) (*GetOptionRequestsOut, error)
 	GetAttributeValues(context.Context, *GetAttributeValuesIn) (*GetAttributeValuesOut, error)
+	SearchAttributeValues(context.Context, *SearchAttributeValuesIn) (*SearchAttributeValuesOut, error)
+	GetSubtree(context.Context, *GetSubtreeIn) (*GetSubtreeOut, error)
+	GetAncestors(context.Context, *GetAncestorsIn) (*GetAncestorsOut, error)
+	GetChildren(context.Context, *GetChildrenIn) (*GetChildrenOut, error)
+	ResolveOptions(context.Context, *ResolveOptionsIn) (*ResolveOptionsOut, error)
+	UpdateAttributeValue(context.Context, *UpdateAttributeValueIn) (*emptypb.Empty, error)
+	DeleteAttributeValue(context.Context, *DeleteAttributeValueIn) (*emptypb.Empty, error)
+	MergeAttributeValues(context.Context, *MergeAttributeValuesIn) (*MergeAttributeValuesOut, error)
+	WatchAttribute(*WatchAttributeIn, grpc.ServerStreamingServer[WatchAttributeOut]) error
+	ImportAttributeValues(context.Context, *ImportAttributeValuesIn) (*ImportAttributeValuesOut, error)
+	CreateAttribute(context.Context, *CreateAttributeIn) (*CreateAttributeOut, error)
+	GetAttribute(context.Context, *GetAttributeIn) (*Attribute, error)
+	ListAttributes(context.Context, *ListAttributesIn) (*ListAttributesOut, error)
+	UpdateAttribute(context.Context, *UpdateAttributeIn) (*emptypb.Empty, error)
+	DeleteAttribute(context.Context, *DeleteAttributeIn) (*emptypb.Empty, error)
+	CreateOptionRequest(context.Context, *CreateOptionRequestIn) (*CreateOptionRequestOut, error)
+	ApproveOptionRequest(context.Context, *ApproveOptionRequestIn) (*ApproveOptionRequestOut, error)
+	RejectOptionRequest(context.Context, *RejectOptionRequestIn) (*emptypb.Empty, error)
+	MergeOptionRequest(context.Context, *MergeOptionRequestIn) (*emptypb.Empty, error)
+	GetAuditLog(context.Context, *GetAuditLogIn) (*GetAuditLogOut, error)
+	ExportCatalog(context.Context, *ExportCatalogIn) (*ExportCatalogOut, error)
+	SetAttributeTranslation(context.Context, *SetAttributeTranslationIn) (*emptypb.Empty, error)
+	DeleteAttributeTranslation(context.Context, *DeleteAttributeTranslationIn) (*emptypb.Empty, error)
+	SetAttributeValueTranslation(context.Context, *SetAttributeValueTranslationIn) (*emptypb.Empty, error)
+	DeleteAttributeValueTranslation(context.Context, *DeleteAttributeValueTranslationIn) (*emptypb.Empty, error)
+	GetAttributeValueAliases(context.Context, *GetAttributeValueAliasesIn) (*GetAttributeValueAliasesOut, error)
+	AddAttributeValueAlias(context.Context, *AddAttributeValueAliasIn) (*AddAttributeValueAliasOut, error)
+	DeleteAttributeValueAlias(context.Context, *DeleteAttributeValueAliasIn) (*emptypb.Empty, error)
 	mustEmbedUnimplementedOptionhubServiceServer()
 }
 
@@ -92,12 +465,96 @@ type UnimplementedOptionhubServiceServer struct{}
 func (UnimplementedOptionhubServiceServer) AddAttributeValue(context.Context, *AddAttributeValueIn) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddAttributeValue not implemented")
 }
-func (UnimplementedOptionhubServiceServer) GetOptionRequests(context.Context, *emptypb.Empty) (*GetOptionRequestsOut, error) {
+func (UnimplementedOptionhubServiceServer) GetOptionRequests(context.Context, *GetOptionRequestsIn) (*GetOptionRequestsOut, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetOptionRequests not implemented")
 }
 func (UnimplementedOptionhubServiceServer) GetAttributeValues(context.Context, *GetAttributeValuesIn) (*GetAttributeValuesOut, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAttributeValues not implemented")
 }
+func (UnimplementedOptionhubServiceServer) SearchAttributeValues(context.Context, *SearchAttributeValuesIn) (*SearchAttributeValuesOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchAttributeValues not implemented")
+}
+func (UnimplementedOptionhubServiceServer) GetSubtree(context.Context, *GetSubtreeIn) (*GetSubtreeOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSubtree not implemented")
+}
+func (UnimplementedOptionhubServiceServer) GetAncestors(context.Context, *GetAncestorsIn) (*GetAncestorsOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAncestors not implemented")
+}
+func (UnimplementedOptionhubServiceServer) GetChildren(context.Context, *GetChildrenIn) (*GetChildrenOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChildren not implemented")
+}
+func (UnimplementedOptionhubServiceServer) ResolveOptions(context.Context, *ResolveOptionsIn) (*ResolveOptionsOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveOptions not implemented")
+}
+func (UnimplementedOptionhubServiceServer) UpdateAttributeValue(context.Context, *UpdateAttributeValueIn) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAttributeValue not implemented")
+}
+func (UnimplementedOptionhubServiceServer) DeleteAttributeValue(context.Context, *DeleteAttributeValueIn) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAttributeValue not implemented")
+}
+func (UnimplementedOptionhubServiceServer) MergeAttributeValues(context.Context, *MergeAttributeValuesIn) (*MergeAttributeValuesOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeAttributeValues not implemented")
+}
+func (UnimplementedOptionhubServiceServer) WatchAttribute(*WatchAttributeIn, grpc.ServerStreamingServer[WatchAttributeOut]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchAttribute not implemented")
+}
+func (UnimplementedOptionhubServiceServer) ImportAttributeValues(context.Context, *ImportAttributeValuesIn) (*ImportAttributeValuesOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportAttributeValues not implemented")
+}
+func (UnimplementedOptionhubServiceServer) CreateAttribute(context.Context, *CreateAttributeIn) (*CreateAttributeOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAttribute not implemented")
+}
+func (UnimplementedOptionhubServiceServer) GetAttribute(context.Context, *GetAttributeIn) (*Attribute, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAttribute not implemented")
+}
+func (UnimplementedOptionhubServiceServer) ListAttributes(context.Context, *ListAttributesIn) (*ListAttributesOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAttributes not implemented")
+}
+func (UnimplementedOptionhubServiceServer) UpdateAttribute(context.Context, *UpdateAttributeIn) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAttribute not implemented")
+}
+func (UnimplementedOptionhubServiceServer) DeleteAttribute(context.Context, *DeleteAttributeIn) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAttribute not implemented")
+}
+func (UnimplementedOptionhubServiceServer) CreateOptionRequest(context.Context, *CreateOptionRequestIn) (*CreateOptionRequestOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOptionRequest not implemented")
+}
+func (UnimplementedOptionhubServiceServer) ApproveOptionRequest(context.Context, *ApproveOptionRequestIn) (*ApproveOptionRequestOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveOptionRequest not implemented")
+}
+func (UnimplementedOptionhubServiceServer) RejectOptionRequest(context.Context, *RejectOptionRequestIn) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectOptionRequest not implemented")
+}
+func (UnimplementedOptionhubServiceServer) MergeOptionRequest(context.Context, *MergeOptionRequestIn) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeOptionRequest not implemented")
+}
+func (UnimplementedOptionhubServiceServer) GetAuditLog(context.Context, *GetAuditLogIn) (*GetAuditLogOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAuditLog not implemented")
+}
+func (UnimplementedOptionhubServiceServer) ExportCatalog(context.Context, *ExportCatalogIn) (*ExportCatalogOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportCatalog not implemented")
+}
+func (UnimplementedOptionhubServiceServer) SetAttributeTranslation(context.Context, *SetAttributeTranslationIn) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAttributeTranslation not implemented")
+}
+func (UnimplementedOptionhubServiceServer) DeleteAttributeTranslation(context.Context, *DeleteAttributeTranslationIn) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAttributeTranslation not implemented")
+}
+func (UnimplementedOptionhubServiceServer) SetAttributeValueTranslation(context.Context, *SetAttributeValueTranslationIn) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAttributeValueTranslation not implemented")
+}
+func (UnimplementedOptionhubServiceServer) DeleteAttributeValueTranslation(context.Context, *DeleteAttributeValueTranslationIn) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAttributeValueTranslation not implemented")
+}
+func (UnimplementedOptionhubServiceServer) GetAttributeValueAliases(context.Context, *GetAttributeValueAliasesIn) (*GetAttributeValueAliasesOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAttributeValueAliases not implemented")
+}
+func (UnimplementedOptionhubServiceServer) AddAttributeValueAlias(context.Context, *AddAttributeValueAliasIn) (*AddAttributeValueAliasOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAttributeValueAlias not implemented")
+}
+func (UnimplementedOptionhubServiceServer) DeleteAttributeValueAlias(context.Context, *DeleteAttributeValueAliasIn) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAttributeValueAlias not implemented")
+}
 func (UnimplementedOptionhubServiceServer) mustEmbedUnimplementedOptionhubServiceServer() {}
 func (UnimplementedOptionhubServiceServer) testEmbeddedByValue()                          {}
 
@@ -138,7 +595,7 @@ func _OptionhubService_AddAttributeValue_Handler(srv interface{}, ctx context.Co
 }
 
 func _OptionhubService_GetOptionRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+	in := new(GetOptionRequestsIn)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -150,7 +607,7 @@ func _OptionhubService_GetOptionRequests_Handler(srv interface{}, ctx context.Co
 		FullMethod: OptionhubService_GetOptionRequests_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OptionhubServiceServer).GetOptionRequests(ctx, req.(*emptypb.Empty))
+		return srv.(OptionhubServiceServer).GetOptionRequests(ctx, req.(*GetOptionRequestsIn))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -173,26 +630,637 @@ func _OptionhubService_GetAttributeValues_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
-// OptionhubService_ServiceDesc is the grpc.ServiceDesc for OptionhubService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var OptionhubService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "OptionhubService",
-	HandlerType: (*OptionhubServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "AddAttributeValue",
-			Handler:    _OptionhubService_AddAttributeValue_Handler,
-		},
-		{
-			MethodName: "GetOptionRequests",
-			Handler:    _OptionhubService_GetOptionRequests_Handler,
-		},
-		{
-			MethodName: "GetAttributeValues",
+func _OptionhubService_SearchAttributeValues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchAttributeValuesIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OptionhubServiceServer).SearchAttributeValues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OptionhubService_SearchAttributeValues_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OptionhubServiceServer).SearchAttributeValues(ctx, req.(*SearchAttributeValuesIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OptionhubService_GetSubtree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSubtreeIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OptionhubServiceServer).GetSubtree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OptionhubService_GetSubtree_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OptionhubServiceServer).GetSubtree(ctx, req.(*GetSubtreeIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OptionhubService_GetAncestors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAncestorsIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OptionhubServiceServer).GetAncestors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OptionhubService_GetAncestors_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OptionhubServiceServer).GetAncestors(ctx, req.(*GetAncestorsIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OptionhubService_GetChildren_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChildrenIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OptionhubServiceServer).GetChildren(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OptionhubService_GetChildren_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OptionhubServiceServer).GetChildren(ctx, req.(*GetChildrenIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OptionhubService_ResolveOptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveOptionsIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OptionhubServiceServer).ResolveOptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OptionhubService_ResolveOptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OptionhubServiceServer).ResolveOptions(ctx, req.(*ResolveOptionsIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OptionhubService_UpdateAttributeValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAttributeValueIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OptionhubServiceServer).UpdateAttributeValue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OptionhubService_UpdateAttributeValue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OptionhubServiceServer).UpdateAttributeValue(ctx, req.(*UpdateAttributeValueIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OptionhubService_DeleteAttributeValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAttributeValueIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OptionhubServiceServer).DeleteAttributeValue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OptionhubService_DeleteAttributeValue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OptionhubServiceServer).DeleteAttributeValue(ctx, req.(*DeleteAttributeValueIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OptionhubService_MergeAttributeValues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeAttributeValuesIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OptionhubServiceServer).MergeAttributeValues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OptionhubService_MergeAttributeValues_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OptionhubServiceServer).MergeAttributeValues(ctx, req.(*MergeAttributeValuesIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OptionhubService_WatchAttribute_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchAttributeIn)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OptionhubServiceServer).WatchAttribute(m, &grpc.GenericServerStream[WatchAttributeIn, WatchAttributeOut]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type OptionhubService_WatchAttributeServer = grpc.ServerStreamingServer[WatchAttributeOut]
+
+func _OptionhubService_ImportAttributeValues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportAttributeValuesIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OptionhubServiceServer).ImportAttributeValues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OptionhubService_ImportAttributeValues_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OptionhubServiceServer).ImportAttributeValues(ctx, req.(*ImportAttributeValuesIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OptionhubService_CreateAttribute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAttributeIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OptionhubServiceServer).CreateAttribute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OptionhubService_CreateAttribute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OptionhubServiceServer).CreateAttribute(ctx, req.(*CreateAttributeIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OptionhubService_GetAttribute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAttributeIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OptionhubServiceServer).GetAttribute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OptionhubService_GetAttribute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OptionhubServiceServer).GetAttribute(ctx, req.(*GetAttributeIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OptionhubService_ListAttributes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAttributesIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OptionhubServiceServer).ListAttributes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OptionhubService_ListAttributes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OptionhubServiceServer).ListAttributes(ctx, req.(*ListAttributesIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OptionhubService_UpdateAttribute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAttributeIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OptionhubServiceServer).UpdateAttribute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OptionhubService_UpdateAttribute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OptionhubServiceServer).UpdateAttribute(ctx, req.(*UpdateAttributeIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OptionhubService_DeleteAttribute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAttributeIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OptionhubServiceServer).DeleteAttribute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OptionhubService_DeleteAttribute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OptionhubServiceServer).DeleteAttribute(ctx, req.(*DeleteAttributeIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OptionhubService_CreateOptionRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOptionRequestIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OptionhubServiceServer).CreateOptionRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OptionhubService_CreateOptionRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OptionhubServiceServer).CreateOptionRequest(ctx, req.(*CreateOptionRequestIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OptionhubService_ApproveOptionRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveOptionRequestIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OptionhubServiceServer).ApproveOptionRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OptionhubService_ApproveOptionRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OptionhubServiceServer).ApproveOptionRequest(ctx, req.(*ApproveOptionRequestIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OptionhubService_RejectOptionRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectOptionRequestIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OptionhubServiceServer).RejectOptionRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OptionhubService_RejectOptionRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OptionhubServiceServer).RejectOptionRequest(ctx, req.(*RejectOptionRequestIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OptionhubService_MergeOptionRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeOptionRequestIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OptionhubServiceServer).MergeOptionRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OptionhubService_MergeOptionRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OptionhubServiceServer).MergeOptionRequest(ctx, req.(*MergeOptionRequestIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OptionhubService_GetAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAuditLogIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OptionhubServiceServer).GetAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OptionhubService_GetAuditLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OptionhubServiceServer).GetAuditLog(ctx, req.(*GetAuditLogIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OptionhubService_ExportCatalog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportCatalogIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OptionhubServiceServer).ExportCatalog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OptionhubService_ExportCatalog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OptionhubServiceServer).ExportCatalog(ctx, req.(*ExportCatalogIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OptionhubService_SetAttributeTranslation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAttributeTranslationIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OptionhubServiceServer).SetAttributeTranslation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OptionhubService_SetAttributeTranslation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OptionhubServiceServer).SetAttributeTranslation(ctx, req.(*SetAttributeTranslationIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OptionhubService_DeleteAttributeTranslation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAttributeTranslationIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OptionhubServiceServer).DeleteAttributeTranslation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OptionhubService_DeleteAttributeTranslation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OptionhubServiceServer).DeleteAttributeTranslation(ctx, req.(*DeleteAttributeTranslationIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OptionhubService_SetAttributeValueTranslation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAttributeValueTranslationIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OptionhubServiceServer).SetAttributeValueTranslation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OptionhubService_SetAttributeValueTranslation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OptionhubServiceServer).SetAttributeValueTranslation(ctx, req.(*SetAttributeValueTranslationIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OptionhubService_DeleteAttributeValueTranslation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAttributeValueTranslationIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OptionhubServiceServer).DeleteAttributeValueTranslation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OptionhubService_DeleteAttributeValueTranslation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OptionhubServiceServer).DeleteAttributeValueTranslation(ctx, req.(*DeleteAttributeValueTranslationIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OptionhubService_GetAttributeValueAliases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAttributeValueAliasesIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OptionhubServiceServer).GetAttributeValueAliases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OptionhubService_GetAttributeValueAliases_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OptionhubServiceServer).GetAttributeValueAliases(ctx, req.(*GetAttributeValueAliasesIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OptionhubService_AddAttributeValueAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddAttributeValueAliasIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OptionhubServiceServer).AddAttributeValueAlias(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OptionhubService_AddAttributeValueAlias_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OptionhubServiceServer).AddAttributeValueAlias(ctx, req.(*AddAttributeValueAliasIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OptionhubService_DeleteAttributeValueAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAttributeValueAliasIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OptionhubServiceServer).DeleteAttributeValueAlias(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OptionhubService_DeleteAttributeValueAlias_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OptionhubServiceServer).DeleteAttributeValueAlias(ctx, req.(*DeleteAttributeValueAliasIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// OptionhubService_ServiceDesc is the grpc.ServiceDesc for OptionhubService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var OptionhubService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "OptionhubService",
+	HandlerType: (*OptionhubServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddAttributeValue",
+			Handler:    _OptionhubService_AddAttributeValue_Handler,
+		},
+		{
+			MethodName: "GetOptionRequests",
+			Handler:    _OptionhubService_GetOptionRequests_Handler,
+		},
+		{
+			MethodName: "GetAttributeValues",
 			Handler:    _OptionhubService_GetAttributeValues_Handler,
 		},
+		{
+			MethodName: "SearchAttributeValues",
+			Handler:    _OptionhubService_SearchAttributeValues_Handler,
+		},
+		{
+			MethodName: "GetSubtree",
+			Handler:    _OptionhubService_GetSubtree_Handler,
+		},
+		{
+			MethodName: "GetAncestors",
+			Handler:    _OptionhubService_GetAncestors_Handler,
+		},
+		{
+			MethodName: "GetChildren",
+			Handler:    _OptionhubService_GetChildren_Handler,
+		},
+		{
+			MethodName: "ResolveOptions",
+			Handler:    _OptionhubService_ResolveOptions_Handler,
+		},
+		{
+			MethodName: "UpdateAttributeValue",
+			Handler:    _OptionhubService_UpdateAttributeValue_Handler,
+		},
+		{
+			MethodName: "DeleteAttributeValue",
+			Handler:    _OptionhubService_DeleteAttributeValue_Handler,
+		},
+		{
+			MethodName: "MergeAttributeValues",
+			Handler:    _OptionhubService_MergeAttributeValues_Handler,
+		},
+		{
+			MethodName: "ImportAttributeValues",
+			Handler:    _OptionhubService_ImportAttributeValues_Handler,
+		},
+		{
+			MethodName: "CreateAttribute",
+			Handler:    _OptionhubService_CreateAttribute_Handler,
+		},
+		{
+			MethodName: "GetAttribute",
+			Handler:    _OptionhubService_GetAttribute_Handler,
+		},
+		{
+			MethodName: "ListAttributes",
+			Handler:    _OptionhubService_ListAttributes_Handler,
+		},
+		{
+			MethodName: "UpdateAttribute",
+			Handler:    _OptionhubService_UpdateAttribute_Handler,
+		},
+		{
+			MethodName: "DeleteAttribute",
+			Handler:    _OptionhubService_DeleteAttribute_Handler,
+		},
+		{
+			MethodName: "CreateOptionRequest",
+			Handler:    _OptionhubService_CreateOptionRequest_Handler,
+		},
+		{
+			MethodName: "ApproveOptionRequest",
+			Handler:    _OptionhubService_ApproveOptionRequest_Handler,
+		},
+		{
+			MethodName: "RejectOptionRequest",
+			Handler:    _OptionhubService_RejectOptionRequest_Handler,
+		},
+		{
+			MethodName: "MergeOptionRequest",
+			Handler:    _OptionhubService_MergeOptionRequest_Handler,
+		},
+		{
+			MethodName: "GetAuditLog",
+			Handler:    _OptionhubService_GetAuditLog_Handler,
+		},
+		{
+			MethodName: "ExportCatalog",
+			Handler:    _OptionhubService_ExportCatalog_Handler,
+		},
+		{
+			MethodName: "SetAttributeTranslation",
+			Handler:    _OptionhubService_SetAttributeTranslation_Handler,
+		},
+		{
+			MethodName: "DeleteAttributeTranslation",
+			Handler:    _OptionhubService_DeleteAttributeTranslation_Handler,
+		},
+		{
+			MethodName: "SetAttributeValueTranslation",
+			Handler:    _OptionhubService_SetAttributeValueTranslation_Handler,
+		},
+		{
+			MethodName: "DeleteAttributeValueTranslation",
+			Handler:    _OptionhubService_DeleteAttributeValueTranslation_Handler,
+		},
+		{
+			MethodName: "GetAttributeValueAliases",
+			Handler:    _OptionhubService_GetAttributeValueAliases_Handler,
+		},
+		{
+			MethodName: "AddAttributeValueAlias",
+			Handler:    _OptionhubService_AddAttributeValueAlias_Handler,
+		},
+		{
+			MethodName: "DeleteAttributeValueAlias",
+			Handler:    _OptionhubService_DeleteAttributeValueAlias_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchAttribute",
+			Handler:       _OptionhubService_WatchAttribute_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/optionhub.proto",
 }